@@ -0,0 +1,11 @@
+data "railway_cron_executions" "backup" {
+  environment_id = railway_project.example.default_environment.id
+  service_id     = railway_service.backup.id
+}
+
+check "backup_succeeded" {
+  assert {
+    condition     = alltrue([for execution in data.railway_cron_executions.backup.executions : execution.succeeded != false])
+    error_message = "A recent backup run has failed."
+  }
+}
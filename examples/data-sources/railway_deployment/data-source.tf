@@ -0,0 +1,4 @@
+data "railway_deployment" "latest" {
+  environment_id = railway_project.example.default_environment.id
+  service_id     = railway_service.example.id
+}
@@ -0,0 +1,6 @@
+data "railway_deployments" "failed" {
+  environment_id = railway_project.example.default_environment.id
+  service_id     = railway_service.example.id
+  statuses       = ["FAILED", "CRASHED"]
+  limit          = 5
+}
@@ -0,0 +1,10 @@
+data "railway_usage" "example" {
+  workspace_id = "ecb63be7-63fb-47fe-95fc-1585d24e172d"
+}
+
+check "usage_budget" {
+  assert {
+    condition     = data.railway_usage.example.estimated_cost < 50
+    error_message = "The workspace is estimated to cost more than $50 this billing period."
+  }
+}
@@ -0,0 +1 @@
+terraform import railway_api_token.ci 5f0e2c1b-3a4d-4b6e-8c7f-9d0a1b2c3d4e
@@ -0,0 +1,13 @@
+resource "time_rotating" "ci" {
+  rotation_days = 90
+}
+
+resource "railway_api_token" "ci" {
+  name             = "ci"
+  workspace_id     = "ecb63be7-63fb-47fe-95fc-1585d24e172d"
+  rotation_trigger = time_rotating.ci.id
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
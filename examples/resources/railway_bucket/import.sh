@@ -0,0 +1 @@
+terraform import railway_bucket.uploads 0bb01547-570d-4109-a5e8-138691f6a2d1:staging:a1b2c3d4-5e6f-4a7b-8c9d-0e1f2a3b4c5d
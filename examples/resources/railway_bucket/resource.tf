@@ -0,0 +1,8 @@
+resource "railway_bucket" "uploads" {
+  name           = "uploads"
+  project_id     = railway_project.example.id
+  environment_id = railway_project.example.default_environment.id
+
+  # Change to rotate the access credentials
+  credentials_rotation_trigger = "2024-01"
+}
@@ -3,3 +3,11 @@ resource "railway_custom_domain" "api" {
   environment_id = railway_project.example.default_environment.id
   service_id     = railway_service.example.id
 }
+
+resource "railway_custom_domain" "www" {
+  domain                = "www.example.com"
+  environment_id        = railway_project.example.default_environment.id
+  service_id            = railway_service.example.id
+  wait_for_verification = true
+  verification_timeout  = 3600
+}
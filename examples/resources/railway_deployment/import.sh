@@ -0,0 +1 @@
+terraform import railway_deployment.production 5b7c2a1e-6f0d-4c8a-9e3b-2d1f4a6c8e90
@@ -0,0 +1,11 @@
+resource "railway_deployment" "production" {
+  commit_sha     = "3f786850e387550fdab836ed7e6dc881de23001b"
+  environment_id = railway_project.example.default_environment.id
+  service_id     = railway_service.example.id
+}
+
+resource "railway_deployment" "latest" {
+  latest_commit  = true
+  environment_id = railway_project.example.default_environment.id
+  service_id     = railway_service.example.id
+}
@@ -0,0 +1,3 @@
+resource "railway_deployment_rollback" "incident" {
+  deployment_id = var.last_known_good_deployment_id
+}
@@ -0,0 +1,5 @@
+resource "railway_docker_compose" "example" {
+  yaml           = file("docker-compose.yml")
+  project_id     = railway_project.example.id
+  environment_id = railway_project.example.default_environment.id
+}
@@ -0,0 +1 @@
+terraform import railway_egress_gateway.api 89fa0236-2b1b-4a8c-b12d-ae3634b30d97:staging:us-west2
@@ -0,0 +1,9 @@
+resource "railway_egress_gateway" "api" {
+  region         = "us-west2"
+  environment_id = railway_project.example.default_environment.id
+  service_id     = railway_service.example.id
+}
+
+output "api_outbound_ips" {
+  value = railway_egress_gateway.api.ipv4_addresses
+}
@@ -0,0 +1,2 @@
+terraform import railway_integration.example 2c5d9f1e-8a7b-4c3d-9e6f-1a2b3c4d5e6f
+terraform import railway_integration.example 0bb01547-570d-4109-a5e8-138691f6a2d1:2c5d9f1e-8a7b-4c3d-9e6f-1a2b3c4d5e6f
@@ -0,0 +1,9 @@
+resource "railway_integration" "example" {
+  name       = "datadog"
+  project_id = railway_project.example.id
+
+  config = jsonencode({
+    site   = "datadoghq.eu"
+    region = "eu"
+  })
+}
@@ -0,0 +1,25 @@
+resource "railway_environment" "preview" {
+  name       = "preview"
+  project_id = railway_project.example.id
+}
+
+resource "railway_observability_dashboard" "preview" {
+  environment_id = railway_environment.preview.id
+
+  items = [
+    {
+      name         = "API metrics"
+      type         = "SERVICE_METRICS_ITEM"
+      resource_ids = [railway_service.example.id]
+      measurements = ["CPU_USAGE", "MEMORY_USAGE_GB"]
+      layout       = { x = 0, y = 0, width = 6, height = 4 }
+    },
+    {
+      name         = "API errors"
+      type         = "SERVICE_LOGS_ITEM"
+      resource_ids = [railway_service.example.id]
+      logs_filter  = "@level:error"
+      layout       = { x = 6, y = 0, width = 6, height = 4 }
+    },
+  ]
+}
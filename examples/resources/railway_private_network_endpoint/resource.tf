@@ -0,0 +1,12 @@
+resource "railway_private_network_endpoint" "api" {
+  dns_name       = "api"
+  environment_id = railway_project.example.default_environment.id
+  service_id     = railway_service.example.id
+}
+
+resource "railway_variable" "api_url" {
+  name           = "API_URL"
+  value          = "http://${railway_private_network_endpoint.api.hostname}:8080"
+  environment_id = railway_project.example.default_environment.id
+  service_id     = railway_service.worker.id
+}
@@ -3,3 +3,9 @@ resource "railway_service_domain" "api" {
   environment_id = railway_project.example.default_environment.id
   service_id     = railway_service.example.id
 }
+
+resource "railway_service_domain" "web" {
+  target_port    = 3000
+  environment_id = railway_project.example.default_environment.id
+  service_id     = railway_service.example.id
+}
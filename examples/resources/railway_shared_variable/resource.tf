@@ -4,3 +4,11 @@ resource "railway_shared_variable" "example" {
   project_id     = railway_project.example.id
   environment_id = railway_project.example.default_environment.id
 }
+
+resource "railway_shared_variable" "payments" {
+  name                = "STRIPE_SECRET_KEY"
+  value               = "sk_live_1234567890"
+  project_id          = railway_project.example.id
+  environment_id      = railway_project.example.default_environment.id
+  enabled_service_ids = [railway_service.api.id]
+}
@@ -0,0 +1 @@
+terraform import railway_ssh_public_key.example 7a6b3d2e-6c1f-4f4e-9f3a-2d8b5c4e1a90
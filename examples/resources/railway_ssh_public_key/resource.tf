@@ -0,0 +1,4 @@
+resource "railway_ssh_public_key" "example" {
+  name       = "laptop"
+  public_key = file("~/.ssh/id_ed25519.pub")
+}
@@ -0,0 +1,20 @@
+resource "railway_template_deployment" "postgres" {
+  template_code  = "postgres"
+  project_id     = railway_project.example.id
+  environment_id = railway_project.example.default_environment.id
+
+  service_variables = {
+    Postgres = {
+      POSTGRES_DB = "app"
+    }
+  }
+}
+
+resource "railway_variable" "database_url" {
+  name           = "DATABASE_URL"
+  value          = "$${{Postgres.DATABASE_URL}}"
+  environment_id = railway_project.example.default_environment.id
+  service_id     = railway_service.example.id
+
+  depends_on = [railway_template_deployment.postgres]
+}
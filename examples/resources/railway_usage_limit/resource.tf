@@ -0,0 +1,5 @@
+resource "railway_usage_limit" "example" {
+  workspace_id = "ecb63be7-63fb-47fe-95fc-1585d24e172d"
+  soft_limit   = 50
+  hard_limit   = 100
+}
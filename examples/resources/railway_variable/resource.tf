@@ -4,3 +4,30 @@ resource "railway_variable" "example" {
   environment_id = railway_project.example.default_environment.id
   service_id     = railway_service.example.id
 }
+
+resource "railway_variable" "database_url" {
+  name           = "DATABASE_URL"
+  environment_id = railway_project.example.default_environment.id
+  service_id     = railway_service.example.id
+
+  reference = {
+    service_id = railway_service.postgres.id
+    name       = "DATABASE_URL"
+  }
+}
+
+resource "railway_variable" "stripe_key" {
+  name             = "STRIPE_SECRET_KEY"
+  value_wo         = var.stripe_secret_key
+  value_wo_version = 1
+  environment_id   = railway_project.example.default_environment.id
+  service_id       = railway_service.example.id
+}
+
+resource "railway_variable" "api_token" {
+  name           = "API_TOKEN"
+  value          = var.api_token
+  sealed         = true
+  environment_id = railway_project.example.default_environment.id
+  service_id     = railway_service.example.id
+}
@@ -0,0 +1,11 @@
+resource "railway_variable_import" "example" {
+  dotenv         = file(".env")
+  environment_id = railway_project.example.default_environment.id
+  service_id     = railway_service.example.id
+}
+
+resource "railway_variable_import" "heroku" {
+  heroku_app_id  = "01234567-89ab-cdef-0123-456789abcdef"
+  environment_id = railway_project.example.default_environment.id
+  service_id     = railway_service.example.id
+}
@@ -0,0 +1,2 @@
+terraform import railway_variables.example 89fa0236-2b1b-4a8c-b12d-ae3634b30d97:staging
+terraform import railway_variables.shared shared:0bb01547-570d-4109-a5e8-138691f6a2d1:staging
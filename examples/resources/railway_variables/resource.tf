@@ -0,0 +1,20 @@
+resource "railway_variables" "example" {
+  environment_id = railway_project.example.default_environment.id
+  service_id     = railway_service.example.id
+
+  variables = {
+    SENTRY_KEY    = "KEY"
+    SENTRY_SECRET = "SECRET"
+  }
+
+  ignore_names = ["DATABASE_URL"]
+}
+
+resource "railway_variables" "shared" {
+  environment_id = railway_project.example.default_environment.id
+  project_id     = railway_project.example.id
+
+  variables = {
+    LOG_LEVEL = "info"
+  }
+}
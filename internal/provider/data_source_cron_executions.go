@@ -0,0 +1,204 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const defaultCronExecutionsLimit = 10
+
+var _ datasource.DataSource = &CronExecutionsDataSource{}
+
+func NewCronExecutionsDataSource() datasource.DataSource {
+	return &CronExecutionsDataSource{}
+}
+
+type CronExecutionsDataSource struct {
+	client *graphql.Client
+}
+
+type CronExecutionsDataSourceModel struct {
+	EnvironmentId types.String `tfsdk:"environment_id"`
+	ServiceId     types.String `tfsdk:"service_id"`
+	Limit         types.Int64  `tfsdk:"limit"`
+	Executions    types.List   `tfsdk:"executions"`
+}
+
+type CronExecutionsDataSourceExecutionModel struct {
+	Id           types.String `tfsdk:"id"`
+	DeploymentId types.String `tfsdk:"deployment_id"`
+	Status       types.String `tfsdk:"status"`
+	Succeeded    types.Bool   `tfsdk:"succeeded"`
+	CreatedAt    types.String `tfsdk:"created_at"`
+	CompletedAt  types.String `tfsdk:"completed_at"`
+}
+
+var cronExecutionAttrTypes = map[string]attr.Type{
+	"id":            types.StringType,
+	"deployment_id": types.StringType,
+	"status":        types.StringType,
+	"succeeded":     types.BoolType,
+	"created_at":    types.StringType,
+	"completed_at":  types.StringType,
+}
+
+func (d *CronExecutionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cron_executions"
+}
+
+func (d *CronExecutionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Railway cron executions. Lists the most recent cron runs of a service in an environment.",
+		Attributes: map[string]schema.Attribute{
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the environment the cron runs belong to.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an id"),
+				},
+			},
+			"service_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the service the cron runs belong to.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an id"),
+				},
+			},
+			"limit": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of cron runs to list. **Default** `10`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+					int64validator.AtMost(100),
+				},
+			},
+			"executions": schema.ListNestedAttribute{
+				MarkdownDescription: "Cron runs, most recent first.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Identifier of the cron run.",
+							Computed:            true,
+						},
+						"deployment_id": schema.StringAttribute{
+							MarkdownDescription: "Identifier of the deployment the cron run belongs to.",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Status of the cron run, e.g. `RUNNING`, `EXITED` or `CRASHED`.",
+							Computed:            true,
+						},
+						"succeeded": schema.BoolAttribute{
+							MarkdownDescription: "Whether the cron run exited successfully. Not set while it is running.",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "Start time of the cron run in RFC 3339 format.",
+							Computed:            true,
+						},
+						"completed_at": schema.StringAttribute{
+							MarkdownDescription: "Completion time of the cron run in RFC 3339 format.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *CronExecutionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *CronExecutionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *CronExecutionsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	limit := int64(defaultCronExecutionsLimit)
+
+	if !data.Limit.IsNull() {
+		limit = data.Limit.ValueInt64()
+	}
+
+	response, err := listCronExecutions(ctx, *d.client, data.EnvironmentId.ValueString(), data.ServiceId.ValueString(), int(limit))
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read cron executions, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "read cron executions")
+
+	executions := make([]CronExecutionsDataSourceExecutionModel, 0, len(response.DeploymentInstanceExecutions.Edges))
+
+	for _, edge := range response.DeploymentInstanceExecutions.Edges {
+		execution := CronExecutionsDataSourceExecutionModel{
+			Id:           types.StringValue(edge.Node.Id),
+			DeploymentId: types.StringValue(edge.Node.DeploymentId),
+			Status:       types.StringValue(string(edge.Node.Status)),
+			Succeeded:    types.BoolNull(),
+			CreatedAt:    types.StringValue(edge.Node.CreatedAt.Format(time.RFC3339)),
+			CompletedAt:  types.StringNull(),
+		}
+
+		// Runs which exit with a non zero code are marked as crashed
+		switch edge.Node.Status {
+		case DeploymentInstanceStatusExited:
+			execution.Succeeded = types.BoolValue(true)
+		case DeploymentInstanceStatusCrashed:
+			execution.Succeeded = types.BoolValue(false)
+		}
+
+		if !edge.Node.CompletedAt.IsZero() {
+			execution.CompletedAt = types.StringValue(edge.Node.CompletedAt.Format(time.RFC3339))
+		}
+
+		executions = append(executions, execution)
+	}
+
+	list, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: cronExecutionAttrTypes}, executions)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Executions = list
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,36 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCronExecutionsDataSourceDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccCronExecutionsDataSourceConfigDefault(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.railway_cron_executions.test", "environment_id", "d0519b29-5d12-4857-a5dd-76fa7418336c"),
+					resource.TestCheckResourceAttr("data.railway_cron_executions.test", "service_id", "39da7e07-fa3a-42fd-b695-d229319f2993"),
+					resource.TestCheckResourceAttr("data.railway_cron_executions.test", "limit", "5"),
+					resource.TestCheckResourceAttrSet("data.railway_cron_executions.test", "executions.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCronExecutionsDataSourceConfigDefault() string {
+	return `
+data "railway_cron_executions" "test" {
+  environment_id = "d0519b29-5d12-4857-a5dd-76fa7418336c"
+  service_id = "39da7e07-fa3a-42fd-b695-d229319f2993"
+  limit = 5
+}
+`
+}
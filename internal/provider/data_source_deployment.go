@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &DeploymentDataSource{}
+
+func NewDeploymentDataSource() datasource.DataSource {
+	return &DeploymentDataSource{}
+}
+
+type DeploymentDataSource struct {
+	client *graphql.Client
+}
+
+type DeploymentDataSourceModel struct {
+	EnvironmentId types.String `tfsdk:"environment_id"`
+	ServiceId     types.String `tfsdk:"service_id"`
+	Id            types.String `tfsdk:"id"`
+	Status        types.String `tfsdk:"status"`
+	CreatedAt     types.String `tfsdk:"created_at"`
+	CommitSha     types.String `tfsdk:"commit_sha"`
+	CommitMessage types.String `tfsdk:"commit_message"`
+	CanRollback   types.Bool   `tfsdk:"can_rollback"`
+	StaticUrl     types.String `tfsdk:"static_url"`
+}
+
+func (d *DeploymentDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deployment"
+}
+
+func (d *DeploymentDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := deploymentDataSourceAttributes()
+
+	attributes["environment_id"] = schema.StringAttribute{
+		MarkdownDescription: "Identifier of the environment the deployment belongs to.",
+		Required:            true,
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(uuidRegex(), "must be an id"),
+		},
+	}
+
+	attributes["service_id"] = schema.StringAttribute{
+		MarkdownDescription: "Identifier of the service the deployment belongs to.",
+		Required:            true,
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(uuidRegex(), "must be an id"),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Railway deployment. Finds the latest successful deployment of a service in an environment.",
+		Attributes:          attributes,
+	}
+}
+
+func (d *DeploymentDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *DeploymentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *DeploymentDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deployments, err := listServiceDeployments(ctx, *d.client, data.EnvironmentId.ValueString(), data.ServiceId.ValueString(), []DeploymentStatus{DeploymentStatusSuccess}, 1)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read deployments, got error: %s", err))
+		return
+	}
+
+	if len(deployments) == 0 {
+		resp.Diagnostics.AddError("Client Error", "Unable to find a successful deployment")
+		return
+	}
+
+	tflog.Trace(ctx, "read a deployment")
+
+	model := buildDeploymentDataSourceModel(&deployments[0])
+
+	data.Id = model.Id
+	data.Status = model.Status
+	data.CreatedAt = model.CreatedAt
+	data.CommitSha = model.CommitSha
+	data.CommitMessage = model.CommitMessage
+	data.CanRollback = model.CanRollback
+	data.StaticUrl = model.StaticUrl
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
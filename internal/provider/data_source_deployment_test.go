@@ -0,0 +1,35 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDeploymentDataSourceDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccDeploymentDataSourceConfigDefault(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("data.railway_deployment.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("data.railway_deployment.test", "status", "SUCCESS"),
+					resource.TestCheckResourceAttrSet("data.railway_deployment.test", "created_at"),
+					resource.TestCheckResourceAttrSet("data.railway_deployment.test", "can_rollback"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDeploymentDataSourceConfigDefault() string {
+	return `
+data "railway_deployment" "test" {
+  environment_id = "d0519b29-5d12-4857-a5dd-76fa7418336c"
+  service_id = "39da7e07-fa3a-42fd-b695-d229319f2993"
+}
+`
+}
@@ -0,0 +1,287 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const defaultDeploymentsLimit = 10
+
+var _ datasource.DataSource = &DeploymentsDataSource{}
+
+func NewDeploymentsDataSource() datasource.DataSource {
+	return &DeploymentsDataSource{}
+}
+
+type DeploymentsDataSource struct {
+	client *graphql.Client
+}
+
+type DeploymentsDataSourceModel struct {
+	EnvironmentId types.String `tfsdk:"environment_id"`
+	ServiceId     types.String `tfsdk:"service_id"`
+	Statuses      types.Set    `tfsdk:"statuses"`
+	Limit         types.Int64  `tfsdk:"limit"`
+	Deployments   types.List   `tfsdk:"deployments"`
+}
+
+type DeploymentDataSourceDeploymentModel struct {
+	Id            types.String `tfsdk:"id"`
+	Status        types.String `tfsdk:"status"`
+	CreatedAt     types.String `tfsdk:"created_at"`
+	CommitSha     types.String `tfsdk:"commit_sha"`
+	CommitMessage types.String `tfsdk:"commit_message"`
+	CanRollback   types.Bool   `tfsdk:"can_rollback"`
+	StaticUrl     types.String `tfsdk:"static_url"`
+}
+
+var deploymentAttrTypes = map[string]attr.Type{
+	"id":             types.StringType,
+	"status":         types.StringType,
+	"created_at":     types.StringType,
+	"commit_sha":     types.StringType,
+	"commit_message": types.StringType,
+	"can_rollback":   types.BoolType,
+	"static_url":     types.StringType,
+}
+
+var deploymentStatuses = []string{
+	string(DeploymentStatusBuilding),
+	string(DeploymentStatusCrashed),
+	string(DeploymentStatusDeploying),
+	string(DeploymentStatusFailed),
+	string(DeploymentStatusInitializing),
+	string(DeploymentStatusNeedsApproval),
+	string(DeploymentStatusQueued),
+	string(DeploymentStatusRemoved),
+	string(DeploymentStatusRemoving),
+	string(DeploymentStatusSkipped),
+	string(DeploymentStatusSleeping),
+	string(DeploymentStatusSuccess),
+	string(DeploymentStatusWaiting),
+}
+
+func deploymentDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Identifier of the deployment.",
+			Computed:            true,
+		},
+		"status": schema.StringAttribute{
+			MarkdownDescription: "Status of the deployment.",
+			Computed:            true,
+		},
+		"created_at": schema.StringAttribute{
+			MarkdownDescription: "Creation time of the deployment in RFC 3339 format.",
+			Computed:            true,
+		},
+		"commit_sha": schema.StringAttribute{
+			MarkdownDescription: "SHA of the deployed commit.",
+			Computed:            true,
+		},
+		"commit_message": schema.StringAttribute{
+			MarkdownDescription: "Message of the deployed commit.",
+			Computed:            true,
+		},
+		"can_rollback": schema.BoolAttribute{
+			MarkdownDescription: "Whether the service can be rolled back to the deployment.",
+			Computed:            true,
+		},
+		"static_url": schema.StringAttribute{
+			MarkdownDescription: "Static URL of the deployment, including the scheme.",
+			Computed:            true,
+		},
+	}
+}
+
+func (d *DeploymentsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deployments"
+}
+
+func (d *DeploymentsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Railway deployments. Lists the most recent deployments of a service in an environment.",
+		Attributes: map[string]schema.Attribute{
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the environment the deployments belong to.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an id"),
+				},
+			},
+			"service_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the service the deployments belong to.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an id"),
+				},
+			},
+			"statuses": schema.SetAttribute{
+				MarkdownDescription: "Statuses to filter the deployments by.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(deploymentStatuses...)),
+				},
+			},
+			"limit": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of deployments to list. **Default** `10`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+					int64validator.AtMost(100),
+				},
+			},
+			"deployments": schema.ListNestedAttribute{
+				MarkdownDescription: "Deployments, most recent first.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: deploymentDataSourceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *DeploymentsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *DeploymentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *DeploymentsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var statusNames []string
+
+	if !data.Statuses.IsNull() {
+		resp.Diagnostics.Append(data.Statuses.ElementsAs(ctx, &statusNames, false)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	statuses := make([]DeploymentStatus, 0, len(statusNames))
+
+	for _, status := range statusNames {
+		statuses = append(statuses, DeploymentStatus(status))
+	}
+
+	limit := int64(defaultDeploymentsLimit)
+
+	if !data.Limit.IsNull() {
+		limit = data.Limit.ValueInt64()
+	}
+
+	deployments, err := listServiceDeployments(ctx, *d.client, data.EnvironmentId.ValueString(), data.ServiceId.ValueString(), statuses, int(limit))
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read deployments, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "read deployments")
+
+	models := make([]DeploymentDataSourceDeploymentModel, 0, len(deployments))
+
+	for _, deployment := range deployments {
+		models = append(models, buildDeploymentDataSourceModel(&deployment))
+	}
+
+	list, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: deploymentAttrTypes}, models)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Deployments = list
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func listServiceDeployments(ctx context.Context, client graphql.Client, environmentId string, serviceId string, statuses []DeploymentStatus, limit int) ([]Deployment, error) {
+	input := DeploymentListInput{
+		EnvironmentId: &environmentId,
+		ServiceId:     &serviceId,
+	}
+
+	if len(statuses) > 0 {
+		input.Status = &DeploymentStatusInput{In: statuses}
+	}
+
+	response, err := listDeployments(ctx, client, input, limit)
+
+	if err != nil {
+		return nil, err
+	}
+
+	deployments := make([]Deployment, 0, len(response.Deployments.Edges))
+
+	for _, edge := range response.Deployments.Edges {
+		deployments = append(deployments, edge.Node.Deployment)
+	}
+
+	return deployments, nil
+}
+
+func buildDeploymentDataSourceModel(deployment *Deployment) DeploymentDataSourceDeploymentModel {
+	model := DeploymentDataSourceDeploymentModel{
+		Id:            types.StringValue(deployment.Id),
+		Status:        types.StringValue(string(deployment.Status)),
+		CreatedAt:     types.StringValue(deployment.CreatedAt.Format(time.RFC3339)),
+		CommitSha:     types.StringNull(),
+		CommitMessage: types.StringNull(),
+		CanRollback:   types.BoolValue(deployment.CanRollback),
+		StaticUrl:     types.StringNull(),
+	}
+
+	if commitHash, ok := deployment.Meta["commitHash"].(string); ok && commitHash != "" {
+		model.CommitSha = types.StringValue(commitHash)
+	}
+
+	if commitMessage, ok := deployment.Meta["commitMessage"].(string); ok && commitMessage != "" {
+		model.CommitMessage = types.StringValue(commitMessage)
+	}
+
+	if deployment.StaticUrl != "" {
+		model.StaticUrl = types.StringValue("https://" + deployment.StaticUrl)
+	}
+
+	return model
+}
@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDeploymentsDataSourceDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccDeploymentsDataSourceConfigDefault(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.railway_deployments.test", "environment_id", "d0519b29-5d12-4857-a5dd-76fa7418336c"),
+					resource.TestCheckResourceAttr("data.railway_deployments.test", "service_id", "39da7e07-fa3a-42fd-b695-d229319f2993"),
+					resource.TestCheckResourceAttr("data.railway_deployments.test", "deployments.#", "1"),
+					resource.TestMatchResourceAttr("data.railway_deployments.test", "deployments.0.id", uuidRegex()),
+					resource.TestCheckResourceAttr("data.railway_deployments.test", "deployments.0.status", "SUCCESS"),
+					resource.TestCheckResourceAttrSet("data.railway_deployments.test", "deployments.0.created_at"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDeploymentsDataSourceConfigDefault() string {
+	return `
+data "railway_deployments" "test" {
+  environment_id = "d0519b29-5d12-4857-a5dd-76fa7418336c"
+  service_id = "39da7e07-fa3a-42fd-b695-d229319f2993"
+  statuses = ["SUCCESS"]
+  limit = 1
+}
+`
+}
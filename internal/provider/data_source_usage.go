@@ -0,0 +1,245 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var usageMeasurements = []MetricMeasurement{
+	MetricMeasurementCpuUsage,
+	MetricMeasurementMemoryUsageGb,
+	MetricMeasurementNetworkRxGb,
+	MetricMeasurementNetworkTxGb,
+	MetricMeasurementDiskUsageGb,
+}
+
+var _ datasource.DataSource = &UsageDataSource{}
+
+func NewUsageDataSource() datasource.DataSource {
+	return &UsageDataSource{}
+}
+
+type UsageDataSource struct {
+	client *graphql.Client
+}
+
+type UsageDataSourceModel struct {
+	ProjectId     types.String  `tfsdk:"project_id"`
+	WorkspaceId   types.String  `tfsdk:"workspace_id"`
+	Current       types.Object  `tfsdk:"current"`
+	Estimated     types.Object  `tfsdk:"estimated"`
+	CurrentCost   types.Float64 `tfsdk:"current_cost"`
+	EstimatedCost types.Float64 `tfsdk:"estimated_cost"`
+}
+
+var usageAttrTypes = map[string]attr.Type{
+	"cpu":        types.Float64Type,
+	"memory":     types.Float64Type,
+	"network_rx": types.Float64Type,
+	"network_tx": types.Float64Type,
+	"disk":       types.Float64Type,
+}
+
+func usageDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"cpu": schema.Float64Attribute{
+			MarkdownDescription: "CPU usage in vCPU minutes.",
+			Computed:            true,
+		},
+		"memory": schema.Float64Attribute{
+			MarkdownDescription: "Memory usage in GB minutes.",
+			Computed:            true,
+		},
+		"network_rx": schema.Float64Attribute{
+			MarkdownDescription: "Network ingress in GB.",
+			Computed:            true,
+		},
+		"network_tx": schema.Float64Attribute{
+			MarkdownDescription: "Network egress in GB.",
+			Computed:            true,
+		},
+		"disk": schema.Float64Attribute{
+			MarkdownDescription: "Volume usage in GB minutes.",
+			Computed:            true,
+		},
+	}
+}
+
+func (d *UsageDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_usage"
+}
+
+func (d *UsageDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Railway usage. Returns the usage of the current billing period and its estimate at the end of the period for a project, a workspace or the current user.",
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the project to get the usage of. Conflicts with `workspace_id`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an id"),
+					stringvalidator.ConflictsWith(path.MatchRoot("workspace_id")),
+				},
+			},
+			"workspace_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the workspace to get the usage of. Conflicts with `project_id`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an id"),
+				},
+			},
+			"current": schema.SingleNestedAttribute{
+				MarkdownDescription: "Usage so far in the current billing period.",
+				Computed:            true,
+				Attributes:          usageDataSourceAttributes(),
+			},
+			"estimated": schema.SingleNestedAttribute{
+				MarkdownDescription: "Estimated usage at the end of the current billing period.",
+				Computed:            true,
+				Attributes:          usageDataSourceAttributes(),
+			},
+			"current_cost": schema.Float64Attribute{
+				MarkdownDescription: "Cost in dollars of the current billing period as reported by Railway, which may be cached. Only available for a workspace, Railway does not report costs per project.",
+				Computed:            true,
+			},
+			"estimated_cost": schema.Float64Attribute{
+				MarkdownDescription: "Estimated cost in dollars at the end of the current billing period, projected from `current_cost` over the elapsed part of the period. Only available for a workspace.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *UsageDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *UsageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *UsageDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectId := data.ProjectId.ValueStringPointer()
+	workspaceId := data.WorkspaceId.ValueStringPointer()
+
+	usage, err := getUsage(ctx, *d.client, usageMeasurements, projectId, workspaceId)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read usage, got error: %s", err))
+		return
+	}
+
+	estimatedUsage, err := getEstimatedUsage(ctx, *d.client, usageMeasurements, projectId, workspaceId)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read estimated usage, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "read usage")
+
+	current := make(map[MetricMeasurement]float64)
+
+	for _, value := range usage.Usage {
+		current[value.Measurement] += value.Value
+	}
+
+	// Estimates are returned per project, so they are summed for workspaces
+	estimated := make(map[MetricMeasurement]float64)
+
+	for _, value := range estimatedUsage.EstimatedUsage {
+		estimated[value.Measurement] += value.EstimatedValue
+	}
+
+	currentObject, diags := buildUsage(current)
+	resp.Diagnostics.Append(diags...)
+
+	estimatedObject, diags := buildUsage(estimated)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Current = currentObject
+	data.Estimated = estimatedObject
+	data.CurrentCost = types.Float64Null()
+	data.EstimatedCost = types.Float64Null()
+
+	// Railway only reports costs for the billing customer of a workspace
+	if workspaceId != nil {
+		response, err := getWorkspaceCurrentUsage(ctx, *d.client, *workspaceId)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read workspace usage, got error: %s", err))
+			return
+		}
+
+		customer := response.Workspace.Customer
+
+		data.CurrentCost = types.Float64Value(customer.CurrentUsage)
+		data.EstimatedCost = types.Float64Value(estimateCost(customer.CurrentUsage, customer.BillingPeriod.Start, customer.BillingPeriod.End, time.Now()))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func buildUsage(values map[MetricMeasurement]float64) (types.Object, diag.Diagnostics) {
+	cpu := values[MetricMeasurementCpuUsage]
+	memory := values[MetricMeasurementMemoryUsageGb]
+	networkRx := values[MetricMeasurementNetworkRxGb]
+	networkTx := values[MetricMeasurementNetworkTxGb]
+	disk := values[MetricMeasurementDiskUsageGb]
+
+	return types.ObjectValue(usageAttrTypes, map[string]attr.Value{
+		"cpu":        types.Float64Value(cpu),
+		"memory":     types.Float64Value(memory),
+		"network_rx": types.Float64Value(networkRx),
+		"network_tx": types.Float64Value(networkTx),
+		"disk":       types.Float64Value(disk),
+	})
+}
+
+// estimateCost projects the cost so far in a billing period to the end of the period.
+func estimateCost(current float64, start time.Time, end time.Time, now time.Time) float64 {
+	elapsed := now.Sub(start)
+	period := end.Sub(start)
+
+	if elapsed <= 0 || period <= 0 || elapsed >= period {
+		return current
+	}
+
+	return current * float64(period) / float64(elapsed)
+}
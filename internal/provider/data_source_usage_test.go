@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUsageDataSourceDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccUsageDataSourceConfigDefault(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.railway_usage.test", "project_id", "0bb01547-570d-4109-a5e8-138691f6a2d1"),
+					resource.TestCheckResourceAttrSet("data.railway_usage.test", "current.cpu"),
+					resource.TestCheckResourceAttrSet("data.railway_usage.test", "estimated.cpu"),
+					resource.TestCheckNoResourceAttr("data.railway_usage.test", "current_cost"),
+					resource.TestCheckNoResourceAttr("data.railway_usage.test", "estimated_cost"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccUsageDataSourceWorkspace(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccUsageDataSourceConfigWorkspace(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.railway_usage.test", "workspace_id", "ecb63be7-63fb-47fe-95fc-1585d24e172d"),
+					resource.TestCheckResourceAttrSet("data.railway_usage.test", "current.cpu"),
+					resource.TestCheckResourceAttrSet("data.railway_usage.test", "current_cost"),
+					resource.TestCheckResourceAttrSet("data.railway_usage.test", "estimated_cost"),
+				),
+			},
+		},
+	})
+}
+
+func TestEstimateCost(t *testing.T) {
+	start := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2026, 10, 31, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		now  time.Time
+		want float64
+	}{
+		{time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC), 20},
+		{time.Date(2026, 10, 7, 0, 0, 0, 0, time.UTC), 50},
+		{start, 10},
+		{end, 10},
+		{time.Date(2026, 11, 5, 0, 0, 0, 0, time.UTC), 10},
+	}
+
+	for _, test := range tests {
+		if got := estimateCost(10, start, end, test.now); got != test.want {
+			t.Errorf("estimateCost(10, %s) = %v, want %v", test.now, got, test.want)
+		}
+	}
+}
+
+func testAccUsageDataSourceConfigDefault() string {
+	return `
+data "railway_usage" "test" {
+  project_id = "0bb01547-570d-4109-a5e8-138691f6a2d1"
+}
+`
+}
+
+func testAccUsageDataSourceConfigWorkspace() string {
+	return `
+data "railway_usage" "test" {
+  workspace_id = "ecb63be7-63fb-47fe-95fc-1585d24e172d"
+}
+`
+}
@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var dotenvNameRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_.-]*$`)
+
+// parseDotenv parses dotenv formatted content into variables. It supports comments, `export`
+// prefixes, single quoted literal values and double quoted values with escapes which can span
+// multiple lines.
+func parseDotenv(content string) (map[string]string, error) {
+	variables := make(map[string]string)
+	lines := strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n")
+
+	for i := 0; i < len(lines); i++ {
+		line := strings.TrimSpace(lines[i])
+
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		line = strings.TrimPrefix(line, "export ")
+
+		name, rest, found := strings.Cut(line, "=")
+
+		if !found {
+			return nil, fmt.Errorf("line %d: expected NAME=value", i+1)
+		}
+
+		name = strings.TrimSpace(name)
+
+		if !dotenvNameRegex.MatchString(name) {
+			return nil, fmt.Errorf("line %d: invalid variable name %q", i+1, name)
+		}
+
+		rest = strings.TrimSpace(rest)
+
+		if rest == "" || (rest[0] != '"' && rest[0] != '\'') {
+			// Unquoted values end at an inline comment
+			if index := strings.Index(rest, " #"); index >= 0 {
+				rest = rest[:index]
+			}
+
+			variables[name] = strings.TrimSpace(rest)
+			continue
+		}
+
+		quote := rest[0]
+		value := rest[1:]
+		start := i
+
+		// Quoted values continue on the following lines until the closing quote
+		for !hasClosingQuote(value, quote) {
+			i++
+
+			if i >= len(lines) {
+				return nil, fmt.Errorf("line %d: unterminated quoted value", start+1)
+			}
+
+			value += "\n" + lines[i]
+		}
+
+		value = value[:closingQuoteIndex(value, quote)]
+
+		if quote == '"' {
+			value = unescapeDotenvValue(value)
+		}
+
+		variables[name] = value
+	}
+
+	return variables, nil
+}
+
+func closingQuoteIndex(value string, quote byte) int {
+	for i := 0; i < len(value); i++ {
+		if quote == '"' && value[i] == '\\' {
+			i++
+			continue
+		}
+
+		if value[i] == quote {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func hasClosingQuote(value string, quote byte) bool {
+	return closingQuoteIndex(value, quote) >= 0
+}
+
+func unescapeDotenvValue(value string) string {
+	replacer := strings.NewReplacer(`\n`, "\n", `\r`, "\r", `\t`, "\t", `\"`, `"`, `\\`, `\`)
+
+	return replacer.Replace(value)
+}
@@ -10,6 +10,108 @@ import (
 	"github.com/Khan/genqlient/graphql"
 )
 
+// ApiToken includes the GraphQL fields of ApiToken requested by the fragment ApiToken.
+type ApiToken struct {
+	Id           string `json:"id"`
+	Name         string `json:"name"`
+	DisplayToken string `json:"displayToken"`
+	WorkspaceId  string `json:"workspaceId"`
+}
+
+// GetId returns ApiToken.Id, and is useful for accessing the field via an interface.
+func (v *ApiToken) GetId() string { return v.Id }
+
+// GetName returns ApiToken.Name, and is useful for accessing the field via an interface.
+func (v *ApiToken) GetName() string { return v.Name }
+
+// GetDisplayToken returns ApiToken.DisplayToken, and is useful for accessing the field via an interface.
+func (v *ApiToken) GetDisplayToken() string { return v.DisplayToken }
+
+// GetWorkspaceId returns ApiToken.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *ApiToken) GetWorkspaceId() string { return v.WorkspaceId }
+
+type ApiTokenCreateInput struct {
+	Name        string  `json:"name"`
+	WorkspaceId *string `json:"workspaceId,omitempty"`
+}
+
+// GetName returns ApiTokenCreateInput.Name, and is useful for accessing the field via an interface.
+func (v *ApiTokenCreateInput) GetName() string { return v.Name }
+
+// GetWorkspaceId returns ApiTokenCreateInput.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *ApiTokenCreateInput) GetWorkspaceId() *string { return v.WorkspaceId }
+
+// Bucket includes the GraphQL fields of Bucket requested by the fragment Bucket.
+type Bucket struct {
+	Id        string `json:"id"`
+	Name      string `json:"name"`
+	ProjectId string `json:"projectId"`
+}
+
+// GetId returns Bucket.Id, and is useful for accessing the field via an interface.
+func (v *Bucket) GetId() string { return v.Id }
+
+// GetName returns Bucket.Name, and is useful for accessing the field via an interface.
+func (v *Bucket) GetName() string { return v.Name }
+
+// GetProjectId returns Bucket.ProjectId, and is useful for accessing the field via an interface.
+func (v *Bucket) GetProjectId() string { return v.ProjectId }
+
+type BucketCreateInput struct {
+	// [unimplemented] The environment to deploy the bucket instances into. If
+	// `null`, the bucket will not be deployed to any environment. `undefined` will
+	// deploy to all environments.
+	EnvironmentId *string `json:"environmentId,omitempty"`
+	// The name of the bucket
+	Name *string `json:"name,omitempty"`
+	// The project to create the bucket in
+	ProjectId string `json:"projectId"`
+}
+
+// GetEnvironmentId returns BucketCreateInput.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *BucketCreateInput) GetEnvironmentId() *string { return v.EnvironmentId }
+
+// GetName returns BucketCreateInput.Name, and is useful for accessing the field via an interface.
+func (v *BucketCreateInput) GetName() *string { return v.Name }
+
+// GetProjectId returns BucketCreateInput.ProjectId, and is useful for accessing the field via an interface.
+func (v *BucketCreateInput) GetProjectId() string { return v.ProjectId }
+
+// BucketS3CompatibleCredentials includes the GraphQL fields of BucketS3CompatibleCredentials requested by the fragment BucketS3CompatibleCredentials.
+type BucketS3CompatibleCredentials struct {
+	AccessKeyId     string `json:"accessKeyId"`
+	SecretAccessKey string `json:"secretAccessKey"`
+	BucketName      string `json:"bucketName"`
+	Endpoint        string `json:"endpoint"`
+	Region          string `json:"region"`
+	UrlStyle        string `json:"urlStyle"`
+}
+
+// GetAccessKeyId returns BucketS3CompatibleCredentials.AccessKeyId, and is useful for accessing the field via an interface.
+func (v *BucketS3CompatibleCredentials) GetAccessKeyId() string { return v.AccessKeyId }
+
+// GetSecretAccessKey returns BucketS3CompatibleCredentials.SecretAccessKey, and is useful for accessing the field via an interface.
+func (v *BucketS3CompatibleCredentials) GetSecretAccessKey() string { return v.SecretAccessKey }
+
+// GetBucketName returns BucketS3CompatibleCredentials.BucketName, and is useful for accessing the field via an interface.
+func (v *BucketS3CompatibleCredentials) GetBucketName() string { return v.BucketName }
+
+// GetEndpoint returns BucketS3CompatibleCredentials.Endpoint, and is useful for accessing the field via an interface.
+func (v *BucketS3CompatibleCredentials) GetEndpoint() string { return v.Endpoint }
+
+// GetRegion returns BucketS3CompatibleCredentials.Region, and is useful for accessing the field via an interface.
+func (v *BucketS3CompatibleCredentials) GetRegion() string { return v.Region }
+
+// GetUrlStyle returns BucketS3CompatibleCredentials.UrlStyle, and is useful for accessing the field via an interface.
+func (v *BucketS3CompatibleCredentials) GetUrlStyle() string { return v.UrlStyle }
+
+type BucketUpdateInput struct {
+	Name string `json:"name"`
+}
+
+// GetName returns BucketUpdateInput.Name, and is useful for accessing the field via an interface.
+func (v *BucketUpdateInput) GetName() string { return v.Name }
+
 type Builder string
 
 const (
@@ -19,6 +121,17 @@ const (
 	BuilderRailpack Builder = "RAILPACK"
 )
 
+type CertificateStatus string
+
+const (
+	CertificateStatusCertificateStatusTypeIssueFailed         CertificateStatus = "CERTIFICATE_STATUS_TYPE_ISSUE_FAILED"
+	CertificateStatusCertificateStatusTypeIssuing             CertificateStatus = "CERTIFICATE_STATUS_TYPE_ISSUING"
+	CertificateStatusCertificateStatusTypeUnspecified         CertificateStatus = "CERTIFICATE_STATUS_TYPE_UNSPECIFIED"
+	CertificateStatusCertificateStatusTypeValid               CertificateStatus = "CERTIFICATE_STATUS_TYPE_VALID"
+	CertificateStatusCertificateStatusTypeValidatingOwnership CertificateStatus = "CERTIFICATE_STATUS_TYPE_VALIDATING_OWNERSHIP"
+	CertificateStatusUnrecognized                             CertificateStatus = "UNRECOGNIZED"
+)
+
 // CustomDomain includes the GraphQL fields of CustomDomain requested by the fragment CustomDomain.
 type CustomDomain struct {
 	Id            string             `json:"id"`
@@ -75,6 +188,11 @@ type CustomDomainStatus struct {
 	DnsRecords          []CustomDomainStatusDnsRecordsDNSRecords `json:"dnsRecords"`
 	VerificationDnsHost string                                   `json:"verificationDnsHost"`
 	VerificationToken   string                                   `json:"verificationToken"`
+	Verified            bool                                     `json:"verified"`
+	CertificateStatus   CertificateStatus                        `json:"certificateStatus"`
+	// Human-readable error message when certificate issuance fails
+	CertificateErrorMessage string                                                `json:"certificateErrorMessage"`
+	Certificates            []CustomDomainStatusCertificatesCertificatePublicData `json:"certificates"`
 }
 
 // GetDnsRecords returns CustomDomainStatus.DnsRecords, and is useful for accessing the field via an interface.
@@ -88,22 +206,235 @@ func (v *CustomDomainStatus) GetVerificationDnsHost() string { return v.Verifica
 // GetVerificationToken returns CustomDomainStatus.VerificationToken, and is useful for accessing the field via an interface.
 func (v *CustomDomainStatus) GetVerificationToken() string { return v.VerificationToken }
 
+// GetVerified returns CustomDomainStatus.Verified, and is useful for accessing the field via an interface.
+func (v *CustomDomainStatus) GetVerified() bool { return v.Verified }
+
+// GetCertificateStatus returns CustomDomainStatus.CertificateStatus, and is useful for accessing the field via an interface.
+func (v *CustomDomainStatus) GetCertificateStatus() CertificateStatus { return v.CertificateStatus }
+
+// GetCertificateErrorMessage returns CustomDomainStatus.CertificateErrorMessage, and is useful for accessing the field via an interface.
+func (v *CustomDomainStatus) GetCertificateErrorMessage() string { return v.CertificateErrorMessage }
+
+// GetCertificates returns CustomDomainStatus.Certificates, and is useful for accessing the field via an interface.
+func (v *CustomDomainStatus) GetCertificates() []CustomDomainStatusCertificatesCertificatePublicData {
+	return v.Certificates
+}
+
+// CustomDomainStatusCertificatesCertificatePublicData includes the requested fields of the GraphQL type CertificatePublicData.
+type CustomDomainStatusCertificatesCertificatePublicData struct {
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// GetExpiresAt returns CustomDomainStatusCertificatesCertificatePublicData.ExpiresAt, and is useful for accessing the field via an interface.
+func (v *CustomDomainStatusCertificatesCertificatePublicData) GetExpiresAt() time.Time {
+	return v.ExpiresAt
+}
+
 // CustomDomainStatusDnsRecordsDNSRecords includes the requested fields of the GraphQL type DNSRecords.
 type CustomDomainStatusDnsRecordsDNSRecords struct {
-	Hostlabel     string `json:"hostlabel"`
-	RequiredValue string `json:"requiredValue"`
-	Zone          string `json:"zone"`
+	Hostlabel     string          `json:"hostlabel"`
+	Fqdn          string          `json:"fqdn"`
+	RecordType    DNSRecordType   `json:"recordType"`
+	RequiredValue string          `json:"requiredValue"`
+	CurrentValue  string          `json:"currentValue"`
+	Status        DNSRecordStatus `json:"status"`
+	Zone          string          `json:"zone"`
 }
 
 // GetHostlabel returns CustomDomainStatusDnsRecordsDNSRecords.Hostlabel, and is useful for accessing the field via an interface.
 func (v *CustomDomainStatusDnsRecordsDNSRecords) GetHostlabel() string { return v.Hostlabel }
 
+// GetFqdn returns CustomDomainStatusDnsRecordsDNSRecords.Fqdn, and is useful for accessing the field via an interface.
+func (v *CustomDomainStatusDnsRecordsDNSRecords) GetFqdn() string { return v.Fqdn }
+
+// GetRecordType returns CustomDomainStatusDnsRecordsDNSRecords.RecordType, and is useful for accessing the field via an interface.
+func (v *CustomDomainStatusDnsRecordsDNSRecords) GetRecordType() DNSRecordType { return v.RecordType }
+
 // GetRequiredValue returns CustomDomainStatusDnsRecordsDNSRecords.RequiredValue, and is useful for accessing the field via an interface.
 func (v *CustomDomainStatusDnsRecordsDNSRecords) GetRequiredValue() string { return v.RequiredValue }
 
+// GetCurrentValue returns CustomDomainStatusDnsRecordsDNSRecords.CurrentValue, and is useful for accessing the field via an interface.
+func (v *CustomDomainStatusDnsRecordsDNSRecords) GetCurrentValue() string { return v.CurrentValue }
+
+// GetStatus returns CustomDomainStatusDnsRecordsDNSRecords.Status, and is useful for accessing the field via an interface.
+func (v *CustomDomainStatusDnsRecordsDNSRecords) GetStatus() DNSRecordStatus { return v.Status }
+
 // GetZone returns CustomDomainStatusDnsRecordsDNSRecords.Zone, and is useful for accessing the field via an interface.
 func (v *CustomDomainStatusDnsRecordsDNSRecords) GetZone() string { return v.Zone }
 
+type DNSRecordStatus string
+
+const (
+	DNSRecordStatusDnsRecordStatusPropagated     DNSRecordStatus = "DNS_RECORD_STATUS_PROPAGATED"
+	DNSRecordStatusDnsRecordStatusRequiresUpdate DNSRecordStatus = "DNS_RECORD_STATUS_REQUIRES_UPDATE"
+	DNSRecordStatusDnsRecordStatusUnspecified    DNSRecordStatus = "DNS_RECORD_STATUS_UNSPECIFIED"
+	DNSRecordStatusUnrecognized                  DNSRecordStatus = "UNRECOGNIZED"
+)
+
+type DNSRecordType string
+
+const (
+	DNSRecordTypeDnsRecordTypeA           DNSRecordType = "DNS_RECORD_TYPE_A"
+	DNSRecordTypeDnsRecordTypeCname       DNSRecordType = "DNS_RECORD_TYPE_CNAME"
+	DNSRecordTypeDnsRecordTypeNs          DNSRecordType = "DNS_RECORD_TYPE_NS"
+	DNSRecordTypeDnsRecordTypeTxt         DNSRecordType = "DNS_RECORD_TYPE_TXT"
+	DNSRecordTypeDnsRecordTypeUnspecified DNSRecordType = "DNS_RECORD_TYPE_UNSPECIFIED"
+	DNSRecordTypeUnrecognized             DNSRecordType = "UNRECOGNIZED"
+)
+
+// Deployment includes the GraphQL fields of Deployment requested by the fragment Deployment.
+type Deployment struct {
+	Id            string                 `json:"id"`
+	Status        DeploymentStatus       `json:"status"`
+	Url           string                 `json:"url"`
+	StaticUrl     string                 `json:"staticUrl"`
+	CanRollback   bool                   `json:"canRollback"`
+	CreatedAt     time.Time              `json:"createdAt"`
+	SnapshotId    string                 `json:"snapshotId"`
+	Meta          map[string]interface{} `json:"meta"`
+	ProjectId     string                 `json:"projectId"`
+	EnvironmentId string                 `json:"environmentId"`
+	ServiceId     string                 `json:"serviceId"`
+}
+
+// GetId returns Deployment.Id, and is useful for accessing the field via an interface.
+func (v *Deployment) GetId() string { return v.Id }
+
+// GetStatus returns Deployment.Status, and is useful for accessing the field via an interface.
+func (v *Deployment) GetStatus() DeploymentStatus { return v.Status }
+
+// GetUrl returns Deployment.Url, and is useful for accessing the field via an interface.
+func (v *Deployment) GetUrl() string { return v.Url }
+
+// GetStaticUrl returns Deployment.StaticUrl, and is useful for accessing the field via an interface.
+func (v *Deployment) GetStaticUrl() string { return v.StaticUrl }
+
+// GetCanRollback returns Deployment.CanRollback, and is useful for accessing the field via an interface.
+func (v *Deployment) GetCanRollback() bool { return v.CanRollback }
+
+// GetCreatedAt returns Deployment.CreatedAt, and is useful for accessing the field via an interface.
+func (v *Deployment) GetCreatedAt() time.Time { return v.CreatedAt }
+
+// GetSnapshotId returns Deployment.SnapshotId, and is useful for accessing the field via an interface.
+func (v *Deployment) GetSnapshotId() string { return v.SnapshotId }
+
+// GetMeta returns Deployment.Meta, and is useful for accessing the field via an interface.
+func (v *Deployment) GetMeta() map[string]interface{} { return v.Meta }
+
+// GetProjectId returns Deployment.ProjectId, and is useful for accessing the field via an interface.
+func (v *Deployment) GetProjectId() string { return v.ProjectId }
+
+// GetEnvironmentId returns Deployment.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *Deployment) GetEnvironmentId() string { return v.EnvironmentId }
+
+// GetServiceId returns Deployment.ServiceId, and is useful for accessing the field via an interface.
+func (v *Deployment) GetServiceId() string { return v.ServiceId }
+
+type DeploymentInstanceStatus string
+
+const (
+	DeploymentInstanceStatusCrashed      DeploymentInstanceStatus = "CRASHED"
+	DeploymentInstanceStatusCreated      DeploymentInstanceStatus = "CREATED"
+	DeploymentInstanceStatusExited       DeploymentInstanceStatus = "EXITED"
+	DeploymentInstanceStatusInitializing DeploymentInstanceStatus = "INITIALIZING"
+	DeploymentInstanceStatusRemoved      DeploymentInstanceStatus = "REMOVED"
+	DeploymentInstanceStatusRemoving     DeploymentInstanceStatus = "REMOVING"
+	DeploymentInstanceStatusRestarting   DeploymentInstanceStatus = "RESTARTING"
+	DeploymentInstanceStatusRunning      DeploymentInstanceStatus = "RUNNING"
+	DeploymentInstanceStatusSkipped      DeploymentInstanceStatus = "SKIPPED"
+	DeploymentInstanceStatusStopped      DeploymentInstanceStatus = "STOPPED"
+)
+
+type DeploymentListInput struct {
+	EnvironmentId  *string                `json:"environmentId,omitempty"`
+	IncludeDeleted *bool                  `json:"includeDeleted,omitempty"`
+	ProjectId      *string                `json:"projectId,omitempty"`
+	ServiceId      *string                `json:"serviceId,omitempty"`
+	Status         *DeploymentStatusInput `json:"status,omitempty"`
+}
+
+// GetEnvironmentId returns DeploymentListInput.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *DeploymentListInput) GetEnvironmentId() *string { return v.EnvironmentId }
+
+// GetIncludeDeleted returns DeploymentListInput.IncludeDeleted, and is useful for accessing the field via an interface.
+func (v *DeploymentListInput) GetIncludeDeleted() *bool { return v.IncludeDeleted }
+
+// GetProjectId returns DeploymentListInput.ProjectId, and is useful for accessing the field via an interface.
+func (v *DeploymentListInput) GetProjectId() *string { return v.ProjectId }
+
+// GetServiceId returns DeploymentListInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *DeploymentListInput) GetServiceId() *string { return v.ServiceId }
+
+// GetStatus returns DeploymentListInput.Status, and is useful for accessing the field via an interface.
+func (v *DeploymentListInput) GetStatus() *DeploymentStatusInput { return v.Status }
+
+type DeploymentStatus string
+
+const (
+	DeploymentStatusBuilding      DeploymentStatus = "BUILDING"
+	DeploymentStatusCrashed       DeploymentStatus = "CRASHED"
+	DeploymentStatusDeploying     DeploymentStatus = "DEPLOYING"
+	DeploymentStatusFailed        DeploymentStatus = "FAILED"
+	DeploymentStatusInitializing  DeploymentStatus = "INITIALIZING"
+	DeploymentStatusNeedsApproval DeploymentStatus = "NEEDS_APPROVAL"
+	DeploymentStatusQueued        DeploymentStatus = "QUEUED"
+	DeploymentStatusRemoved       DeploymentStatus = "REMOVED"
+	DeploymentStatusRemoving      DeploymentStatus = "REMOVING"
+	DeploymentStatusSkipped       DeploymentStatus = "SKIPPED"
+	DeploymentStatusSleeping      DeploymentStatus = "SLEEPING"
+	DeploymentStatusSuccess       DeploymentStatus = "SUCCESS"
+	DeploymentStatusWaiting       DeploymentStatus = "WAITING"
+)
+
+type DeploymentStatusInput struct {
+	In    []DeploymentStatus `json:"in,omitempty"`
+	NotIn []DeploymentStatus `json:"notIn,omitempty"`
+}
+
+// GetIn returns DeploymentStatusInput.In, and is useful for accessing the field via an interface.
+func (v *DeploymentStatusInput) GetIn() []DeploymentStatus { return v.In }
+
+// GetNotIn returns DeploymentStatusInput.NotIn, and is useful for accessing the field via an interface.
+func (v *DeploymentStatusInput) GetNotIn() []DeploymentStatus { return v.NotIn }
+
+// EgressGateway includes the GraphQL fields of EgressGateway requested by the fragment EgressGateway.
+type EgressGateway struct {
+	Ipv4   string `json:"ipv4"`
+	Region string `json:"region"`
+}
+
+// GetIpv4 returns EgressGateway.Ipv4, and is useful for accessing the field via an interface.
+func (v *EgressGateway) GetIpv4() string { return v.Ipv4 }
+
+// GetRegion returns EgressGateway.Region, and is useful for accessing the field via an interface.
+func (v *EgressGateway) GetRegion() string { return v.Region }
+
+type EgressGatewayCreateInput struct {
+	EnvironmentId string  `json:"environmentId"`
+	Region        *string `json:"region,omitempty"`
+	ServiceId     string  `json:"serviceId"`
+}
+
+// GetEnvironmentId returns EgressGatewayCreateInput.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *EgressGatewayCreateInput) GetEnvironmentId() string { return v.EnvironmentId }
+
+// GetRegion returns EgressGatewayCreateInput.Region, and is useful for accessing the field via an interface.
+func (v *EgressGatewayCreateInput) GetRegion() *string { return v.Region }
+
+// GetServiceId returns EgressGatewayCreateInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *EgressGatewayCreateInput) GetServiceId() string { return v.ServiceId }
+
+type EgressGatewayServiceTargetInput struct {
+	EnvironmentId string `json:"environmentId"`
+	ServiceId     string `json:"serviceId"`
+}
+
+// GetEnvironmentId returns EgressGatewayServiceTargetInput.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *EgressGatewayServiceTargetInput) GetEnvironmentId() string { return v.EnvironmentId }
+
+// GetServiceId returns EgressGatewayServiceTargetInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *EgressGatewayServiceTargetInput) GetServiceId() string { return v.ServiceId }
+
 // Environment includes the GraphQL fields of Environment requested by the fragment Environment.
 type Environment struct {
 	Id        string `json:"id"`
@@ -159,6 +490,357 @@ func (v *EnvironmentCreateInput) GetSourceEnvironmentId() *string { return v.Sou
 // GetStageInitialChanges returns EnvironmentCreateInput.StageInitialChanges, and is useful for accessing the field via an interface.
 func (v *EnvironmentCreateInput) GetStageInitialChanges() bool { return v.StageInitialChanges }
 
+type HerokuImportVariablesInput struct {
+	EnvironmentId string `json:"environmentId"`
+	HerokuAppId   string `json:"herokuAppId"`
+	ProjectId     string `json:"projectId"`
+	ServiceId     string `json:"serviceId"`
+}
+
+// GetEnvironmentId returns HerokuImportVariablesInput.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *HerokuImportVariablesInput) GetEnvironmentId() string { return v.EnvironmentId }
+
+// GetHerokuAppId returns HerokuImportVariablesInput.HerokuAppId, and is useful for accessing the field via an interface.
+func (v *HerokuImportVariablesInput) GetHerokuAppId() string { return v.HerokuAppId }
+
+// GetProjectId returns HerokuImportVariablesInput.ProjectId, and is useful for accessing the field via an interface.
+func (v *HerokuImportVariablesInput) GetProjectId() string { return v.ProjectId }
+
+// GetServiceId returns HerokuImportVariablesInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *HerokuImportVariablesInput) GetServiceId() string { return v.ServiceId }
+
+// Integration includes the GraphQL fields of Integration requested by the fragment Integration.
+type Integration struct {
+	Id        string                 `json:"id"`
+	Name      string                 `json:"name"`
+	ProjectId string                 `json:"projectId"`
+	Config    map[string]interface{} `json:"config"`
+}
+
+// GetId returns Integration.Id, and is useful for accessing the field via an interface.
+func (v *Integration) GetId() string { return v.Id }
+
+// GetName returns Integration.Name, and is useful for accessing the field via an interface.
+func (v *Integration) GetName() string { return v.Name }
+
+// GetProjectId returns Integration.ProjectId, and is useful for accessing the field via an interface.
+func (v *Integration) GetProjectId() string { return v.ProjectId }
+
+// GetConfig returns Integration.Config, and is useful for accessing the field via an interface.
+func (v *Integration) GetConfig() map[string]interface{} { return v.Config }
+
+type IntegrationCreateInput struct {
+	Config            map[string]interface{} `json:"config"`
+	IntegrationAuthId *string                `json:"integrationAuthId,omitempty"`
+	Name              string                 `json:"name"`
+	ProjectId         string                 `json:"projectId"`
+}
+
+// GetConfig returns IntegrationCreateInput.Config, and is useful for accessing the field via an interface.
+func (v *IntegrationCreateInput) GetConfig() map[string]interface{} { return v.Config }
+
+// GetIntegrationAuthId returns IntegrationCreateInput.IntegrationAuthId, and is useful for accessing the field via an interface.
+func (v *IntegrationCreateInput) GetIntegrationAuthId() *string { return v.IntegrationAuthId }
+
+// GetName returns IntegrationCreateInput.Name, and is useful for accessing the field via an interface.
+func (v *IntegrationCreateInput) GetName() string { return v.Name }
+
+// GetProjectId returns IntegrationCreateInput.ProjectId, and is useful for accessing the field via an interface.
+func (v *IntegrationCreateInput) GetProjectId() string { return v.ProjectId }
+
+type IntegrationUpdateInput struct {
+	Config            map[string]interface{} `json:"config"`
+	IntegrationAuthId *string                `json:"integrationAuthId,omitempty"`
+	Name              string                 `json:"name"`
+	ProjectId         string                 `json:"projectId"`
+}
+
+// GetConfig returns IntegrationUpdateInput.Config, and is useful for accessing the field via an interface.
+func (v *IntegrationUpdateInput) GetConfig() map[string]interface{} { return v.Config }
+
+// GetIntegrationAuthId returns IntegrationUpdateInput.IntegrationAuthId, and is useful for accessing the field via an interface.
+func (v *IntegrationUpdateInput) GetIntegrationAuthId() *string { return v.IntegrationAuthId }
+
+// GetName returns IntegrationUpdateInput.Name, and is useful for accessing the field via an interface.
+func (v *IntegrationUpdateInput) GetName() string { return v.Name }
+
+// GetProjectId returns IntegrationUpdateInput.ProjectId, and is useful for accessing the field via an interface.
+func (v *IntegrationUpdateInput) GetProjectId() string { return v.ProjectId }
+
+// A thing that can be measured on Railway.
+type MetricMeasurement string
+
+const (
+	MetricMeasurementBackupUsageGb          MetricMeasurement = "BACKUP_USAGE_GB"
+	MetricMeasurementCpuLimit               MetricMeasurement = "CPU_LIMIT"
+	MetricMeasurementCpuUsage               MetricMeasurement = "CPU_USAGE"
+	MetricMeasurementCpuUsage2              MetricMeasurement = "CPU_USAGE_2"
+	MetricMeasurementDiskUsageGb            MetricMeasurement = "DISK_USAGE_GB"
+	MetricMeasurementEphemeralDiskUsageGb   MetricMeasurement = "EPHEMERAL_DISK_USAGE_GB"
+	MetricMeasurementMeasurementUnspecified MetricMeasurement = "MEASUREMENT_UNSPECIFIED"
+	MetricMeasurementMemoryLimitGb          MetricMeasurement = "MEMORY_LIMIT_GB"
+	MetricMeasurementMemoryUsageGb          MetricMeasurement = "MEMORY_USAGE_GB"
+	MetricMeasurementNetworkRxGb            MetricMeasurement = "NETWORK_RX_GB"
+	MetricMeasurementNetworkTxGb            MetricMeasurement = "NETWORK_TX_GB"
+	MetricMeasurementUnrecognized           MetricMeasurement = "UNRECOGNIZED"
+)
+
+// ObservabilityDashboard includes the GraphQL fields of ObservabilityDashboard requested by the fragment ObservabilityDashboard.
+type ObservabilityDashboard struct {
+	Id    string                                                          `json:"id"`
+	Items []ObservabilityDashboardItemsObservabilityDashboardItemInstance `json:"items"`
+}
+
+// GetId returns ObservabilityDashboard.Id, and is useful for accessing the field via an interface.
+func (v *ObservabilityDashboard) GetId() string { return v.Id }
+
+// GetItems returns ObservabilityDashboard.Items, and is useful for accessing the field via an interface.
+func (v *ObservabilityDashboard) GetItems() []ObservabilityDashboardItemsObservabilityDashboardItemInstance {
+	return v.Items
+}
+
+type ObservabilityDashboardCreateInput struct {
+	EnvironmentId string `json:"environmentId"`
+	// If no items are provided, a default dashboard will be created.
+	Items []ObservabilityDashboardUpdateInput `json:"items"`
+}
+
+// GetEnvironmentId returns ObservabilityDashboardCreateInput.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *ObservabilityDashboardCreateInput) GetEnvironmentId() string { return v.EnvironmentId }
+
+// GetItems returns ObservabilityDashboardCreateInput.Items, and is useful for accessing the field via an interface.
+func (v *ObservabilityDashboardCreateInput) GetItems() []ObservabilityDashboardUpdateInput {
+	return v.Items
+}
+
+type ObservabilityDashboardItemConfigInput struct {
+	LogsFilter             *string                `json:"logsFilter,omitempty"`
+	Measurements           []MetricMeasurement    `json:"measurements,omitempty"`
+	ProjectUsageProperties []ProjectUsageProperty `json:"projectUsageProperties,omitempty"`
+	ResourceIds            []string               `json:"resourceIds,omitempty"`
+}
+
+// GetLogsFilter returns ObservabilityDashboardItemConfigInput.LogsFilter, and is useful for accessing the field via an interface.
+func (v *ObservabilityDashboardItemConfigInput) GetLogsFilter() *string { return v.LogsFilter }
+
+// GetMeasurements returns ObservabilityDashboardItemConfigInput.Measurements, and is useful for accessing the field via an interface.
+func (v *ObservabilityDashboardItemConfigInput) GetMeasurements() []MetricMeasurement {
+	return v.Measurements
+}
+
+// GetProjectUsageProperties returns ObservabilityDashboardItemConfigInput.ProjectUsageProperties, and is useful for accessing the field via an interface.
+func (v *ObservabilityDashboardItemConfigInput) GetProjectUsageProperties() []ProjectUsageProperty {
+	return v.ProjectUsageProperties
+}
+
+// GetResourceIds returns ObservabilityDashboardItemConfigInput.ResourceIds, and is useful for accessing the field via an interface.
+func (v *ObservabilityDashboardItemConfigInput) GetResourceIds() []string { return v.ResourceIds }
+
+type ObservabilityDashboardItemCreateInput struct {
+	Config      ObservabilityDashboardItemConfigInput `json:"config"`
+	Description *string                               `json:"description,omitempty"`
+	Id          string                                `json:"id"`
+	Name        string                                `json:"name"`
+	Type        ObservabilityDashboardItemType        `json:"type"`
+}
+
+// GetConfig returns ObservabilityDashboardItemCreateInput.Config, and is useful for accessing the field via an interface.
+func (v *ObservabilityDashboardItemCreateInput) GetConfig() ObservabilityDashboardItemConfigInput {
+	return v.Config
+}
+
+// GetDescription returns ObservabilityDashboardItemCreateInput.Description, and is useful for accessing the field via an interface.
+func (v *ObservabilityDashboardItemCreateInput) GetDescription() *string { return v.Description }
+
+// GetId returns ObservabilityDashboardItemCreateInput.Id, and is useful for accessing the field via an interface.
+func (v *ObservabilityDashboardItemCreateInput) GetId() string { return v.Id }
+
+// GetName returns ObservabilityDashboardItemCreateInput.Name, and is useful for accessing the field via an interface.
+func (v *ObservabilityDashboardItemCreateInput) GetName() string { return v.Name }
+
+// GetType returns ObservabilityDashboardItemCreateInput.Type, and is useful for accessing the field via an interface.
+func (v *ObservabilityDashboardItemCreateInput) GetType() ObservabilityDashboardItemType {
+	return v.Type
+}
+
+type ObservabilityDashboardItemType string
+
+const (
+	ObservabilityDashboardItemTypeProjectUsageItem   ObservabilityDashboardItemType = "PROJECT_USAGE_ITEM"
+	ObservabilityDashboardItemTypeServiceLogsItem    ObservabilityDashboardItemType = "SERVICE_LOGS_ITEM"
+	ObservabilityDashboardItemTypeServiceMetricsItem ObservabilityDashboardItemType = "SERVICE_METRICS_ITEM"
+	ObservabilityDashboardItemTypeVolumeMetricsItem  ObservabilityDashboardItemType = "VOLUME_METRICS_ITEM"
+)
+
+// ObservabilityDashboardItemsObservabilityDashboardItemInstance includes the requested fields of the GraphQL type ObservabilityDashboardItemInstance.
+type ObservabilityDashboardItemsObservabilityDashboardItemInstance struct {
+	Id            string                                                                                               `json:"id"`
+	DisplayConfig map[string]interface{}                                                                               `json:"displayConfig"`
+	DashboardItem ObservabilityDashboardItemsObservabilityDashboardItemInstanceDashboardItemObservabilityDashboardItem `json:"dashboardItem"`
+}
+
+// GetId returns ObservabilityDashboardItemsObservabilityDashboardItemInstance.Id, and is useful for accessing the field via an interface.
+func (v *ObservabilityDashboardItemsObservabilityDashboardItemInstance) GetId() string { return v.Id }
+
+// GetDisplayConfig returns ObservabilityDashboardItemsObservabilityDashboardItemInstance.DisplayConfig, and is useful for accessing the field via an interface.
+func (v *ObservabilityDashboardItemsObservabilityDashboardItemInstance) GetDisplayConfig() map[string]interface{} {
+	return v.DisplayConfig
+}
+
+// GetDashboardItem returns ObservabilityDashboardItemsObservabilityDashboardItemInstance.DashboardItem, and is useful for accessing the field via an interface.
+func (v *ObservabilityDashboardItemsObservabilityDashboardItemInstance) GetDashboardItem() ObservabilityDashboardItemsObservabilityDashboardItemInstanceDashboardItemObservabilityDashboardItem {
+	return v.DashboardItem
+}
+
+// ObservabilityDashboardItemsObservabilityDashboardItemInstanceDashboardItemObservabilityDashboardItem includes the requested fields of the GraphQL type ObservabilityDashboardItem.
+type ObservabilityDashboardItemsObservabilityDashboardItemInstanceDashboardItemObservabilityDashboardItem struct {
+	Id          string                                                                                                     `json:"id"`
+	Name        string                                                                                                     `json:"name"`
+	Description string                                                                                                     `json:"description"`
+	Type        ObservabilityDashboardItemType                                                                             `json:"type"`
+	Config      ObservabilityDashboardItemsObservabilityDashboardItemInstanceDashboardItemObservabilityDashboardItemConfig `json:"config"`
+}
+
+// GetId returns ObservabilityDashboardItemsObservabilityDashboardItemInstanceDashboardItemObservabilityDashboardItem.Id, and is useful for accessing the field via an interface.
+func (v *ObservabilityDashboardItemsObservabilityDashboardItemInstanceDashboardItemObservabilityDashboardItem) GetId() string {
+	return v.Id
+}
+
+// GetName returns ObservabilityDashboardItemsObservabilityDashboardItemInstanceDashboardItemObservabilityDashboardItem.Name, and is useful for accessing the field via an interface.
+func (v *ObservabilityDashboardItemsObservabilityDashboardItemInstanceDashboardItemObservabilityDashboardItem) GetName() string {
+	return v.Name
+}
+
+// GetDescription returns ObservabilityDashboardItemsObservabilityDashboardItemInstanceDashboardItemObservabilityDashboardItem.Description, and is useful for accessing the field via an interface.
+func (v *ObservabilityDashboardItemsObservabilityDashboardItemInstanceDashboardItemObservabilityDashboardItem) GetDescription() string {
+	return v.Description
+}
+
+// GetType returns ObservabilityDashboardItemsObservabilityDashboardItemInstanceDashboardItemObservabilityDashboardItem.Type, and is useful for accessing the field via an interface.
+func (v *ObservabilityDashboardItemsObservabilityDashboardItemInstanceDashboardItemObservabilityDashboardItem) GetType() ObservabilityDashboardItemType {
+	return v.Type
+}
+
+// GetConfig returns ObservabilityDashboardItemsObservabilityDashboardItemInstanceDashboardItemObservabilityDashboardItem.Config, and is useful for accessing the field via an interface.
+func (v *ObservabilityDashboardItemsObservabilityDashboardItemInstanceDashboardItemObservabilityDashboardItem) GetConfig() ObservabilityDashboardItemsObservabilityDashboardItemInstanceDashboardItemObservabilityDashboardItemConfig {
+	return v.Config
+}
+
+// ObservabilityDashboardItemsObservabilityDashboardItemInstanceDashboardItemObservabilityDashboardItemConfig includes the requested fields of the GraphQL type ObservabilityDashboardItemConfig.
+type ObservabilityDashboardItemsObservabilityDashboardItemInstanceDashboardItemObservabilityDashboardItemConfig struct {
+	LogsFilter             string                 `json:"logsFilter"`
+	Measurements           []MetricMeasurement    `json:"measurements"`
+	ProjectUsageProperties []ProjectUsageProperty `json:"projectUsageProperties"`
+	ResourceIds            []string               `json:"resourceIds"`
+}
+
+// GetLogsFilter returns ObservabilityDashboardItemsObservabilityDashboardItemInstanceDashboardItemObservabilityDashboardItemConfig.LogsFilter, and is useful for accessing the field via an interface.
+func (v *ObservabilityDashboardItemsObservabilityDashboardItemInstanceDashboardItemObservabilityDashboardItemConfig) GetLogsFilter() string {
+	return v.LogsFilter
+}
+
+// GetMeasurements returns ObservabilityDashboardItemsObservabilityDashboardItemInstanceDashboardItemObservabilityDashboardItemConfig.Measurements, and is useful for accessing the field via an interface.
+func (v *ObservabilityDashboardItemsObservabilityDashboardItemInstanceDashboardItemObservabilityDashboardItemConfig) GetMeasurements() []MetricMeasurement {
+	return v.Measurements
+}
+
+// GetProjectUsageProperties returns ObservabilityDashboardItemsObservabilityDashboardItemInstanceDashboardItemObservabilityDashboardItemConfig.ProjectUsageProperties, and is useful for accessing the field via an interface.
+func (v *ObservabilityDashboardItemsObservabilityDashboardItemInstanceDashboardItemObservabilityDashboardItemConfig) GetProjectUsageProperties() []ProjectUsageProperty {
+	return v.ProjectUsageProperties
+}
+
+// GetResourceIds returns ObservabilityDashboardItemsObservabilityDashboardItemInstanceDashboardItemObservabilityDashboardItemConfig.ResourceIds, and is useful for accessing the field via an interface.
+func (v *ObservabilityDashboardItemsObservabilityDashboardItemInstanceDashboardItemObservabilityDashboardItemConfig) GetResourceIds() []string {
+	return v.ResourceIds
+}
+
+type ObservabilityDashboardUpdateInput struct {
+	DashboardItem ObservabilityDashboardItemCreateInput `json:"dashboardItem"`
+	DisplayConfig map[string]interface{}                `json:"displayConfig"`
+	Id            string                                `json:"id"`
+}
+
+// GetDashboardItem returns ObservabilityDashboardUpdateInput.DashboardItem, and is useful for accessing the field via an interface.
+func (v *ObservabilityDashboardUpdateInput) GetDashboardItem() ObservabilityDashboardItemCreateInput {
+	return v.DashboardItem
+}
+
+// GetDisplayConfig returns ObservabilityDashboardUpdateInput.DisplayConfig, and is useful for accessing the field via an interface.
+func (v *ObservabilityDashboardUpdateInput) GetDisplayConfig() map[string]interface{} {
+	return v.DisplayConfig
+}
+
+// GetId returns ObservabilityDashboardUpdateInput.Id, and is useful for accessing the field via an interface.
+func (v *ObservabilityDashboardUpdateInput) GetId() string { return v.Id }
+
+type PrivateNetworkCreateOrGetInput struct {
+	EnvironmentId string   `json:"environmentId"`
+	Name          string   `json:"name"`
+	ProjectId     string   `json:"projectId"`
+	Tags          []string `json:"tags"`
+}
+
+// GetEnvironmentId returns PrivateNetworkCreateOrGetInput.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *PrivateNetworkCreateOrGetInput) GetEnvironmentId() string { return v.EnvironmentId }
+
+// GetName returns PrivateNetworkCreateOrGetInput.Name, and is useful for accessing the field via an interface.
+func (v *PrivateNetworkCreateOrGetInput) GetName() string { return v.Name }
+
+// GetProjectId returns PrivateNetworkCreateOrGetInput.ProjectId, and is useful for accessing the field via an interface.
+func (v *PrivateNetworkCreateOrGetInput) GetProjectId() string { return v.ProjectId }
+
+// GetTags returns PrivateNetworkCreateOrGetInput.Tags, and is useful for accessing the field via an interface.
+func (v *PrivateNetworkCreateOrGetInput) GetTags() []string { return v.Tags }
+
+// PrivateNetworkEndpoint includes the GraphQL fields of PrivateNetworkEndpoint requested by the fragment PrivateNetworkEndpoint.
+type PrivateNetworkEndpoint struct {
+	PublicId          string   `json:"publicId"`
+	DnsName           string   `json:"dnsName"`
+	PrivateIps        []string `json:"privateIps"`
+	ServiceInstanceId string   `json:"serviceInstanceId"`
+	Tags              []string `json:"tags"`
+}
+
+// GetPublicId returns PrivateNetworkEndpoint.PublicId, and is useful for accessing the field via an interface.
+func (v *PrivateNetworkEndpoint) GetPublicId() string { return v.PublicId }
+
+// GetDnsName returns PrivateNetworkEndpoint.DnsName, and is useful for accessing the field via an interface.
+func (v *PrivateNetworkEndpoint) GetDnsName() string { return v.DnsName }
+
+// GetPrivateIps returns PrivateNetworkEndpoint.PrivateIps, and is useful for accessing the field via an interface.
+func (v *PrivateNetworkEndpoint) GetPrivateIps() []string { return v.PrivateIps }
+
+// GetServiceInstanceId returns PrivateNetworkEndpoint.ServiceInstanceId, and is useful for accessing the field via an interface.
+func (v *PrivateNetworkEndpoint) GetServiceInstanceId() string { return v.ServiceInstanceId }
+
+// GetTags returns PrivateNetworkEndpoint.Tags, and is useful for accessing the field via an interface.
+func (v *PrivateNetworkEndpoint) GetTags() []string { return v.Tags }
+
+type PrivateNetworkEndpointCreateOrGetInput struct {
+	EnvironmentId    string   `json:"environmentId"`
+	PrivateNetworkId string   `json:"privateNetworkId"`
+	ServiceId        string   `json:"serviceId"`
+	ServiceName      string   `json:"serviceName"`
+	Tags             []string `json:"tags"`
+}
+
+// GetEnvironmentId returns PrivateNetworkEndpointCreateOrGetInput.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *PrivateNetworkEndpointCreateOrGetInput) GetEnvironmentId() string { return v.EnvironmentId }
+
+// GetPrivateNetworkId returns PrivateNetworkEndpointCreateOrGetInput.PrivateNetworkId, and is useful for accessing the field via an interface.
+func (v *PrivateNetworkEndpointCreateOrGetInput) GetPrivateNetworkId() string {
+	return v.PrivateNetworkId
+}
+
+// GetServiceId returns PrivateNetworkEndpointCreateOrGetInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *PrivateNetworkEndpointCreateOrGetInput) GetServiceId() string { return v.ServiceId }
+
+// GetServiceName returns PrivateNetworkEndpointCreateOrGetInput.ServiceName, and is useful for accessing the field via an interface.
+func (v *PrivateNetworkEndpointCreateOrGetInput) GetServiceName() string { return v.ServiceName }
+
+// GetTags returns PrivateNetworkEndpointCreateOrGetInput.Tags, and is useful for accessing the field via an interface.
+func (v *PrivateNetworkEndpointCreateOrGetInput) GetTags() []string { return v.Tags }
+
 // Project includes the GraphQL fields of Project requested by the fragment Project.
 type Project struct {
 	Id           string                                           `json:"id"`
@@ -166,6 +848,7 @@ type Project struct {
 	Description  string                                           `json:"description"`
 	IsPublic     bool                                             `json:"isPublic"`
 	PrDeploys    bool                                             `json:"prDeploys"`
+	DeletedAt    time.Time                                        `json:"deletedAt"`
 	Workspace    *ProjectWorkspace                                `json:"workspace"`
 	Environments ProjectEnvironmentsProjectEnvironmentsConnection `json:"environments"`
 }
@@ -185,6 +868,9 @@ func (v *Project) GetIsPublic() bool { return v.IsPublic }
 // GetPrDeploys returns Project.PrDeploys, and is useful for accessing the field via an interface.
 func (v *Project) GetPrDeploys() bool { return v.PrDeploys }
 
+// GetDeletedAt returns Project.DeletedAt, and is useful for accessing the field via an interface.
+func (v *Project) GetDeletedAt() time.Time { return v.DeletedAt }
+
 // GetWorkspace returns Project.Workspace, and is useful for accessing the field via an interface.
 func (v *Project) GetWorkspace() *ProjectWorkspace { return v.Workspace }
 
@@ -318,6 +1004,18 @@ func (v *ProjectUpdateInput) GetName() string { return v.Name }
 // GetPrDeploys returns ProjectUpdateInput.PrDeploys, and is useful for accessing the field via an interface.
 func (v *ProjectUpdateInput) GetPrDeploys() bool { return v.PrDeploys }
 
+type ProjectUsageProperty string
+
+const (
+	ProjectUsagePropertyBackupUsage    ProjectUsageProperty = "BACKUP_USAGE"
+	ProjectUsagePropertyCpuUsage       ProjectUsageProperty = "CPU_USAGE"
+	ProjectUsagePropertyCurrentUsage   ProjectUsageProperty = "CURRENT_USAGE"
+	ProjectUsagePropertyDiskUsage      ProjectUsageProperty = "DISK_USAGE"
+	ProjectUsagePropertyEstimatedUsage ProjectUsageProperty = "ESTIMATED_USAGE"
+	ProjectUsagePropertyMemoryUsage    ProjectUsageProperty = "MEMORY_USAGE"
+	ProjectUsagePropertyNetworkUsage   ProjectUsageProperty = "NETWORK_USAGE"
+)
+
 // ProjectWorkspace includes the requested fields of the GraphQL type Workspace.
 type ProjectWorkspace struct {
 	Id string `json:"id"`
@@ -443,6 +1141,7 @@ type ServiceDomain struct {
 	Id            string `json:"id"`
 	Domain        string `json:"domain"`
 	Suffix        string `json:"suffix"`
+	TargetPort    int    `json:"targetPort"`
 	EnvironmentId string `json:"environmentId"`
 	ServiceId     string `json:"serviceId"`
 }
@@ -456,6 +1155,9 @@ func (v *ServiceDomain) GetDomain() string { return v.Domain }
 // GetSuffix returns ServiceDomain.Suffix, and is useful for accessing the field via an interface.
 func (v *ServiceDomain) GetSuffix() string { return v.Suffix }
 
+// GetTargetPort returns ServiceDomain.TargetPort, and is useful for accessing the field via an interface.
+func (v *ServiceDomain) GetTargetPort() int { return v.TargetPort }
+
 // GetEnvironmentId returns ServiceDomain.EnvironmentId, and is useful for accessing the field via an interface.
 func (v *ServiceDomain) GetEnvironmentId() string { return v.EnvironmentId }
 
@@ -482,7 +1184,7 @@ type ServiceDomainUpdateInput struct {
 	EnvironmentId   string `json:"environmentId"`
 	ServiceDomainId string `json:"serviceDomainId"`
 	ServiceId       string `json:"serviceId"`
-	TargetPort      int    `json:"targetPort"`
+	TargetPort      *int   `json:"targetPort"`
 }
 
 // GetDomain returns ServiceDomainUpdateInput.Domain, and is useful for accessing the field via an interface.
@@ -498,7 +1200,7 @@ func (v *ServiceDomainUpdateInput) GetServiceDomainId() string { return v.Servic
 func (v *ServiceDomainUpdateInput) GetServiceId() string { return v.ServiceId }
 
 // GetTargetPort returns ServiceDomainUpdateInput.TargetPort, and is useful for accessing the field via an interface.
-func (v *ServiceDomainUpdateInput) GetTargetPort() int { return v.TargetPort }
+func (v *ServiceDomainUpdateInput) GetTargetPort() *int { return v.TargetPort }
 
 type ServiceInstanceUpdateInput struct {
 	BuildCommand            *string                   `json:"buildCommand,omitempty"`
@@ -625,6 +1327,60 @@ func (v *ServiceUpdateInput) GetIcon() string { return v.Icon }
 // GetName returns ServiceUpdateInput.Name, and is useful for accessing the field via an interface.
 func (v *ServiceUpdateInput) GetName() string { return v.Name }
 
+type SharedVariableConfigureInput struct {
+	DisabledServiceIds []string `json:"disabledServiceIds"`
+	EnabledServiceIds  []string `json:"enabledServiceIds"`
+	EnvironmentId      string   `json:"environmentId"`
+	Name               string   `json:"name"`
+	ProjectId          string   `json:"projectId"`
+}
+
+// GetDisabledServiceIds returns SharedVariableConfigureInput.DisabledServiceIds, and is useful for accessing the field via an interface.
+func (v *SharedVariableConfigureInput) GetDisabledServiceIds() []string { return v.DisabledServiceIds }
+
+// GetEnabledServiceIds returns SharedVariableConfigureInput.EnabledServiceIds, and is useful for accessing the field via an interface.
+func (v *SharedVariableConfigureInput) GetEnabledServiceIds() []string { return v.EnabledServiceIds }
+
+// GetEnvironmentId returns SharedVariableConfigureInput.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *SharedVariableConfigureInput) GetEnvironmentId() string { return v.EnvironmentId }
+
+// GetName returns SharedVariableConfigureInput.Name, and is useful for accessing the field via an interface.
+func (v *SharedVariableConfigureInput) GetName() string { return v.Name }
+
+// GetProjectId returns SharedVariableConfigureInput.ProjectId, and is useful for accessing the field via an interface.
+func (v *SharedVariableConfigureInput) GetProjectId() string { return v.ProjectId }
+
+// SshPublicKey includes the GraphQL fields of SshPublicKey requested by the fragment SshPublicKey.
+type SshPublicKey struct {
+	Id          string `json:"id"`
+	Name        string `json:"name"`
+	PublicKey   string `json:"publicKey"`
+	Fingerprint string `json:"fingerprint"`
+}
+
+// GetId returns SshPublicKey.Id, and is useful for accessing the field via an interface.
+func (v *SshPublicKey) GetId() string { return v.Id }
+
+// GetName returns SshPublicKey.Name, and is useful for accessing the field via an interface.
+func (v *SshPublicKey) GetName() string { return v.Name }
+
+// GetPublicKey returns SshPublicKey.PublicKey, and is useful for accessing the field via an interface.
+func (v *SshPublicKey) GetPublicKey() string { return v.PublicKey }
+
+// GetFingerprint returns SshPublicKey.Fingerprint, and is useful for accessing the field via an interface.
+func (v *SshPublicKey) GetFingerprint() string { return v.Fingerprint }
+
+type SshPublicKeyCreateInput struct {
+	Name      string `json:"name"`
+	PublicKey string `json:"publicKey"`
+}
+
+// GetName returns SshPublicKeyCreateInput.Name, and is useful for accessing the field via an interface.
+func (v *SshPublicKeyCreateInput) GetName() string { return v.Name }
+
+// GetPublicKey returns SshPublicKeyCreateInput.PublicKey, and is useful for accessing the field via an interface.
+func (v *SshPublicKeyCreateInput) GetPublicKey() string { return v.PublicKey }
+
 // TCPProxy includes the GraphQL fields of TCPProxy requested by the fragment TCPProxy.
 type TCPProxy struct {
 	Id              string `json:"id"`
@@ -668,6 +1424,53 @@ func (v *TCPProxyCreateInput) GetEnvironmentId() string { return v.EnvironmentId
 // GetServiceId returns TCPProxyCreateInput.ServiceId, and is useful for accessing the field via an interface.
 func (v *TCPProxyCreateInput) GetServiceId() string { return v.ServiceId }
 
+type TemplateDeployV2Input struct {
+	EnvironmentId    *string                `json:"environmentId,omitempty"`
+	ProjectId        *string                `json:"projectId,omitempty"`
+	SerializedConfig map[string]interface{} `json:"serializedConfig"`
+	TemplateId       string                 `json:"templateId"`
+	WorkspaceId      *string                `json:"workspaceId,omitempty"`
+}
+
+// GetEnvironmentId returns TemplateDeployV2Input.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *TemplateDeployV2Input) GetEnvironmentId() *string { return v.EnvironmentId }
+
+// GetProjectId returns TemplateDeployV2Input.ProjectId, and is useful for accessing the field via an interface.
+func (v *TemplateDeployV2Input) GetProjectId() *string { return v.ProjectId }
+
+// GetSerializedConfig returns TemplateDeployV2Input.SerializedConfig, and is useful for accessing the field via an interface.
+func (v *TemplateDeployV2Input) GetSerializedConfig() map[string]interface{} {
+	return v.SerializedConfig
+}
+
+// GetTemplateId returns TemplateDeployV2Input.TemplateId, and is useful for accessing the field via an interface.
+func (v *TemplateDeployV2Input) GetTemplateId() string { return v.TemplateId }
+
+// GetWorkspaceId returns TemplateDeployV2Input.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *TemplateDeployV2Input) GetWorkspaceId() *string { return v.WorkspaceId }
+
+type UsageLimitRemoveInput struct {
+	CustomerId string `json:"customerId"`
+}
+
+// GetCustomerId returns UsageLimitRemoveInput.CustomerId, and is useful for accessing the field via an interface.
+func (v *UsageLimitRemoveInput) GetCustomerId() string { return v.CustomerId }
+
+type UsageLimitSetInput struct {
+	CustomerId       string `json:"customerId"`
+	HardLimitDollars *int   `json:"hardLimitDollars,omitempty"`
+	SoftLimitDollars int    `json:"softLimitDollars"`
+}
+
+// GetCustomerId returns UsageLimitSetInput.CustomerId, and is useful for accessing the field via an interface.
+func (v *UsageLimitSetInput) GetCustomerId() string { return v.CustomerId }
+
+// GetHardLimitDollars returns UsageLimitSetInput.HardLimitDollars, and is useful for accessing the field via an interface.
+func (v *UsageLimitSetInput) GetHardLimitDollars() *int { return v.HardLimitDollars }
+
+// GetSoftLimitDollars returns UsageLimitSetInput.SoftLimitDollars, and is useful for accessing the field via an interface.
+func (v *UsageLimitSetInput) GetSoftLimitDollars() int { return v.SoftLimitDollars }
+
 type VariableCollectionUpsertInput struct {
 	EnvironmentId string `json:"environmentId"`
 	ProjectId     string `json:"projectId"`
@@ -884,6 +1687,63 @@ func (v *VolumeVolumeInstancesVolumeVolumeInstancesConnectionEdgesVolumeVolumeIn
 	return v.SizeMB
 }
 
+type WorkflowStatus string
+
+const (
+	WorkflowStatusComplete WorkflowStatus = "Complete"
+	WorkflowStatusError    WorkflowStatus = "Error"
+	WorkflowStatusNotfound WorkflowStatus = "NotFound"
+	WorkflowStatusRunning  WorkflowStatus = "Running"
+)
+
+// __cancelScheduledDeleteProjectInput is used internally by genqlient
+type __cancelScheduledDeleteProjectInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __cancelScheduledDeleteProjectInput.Id, and is useful for accessing the field via an interface.
+func (v *__cancelScheduledDeleteProjectInput) GetId() string { return v.Id }
+
+// __checkServiceDomainAvailableInput is used internally by genqlient
+type __checkServiceDomainAvailableInput struct {
+	Domain string `json:"domain"`
+}
+
+// GetDomain returns __checkServiceDomainAvailableInput.Domain, and is useful for accessing the field via an interface.
+func (v *__checkServiceDomainAvailableInput) GetDomain() string { return v.Domain }
+
+// __clearEgressGatewaysInput is used internally by genqlient
+type __clearEgressGatewaysInput struct {
+	Input EgressGatewayServiceTargetInput `json:"input"`
+}
+
+// GetInput returns __clearEgressGatewaysInput.Input, and is useful for accessing the field via an interface.
+func (v *__clearEgressGatewaysInput) GetInput() EgressGatewayServiceTargetInput { return v.Input }
+
+// __commitStagedChangesInput is used internally by genqlient
+type __commitStagedChangesInput struct {
+	EnvironmentId string `json:"environmentId"`
+	CommitMessage string `json:"commitMessage"`
+	SkipDeploys   bool   `json:"skipDeploys"`
+}
+
+// GetEnvironmentId returns __commitStagedChangesInput.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *__commitStagedChangesInput) GetEnvironmentId() string { return v.EnvironmentId }
+
+// GetCommitMessage returns __commitStagedChangesInput.CommitMessage, and is useful for accessing the field via an interface.
+func (v *__commitStagedChangesInput) GetCommitMessage() string { return v.CommitMessage }
+
+// GetSkipDeploys returns __commitStagedChangesInput.SkipDeploys, and is useful for accessing the field via an interface.
+func (v *__commitStagedChangesInput) GetSkipDeploys() bool { return v.SkipDeploys }
+
+// __configureSharedVariableInput is used internally by genqlient
+type __configureSharedVariableInput struct {
+	Input SharedVariableConfigureInput `json:"input"`
+}
+
+// GetInput returns __configureSharedVariableInput.Input, and is useful for accessing the field via an interface.
+func (v *__configureSharedVariableInput) GetInput() SharedVariableConfigureInput { return v.Input }
+
 // __connectServiceInput is used internally by genqlient
 type __connectServiceInput struct {
 	Id    string              `json:"id"`
@@ -896,6 +1756,22 @@ func (v *__connectServiceInput) GetId() string { return v.Id }
 // GetInput returns __connectServiceInput.Input, and is useful for accessing the field via an interface.
 func (v *__connectServiceInput) GetInput() ServiceConnectInput { return v.Input }
 
+// __createApiTokenInput is used internally by genqlient
+type __createApiTokenInput struct {
+	Input ApiTokenCreateInput `json:"input"`
+}
+
+// GetInput returns __createApiTokenInput.Input, and is useful for accessing the field via an interface.
+func (v *__createApiTokenInput) GetInput() ApiTokenCreateInput { return v.Input }
+
+// __createBucketInput is used internally by genqlient
+type __createBucketInput struct {
+	Input BucketCreateInput `json:"input"`
+}
+
+// GetInput returns __createBucketInput.Input, and is useful for accessing the field via an interface.
+func (v *__createBucketInput) GetInput() BucketCreateInput { return v.Input }
+
 // __createCustomDomainInput is used internally by genqlient
 type __createCustomDomainInput struct {
 	Input CustomDomainCreateInput `json:"input"`
@@ -904,6 +1780,14 @@ type __createCustomDomainInput struct {
 // GetInput returns __createCustomDomainInput.Input, and is useful for accessing the field via an interface.
 func (v *__createCustomDomainInput) GetInput() CustomDomainCreateInput { return v.Input }
 
+// __createEgressGatewayInput is used internally by genqlient
+type __createEgressGatewayInput struct {
+	Input EgressGatewayCreateInput `json:"input"`
+}
+
+// GetInput returns __createEgressGatewayInput.Input, and is useful for accessing the field via an interface.
+func (v *__createEgressGatewayInput) GetInput() EgressGatewayCreateInput { return v.Input }
+
 // __createEnvironmentInput is used internally by genqlient
 type __createEnvironmentInput struct {
 	Input EnvironmentCreateInput `json:"input"`
@@ -912,6 +1796,42 @@ type __createEnvironmentInput struct {
 // GetInput returns __createEnvironmentInput.Input, and is useful for accessing the field via an interface.
 func (v *__createEnvironmentInput) GetInput() EnvironmentCreateInput { return v.Input }
 
+// __createIntegrationInput is used internally by genqlient
+type __createIntegrationInput struct {
+	Input IntegrationCreateInput `json:"input"`
+}
+
+// GetInput returns __createIntegrationInput.Input, and is useful for accessing the field via an interface.
+func (v *__createIntegrationInput) GetInput() IntegrationCreateInput { return v.Input }
+
+// __createObservabilityDashboardInput is used internally by genqlient
+type __createObservabilityDashboardInput struct {
+	Input ObservabilityDashboardCreateInput `json:"input"`
+}
+
+// GetInput returns __createObservabilityDashboardInput.Input, and is useful for accessing the field via an interface.
+func (v *__createObservabilityDashboardInput) GetInput() ObservabilityDashboardCreateInput {
+	return v.Input
+}
+
+// __createOrGetPrivateNetworkEndpointInput is used internally by genqlient
+type __createOrGetPrivateNetworkEndpointInput struct {
+	Input PrivateNetworkEndpointCreateOrGetInput `json:"input"`
+}
+
+// GetInput returns __createOrGetPrivateNetworkEndpointInput.Input, and is useful for accessing the field via an interface.
+func (v *__createOrGetPrivateNetworkEndpointInput) GetInput() PrivateNetworkEndpointCreateOrGetInput {
+	return v.Input
+}
+
+// __createOrGetPrivateNetworkInput is used internally by genqlient
+type __createOrGetPrivateNetworkInput struct {
+	Input PrivateNetworkCreateOrGetInput `json:"input"`
+}
+
+// GetInput returns __createOrGetPrivateNetworkInput.Input, and is useful for accessing the field via an interface.
+func (v *__createOrGetPrivateNetworkInput) GetInput() PrivateNetworkCreateOrGetInput { return v.Input }
+
 // __createProjectInput is used internally by genqlient
 type __createProjectInput struct {
 	Input ProjectCreateInput `json:"input"`
@@ -936,6 +1856,14 @@ type __createServiceInput struct {
 // GetInput returns __createServiceInput.Input, and is useful for accessing the field via an interface.
 func (v *__createServiceInput) GetInput() ServiceCreateInput { return v.Input }
 
+// __createSshPublicKeyInput is used internally by genqlient
+type __createSshPublicKeyInput struct {
+	Input SshPublicKeyCreateInput `json:"input"`
+}
+
+// GetInput returns __createSshPublicKeyInput.Input, and is useful for accessing the field via an interface.
+func (v *__createSshPublicKeyInput) GetInput() SshPublicKeyCreateInput { return v.Input }
+
 // __createTcpProxyInput is used internally by genqlient
 type __createTcpProxyInput struct {
 	Input TCPProxyCreateInput `json:"input"`
@@ -952,6 +1880,14 @@ type __createVolumeInput struct {
 // GetInput returns __createVolumeInput.Input, and is useful for accessing the field via an interface.
 func (v *__createVolumeInput) GetInput() VolumeCreateInput { return v.Input }
 
+// __deleteApiTokenInput is used internally by genqlient
+type __deleteApiTokenInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __deleteApiTokenInput.Id, and is useful for accessing the field via an interface.
+func (v *__deleteApiTokenInput) GetId() string { return v.Id }
+
 // __deleteCustomDomainInput is used internally by genqlient
 type __deleteCustomDomainInput struct {
 	Id string `json:"id"`
@@ -968,6 +1904,22 @@ type __deleteEnvironmentInput struct {
 // GetId returns __deleteEnvironmentInput.Id, and is useful for accessing the field via an interface.
 func (v *__deleteEnvironmentInput) GetId() string { return v.Id }
 
+// __deleteIntegrationInput is used internally by genqlient
+type __deleteIntegrationInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __deleteIntegrationInput.Id, and is useful for accessing the field via an interface.
+func (v *__deleteIntegrationInput) GetId() string { return v.Id }
+
+// __deletePrivateNetworkEndpointInput is used internally by genqlient
+type __deletePrivateNetworkEndpointInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __deletePrivateNetworkEndpointInput.Id, and is useful for accessing the field via an interface.
+func (v *__deletePrivateNetworkEndpointInput) GetId() string { return v.Id }
+
 // __deleteProjectInput is used internally by genqlient
 type __deleteProjectInput struct {
 	Id string `json:"id"`
@@ -992,6 +1944,14 @@ type __deleteServiceInput struct {
 // GetId returns __deleteServiceInput.Id, and is useful for accessing the field via an interface.
 func (v *__deleteServiceInput) GetId() string { return v.Id }
 
+// __deleteSshPublicKeyInput is used internally by genqlient
+type __deleteSshPublicKeyInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __deleteSshPublicKeyInput.Id, and is useful for accessing the field via an interface.
+func (v *__deleteSshPublicKeyInput) GetId() string { return v.Id }
+
 // __deleteTcpProxyInput is used internally by genqlient
 type __deleteTcpProxyInput struct {
 	Id string `json:"id"`
@@ -1016,6 +1976,30 @@ type __deleteVolumeInput struct {
 // GetId returns __deleteVolumeInput.Id, and is useful for accessing the field via an interface.
 func (v *__deleteVolumeInput) GetId() string { return v.Id }
 
+// __deployServiceInstanceInput is used internally by genqlient
+type __deployServiceInstanceInput struct {
+	EnvironmentId string  `json:"environmentId"`
+	ServiceId     string  `json:"serviceId"`
+	CommitSha     *string `json:"commitSha"`
+}
+
+// GetEnvironmentId returns __deployServiceInstanceInput.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *__deployServiceInstanceInput) GetEnvironmentId() string { return v.EnvironmentId }
+
+// GetServiceId returns __deployServiceInstanceInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__deployServiceInstanceInput) GetServiceId() string { return v.ServiceId }
+
+// GetCommitSha returns __deployServiceInstanceInput.CommitSha, and is useful for accessing the field via an interface.
+func (v *__deployServiceInstanceInput) GetCommitSha() *string { return v.CommitSha }
+
+// __deployTemplateInput is used internally by genqlient
+type __deployTemplateInput struct {
+	Input TemplateDeployV2Input `json:"input"`
+}
+
+// GetInput returns __deployTemplateInput.Input, and is useful for accessing the field via an interface.
+func (v *__deployTemplateInput) GetInput() TemplateDeployV2Input { return v.Input }
+
 // __disconnectServiceInput is used internally by genqlient
 type __disconnectServiceInput struct {
 	Id string `json:"id"`
@@ -1024,6 +2008,42 @@ type __disconnectServiceInput struct {
 // GetId returns __disconnectServiceInput.Id, and is useful for accessing the field via an interface.
 func (v *__disconnectServiceInput) GetId() string { return v.Id }
 
+// __getBucketCredentialsInput is used internally by genqlient
+type __getBucketCredentialsInput struct {
+	BucketId      string `json:"bucketId"`
+	EnvironmentId string `json:"environmentId"`
+	ProjectId     string `json:"projectId"`
+}
+
+// GetBucketId returns __getBucketCredentialsInput.BucketId, and is useful for accessing the field via an interface.
+func (v *__getBucketCredentialsInput) GetBucketId() string { return v.BucketId }
+
+// GetEnvironmentId returns __getBucketCredentialsInput.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *__getBucketCredentialsInput) GetEnvironmentId() string { return v.EnvironmentId }
+
+// GetProjectId returns __getBucketCredentialsInput.ProjectId, and is useful for accessing the field via an interface.
+func (v *__getBucketCredentialsInput) GetProjectId() string { return v.ProjectId }
+
+// __getCustomDomainInput is used internally by genqlient
+type __getCustomDomainInput struct {
+	Id        string `json:"id"`
+	ProjectId string `json:"projectId"`
+}
+
+// GetId returns __getCustomDomainInput.Id, and is useful for accessing the field via an interface.
+func (v *__getCustomDomainInput) GetId() string { return v.Id }
+
+// GetProjectId returns __getCustomDomainInput.ProjectId, and is useful for accessing the field via an interface.
+func (v *__getCustomDomainInput) GetProjectId() string { return v.ProjectId }
+
+// __getDeploymentInput is used internally by genqlient
+type __getDeploymentInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __getDeploymentInput.Id, and is useful for accessing the field via an interface.
+func (v *__getDeploymentInput) GetId() string { return v.Id }
+
 // __getEnvironmentInput is used internally by genqlient
 type __getEnvironmentInput struct {
 	Id string `json:"id"`
@@ -1040,6 +2060,38 @@ type __getEnvironmentsInput struct {
 // GetProjectId returns __getEnvironmentsInput.ProjectId, and is useful for accessing the field via an interface.
 func (v *__getEnvironmentsInput) GetProjectId() string { return v.ProjectId }
 
+// __getEstimatedUsageInput is used internally by genqlient
+type __getEstimatedUsageInput struct {
+	Measurements []MetricMeasurement `json:"measurements"`
+	ProjectId    *string             `json:"projectId"`
+	WorkspaceId  *string             `json:"workspaceId"`
+}
+
+// GetMeasurements returns __getEstimatedUsageInput.Measurements, and is useful for accessing the field via an interface.
+func (v *__getEstimatedUsageInput) GetMeasurements() []MetricMeasurement { return v.Measurements }
+
+// GetProjectId returns __getEstimatedUsageInput.ProjectId, and is useful for accessing the field via an interface.
+func (v *__getEstimatedUsageInput) GetProjectId() *string { return v.ProjectId }
+
+// GetWorkspaceId returns __getEstimatedUsageInput.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *__getEstimatedUsageInput) GetWorkspaceId() *string { return v.WorkspaceId }
+
+// __getPrivateNetworkEndpointInput is used internally by genqlient
+type __getPrivateNetworkEndpointInput struct {
+	EnvironmentId    string `json:"environmentId"`
+	PrivateNetworkId string `json:"privateNetworkId"`
+	ServiceId        string `json:"serviceId"`
+}
+
+// GetEnvironmentId returns __getPrivateNetworkEndpointInput.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *__getPrivateNetworkEndpointInput) GetEnvironmentId() string { return v.EnvironmentId }
+
+// GetPrivateNetworkId returns __getPrivateNetworkEndpointInput.PrivateNetworkId, and is useful for accessing the field via an interface.
+func (v *__getPrivateNetworkEndpointInput) GetPrivateNetworkId() string { return v.PrivateNetworkId }
+
+// GetServiceId returns __getPrivateNetworkEndpointInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__getPrivateNetworkEndpointInput) GetServiceId() string { return v.ServiceId }
+
 // __getProjectInput is used internally by genqlient
 type __getProjectInput struct {
 	Id string `json:"id"`
@@ -1088,6 +2140,14 @@ func (v *__getSharedVariablesInput) GetProjectId() string { return v.ProjectId }
 // GetEnvironmentId returns __getSharedVariablesInput.EnvironmentId, and is useful for accessing the field via an interface.
 func (v *__getSharedVariablesInput) GetEnvironmentId() string { return v.EnvironmentId }
 
+// __getStagedChangesInput is used internally by genqlient
+type __getStagedChangesInput struct {
+	EnvironmentId string `json:"environmentId"`
+}
+
+// GetEnvironmentId returns __getStagedChangesInput.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *__getStagedChangesInput) GetEnvironmentId() string { return v.EnvironmentId }
+
 // __getTcpProxyInput is used internally by genqlient
 type __getTcpProxyInput struct {
 	EnvironmentId string `json:"environmentId"`
@@ -1100,6 +2160,30 @@ func (v *__getTcpProxyInput) GetEnvironmentId() string { return v.EnvironmentId
 // GetServiceId returns __getTcpProxyInput.ServiceId, and is useful for accessing the field via an interface.
 func (v *__getTcpProxyInput) GetServiceId() string { return v.ServiceId }
 
+// __getTemplateInput is used internally by genqlient
+type __getTemplateInput struct {
+	Code string `json:"code"`
+}
+
+// GetCode returns __getTemplateInput.Code, and is useful for accessing the field via an interface.
+func (v *__getTemplateInput) GetCode() string { return v.Code }
+
+// __getUsageInput is used internally by genqlient
+type __getUsageInput struct {
+	Measurements []MetricMeasurement `json:"measurements"`
+	ProjectId    *string             `json:"projectId"`
+	WorkspaceId  *string             `json:"workspaceId"`
+}
+
+// GetMeasurements returns __getUsageInput.Measurements, and is useful for accessing the field via an interface.
+func (v *__getUsageInput) GetMeasurements() []MetricMeasurement { return v.Measurements }
+
+// GetProjectId returns __getUsageInput.ProjectId, and is useful for accessing the field via an interface.
+func (v *__getUsageInput) GetProjectId() *string { return v.ProjectId }
+
+// GetWorkspaceId returns __getUsageInput.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *__getUsageInput) GetWorkspaceId() *string { return v.WorkspaceId }
+
 // __getVariablesInput is used internally by genqlient
 type __getVariablesInput struct {
 	ProjectId     string `json:"projectId"`
@@ -1124,6 +2208,82 @@ type __getVolumeInstancesInput struct {
 // GetId returns __getVolumeInstancesInput.Id, and is useful for accessing the field via an interface.
 func (v *__getVolumeInstancesInput) GetId() string { return v.Id }
 
+// __getWorkflowStatusInput is used internally by genqlient
+type __getWorkflowStatusInput struct {
+	WorkflowId string `json:"workflowId"`
+}
+
+// GetWorkflowId returns __getWorkflowStatusInput.WorkflowId, and is useful for accessing the field via an interface.
+func (v *__getWorkflowStatusInput) GetWorkflowId() string { return v.WorkflowId }
+
+// __getWorkspaceCurrentUsageInput is used internally by genqlient
+type __getWorkspaceCurrentUsageInput struct {
+	WorkspaceId string `json:"workspaceId"`
+}
+
+// GetWorkspaceId returns __getWorkspaceCurrentUsageInput.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *__getWorkspaceCurrentUsageInput) GetWorkspaceId() string { return v.WorkspaceId }
+
+// __getWorkspaceUsageLimitInput is used internally by genqlient
+type __getWorkspaceUsageLimitInput struct {
+	WorkspaceId string `json:"workspaceId"`
+}
+
+// GetWorkspaceId returns __getWorkspaceUsageLimitInput.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *__getWorkspaceUsageLimitInput) GetWorkspaceId() string { return v.WorkspaceId }
+
+// __importDockerComposeInput is used internally by genqlient
+type __importDockerComposeInput struct {
+	ProjectId        string `json:"projectId"`
+	EnvironmentId    string `json:"environmentId"`
+	Yaml             string `json:"yaml"`
+	SkipStagingPatch bool   `json:"skipStagingPatch"`
+}
+
+// GetProjectId returns __importDockerComposeInput.ProjectId, and is useful for accessing the field via an interface.
+func (v *__importDockerComposeInput) GetProjectId() string { return v.ProjectId }
+
+// GetEnvironmentId returns __importDockerComposeInput.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *__importDockerComposeInput) GetEnvironmentId() string { return v.EnvironmentId }
+
+// GetYaml returns __importDockerComposeInput.Yaml, and is useful for accessing the field via an interface.
+func (v *__importDockerComposeInput) GetYaml() string { return v.Yaml }
+
+// GetSkipStagingPatch returns __importDockerComposeInput.SkipStagingPatch, and is useful for accessing the field via an interface.
+func (v *__importDockerComposeInput) GetSkipStagingPatch() bool { return v.SkipStagingPatch }
+
+// __importHerokuVariablesInput is used internally by genqlient
+type __importHerokuVariablesInput struct {
+	Input HerokuImportVariablesInput `json:"input"`
+}
+
+// GetInput returns __importHerokuVariablesInput.Input, and is useful for accessing the field via an interface.
+func (v *__importHerokuVariablesInput) GetInput() HerokuImportVariablesInput { return v.Input }
+
+// __listBucketsInput is used internally by genqlient
+type __listBucketsInput struct {
+	ProjectId string `json:"projectId"`
+}
+
+// GetProjectId returns __listBucketsInput.ProjectId, and is useful for accessing the field via an interface.
+func (v *__listBucketsInput) GetProjectId() string { return v.ProjectId }
+
+// __listCronExecutionsInput is used internally by genqlient
+type __listCronExecutionsInput struct {
+	EnvironmentId string `json:"environmentId"`
+	ServiceId     string `json:"serviceId"`
+	First         int    `json:"first"`
+}
+
+// GetEnvironmentId returns __listCronExecutionsInput.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *__listCronExecutionsInput) GetEnvironmentId() string { return v.EnvironmentId }
+
+// GetServiceId returns __listCronExecutionsInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__listCronExecutionsInput) GetServiceId() string { return v.ServiceId }
+
+// GetFirst returns __listCronExecutionsInput.First, and is useful for accessing the field via an interface.
+func (v *__listCronExecutionsInput) GetFirst() int { return v.First }
+
 // __listCustomDomainsInput is used internally by genqlient
 type __listCustomDomainsInput struct {
 	EnvironmentId string `json:"environmentId"`
@@ -1156,6 +2316,70 @@ func (v *__listDeploymentTriggersInput) GetEnvironmentId() string { return v.Env
 // GetServiceId returns __listDeploymentTriggersInput.ServiceId, and is useful for accessing the field via an interface.
 func (v *__listDeploymentTriggersInput) GetServiceId() string { return v.ServiceId }
 
+// __listDeploymentsInput is used internally by genqlient
+type __listDeploymentsInput struct {
+	Input DeploymentListInput `json:"input"`
+	First int                 `json:"first"`
+}
+
+// GetInput returns __listDeploymentsInput.Input, and is useful for accessing the field via an interface.
+func (v *__listDeploymentsInput) GetInput() DeploymentListInput { return v.Input }
+
+// GetFirst returns __listDeploymentsInput.First, and is useful for accessing the field via an interface.
+func (v *__listDeploymentsInput) GetFirst() int { return v.First }
+
+// __listEgressGatewaysInput is used internally by genqlient
+type __listEgressGatewaysInput struct {
+	EnvironmentId string `json:"environmentId"`
+	ServiceId     string `json:"serviceId"`
+}
+
+// GetEnvironmentId returns __listEgressGatewaysInput.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *__listEgressGatewaysInput) GetEnvironmentId() string { return v.EnvironmentId }
+
+// GetServiceId returns __listEgressGatewaysInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__listEgressGatewaysInput) GetServiceId() string { return v.ServiceId }
+
+// __listIntegrationsInput is used internally by genqlient
+type __listIntegrationsInput struct {
+	ProjectId string `json:"projectId"`
+}
+
+// GetProjectId returns __listIntegrationsInput.ProjectId, and is useful for accessing the field via an interface.
+func (v *__listIntegrationsInput) GetProjectId() string { return v.ProjectId }
+
+// __listObservabilityDashboardsInput is used internally by genqlient
+type __listObservabilityDashboardsInput struct {
+	EnvironmentId string `json:"environmentId"`
+}
+
+// GetEnvironmentId returns __listObservabilityDashboardsInput.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *__listObservabilityDashboardsInput) GetEnvironmentId() string { return v.EnvironmentId }
+
+// __listPrivateNetworksInput is used internally by genqlient
+type __listPrivateNetworksInput struct {
+	EnvironmentId string `json:"environmentId"`
+}
+
+// GetEnvironmentId returns __listPrivateNetworksInput.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *__listPrivateNetworksInput) GetEnvironmentId() string { return v.EnvironmentId }
+
+// __listProjectServicesInput is used internally by genqlient
+type __listProjectServicesInput struct {
+	ProjectId string `json:"projectId"`
+}
+
+// GetProjectId returns __listProjectServicesInput.ProjectId, and is useful for accessing the field via an interface.
+func (v *__listProjectServicesInput) GetProjectId() string { return v.ProjectId }
+
+// __listSealedVariablesInput is used internally by genqlient
+type __listSealedVariablesInput struct {
+	EnvironmentId string `json:"environmentId"`
+}
+
+// GetEnvironmentId returns __listSealedVariablesInput.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *__listSealedVariablesInput) GetEnvironmentId() string { return v.EnvironmentId }
+
 // __listServiceDomainsInput is used internally by genqlient
 type __listServiceDomainsInput struct {
 	EnvironmentId string `json:"environmentId"`
@@ -1172,6 +2396,26 @@ func (v *__listServiceDomainsInput) GetServiceId() string { return v.ServiceId }
 // GetProjectId returns __listServiceDomainsInput.ProjectId, and is useful for accessing the field via an interface.
 func (v *__listServiceDomainsInput) GetProjectId() string { return v.ProjectId }
 
+// __privateNetworkEndpointNameAvailableInput is used internally by genqlient
+type __privateNetworkEndpointNameAvailableInput struct {
+	EnvironmentId    string `json:"environmentId"`
+	PrivateNetworkId string `json:"privateNetworkId"`
+	Prefix           string `json:"prefix"`
+}
+
+// GetEnvironmentId returns __privateNetworkEndpointNameAvailableInput.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *__privateNetworkEndpointNameAvailableInput) GetEnvironmentId() string {
+	return v.EnvironmentId
+}
+
+// GetPrivateNetworkId returns __privateNetworkEndpointNameAvailableInput.PrivateNetworkId, and is useful for accessing the field via an interface.
+func (v *__privateNetworkEndpointNameAvailableInput) GetPrivateNetworkId() string {
+	return v.PrivateNetworkId
+}
+
+// GetPrefix returns __privateNetworkEndpointNameAvailableInput.Prefix, and is useful for accessing the field via an interface.
+func (v *__privateNetworkEndpointNameAvailableInput) GetPrefix() string { return v.Prefix }
+
 // __redeployServiceInstanceInput is used internally by genqlient
 type __redeployServiceInstanceInput struct {
 	EnvironmentId string `json:"environmentId"`
@@ -1184,6 +2428,106 @@ func (v *__redeployServiceInstanceInput) GetEnvironmentId() string { return v.En
 // GetServiceId returns __redeployServiceInstanceInput.ServiceId, and is useful for accessing the field via an interface.
 func (v *__redeployServiceInstanceInput) GetServiceId() string { return v.ServiceId }
 
+// __removeUsageLimitInput is used internally by genqlient
+type __removeUsageLimitInput struct {
+	Input UsageLimitRemoveInput `json:"input"`
+}
+
+// GetInput returns __removeUsageLimitInput.Input, and is useful for accessing the field via an interface.
+func (v *__removeUsageLimitInput) GetInput() UsageLimitRemoveInput { return v.Input }
+
+// __renamePrivateNetworkEndpointInput is used internally by genqlient
+type __renamePrivateNetworkEndpointInput struct {
+	Id               string `json:"id"`
+	PrivateNetworkId string `json:"privateNetworkId"`
+	DnsName          string `json:"dnsName"`
+}
+
+// GetId returns __renamePrivateNetworkEndpointInput.Id, and is useful for accessing the field via an interface.
+func (v *__renamePrivateNetworkEndpointInput) GetId() string { return v.Id }
+
+// GetPrivateNetworkId returns __renamePrivateNetworkEndpointInput.PrivateNetworkId, and is useful for accessing the field via an interface.
+func (v *__renamePrivateNetworkEndpointInput) GetPrivateNetworkId() string { return v.PrivateNetworkId }
+
+// GetDnsName returns __renamePrivateNetworkEndpointInput.DnsName, and is useful for accessing the field via an interface.
+func (v *__renamePrivateNetworkEndpointInput) GetDnsName() string { return v.DnsName }
+
+// __resetBucketCredentialsInput is used internally by genqlient
+type __resetBucketCredentialsInput struct {
+	BucketId      string `json:"bucketId"`
+	EnvironmentId string `json:"environmentId"`
+	ProjectId     string `json:"projectId"`
+}
+
+// GetBucketId returns __resetBucketCredentialsInput.BucketId, and is useful for accessing the field via an interface.
+func (v *__resetBucketCredentialsInput) GetBucketId() string { return v.BucketId }
+
+// GetEnvironmentId returns __resetBucketCredentialsInput.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *__resetBucketCredentialsInput) GetEnvironmentId() string { return v.EnvironmentId }
+
+// GetProjectId returns __resetBucketCredentialsInput.ProjectId, and is useful for accessing the field via an interface.
+func (v *__resetBucketCredentialsInput) GetProjectId() string { return v.ProjectId }
+
+// __resetObservabilityDashboardInput is used internally by genqlient
+type __resetObservabilityDashboardInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __resetObservabilityDashboardInput.Id, and is useful for accessing the field via an interface.
+func (v *__resetObservabilityDashboardInput) GetId() string { return v.Id }
+
+// __rollbackDeploymentInput is used internally by genqlient
+type __rollbackDeploymentInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __rollbackDeploymentInput.Id, and is useful for accessing the field via an interface.
+func (v *__rollbackDeploymentInput) GetId() string { return v.Id }
+
+// __scheduleDeleteProjectInput is used internally by genqlient
+type __scheduleDeleteProjectInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __scheduleDeleteProjectInput.Id, and is useful for accessing the field via an interface.
+func (v *__scheduleDeleteProjectInput) GetId() string { return v.Id }
+
+// __setUsageLimitInput is used internally by genqlient
+type __setUsageLimitInput struct {
+	Input UsageLimitSetInput `json:"input"`
+}
+
+// GetInput returns __setUsageLimitInput.Input, and is useful for accessing the field via an interface.
+func (v *__setUsageLimitInput) GetInput() UsageLimitSetInput { return v.Input }
+
+// __stageEnvironmentChangesInput is used internally by genqlient
+type __stageEnvironmentChangesInput struct {
+	EnvironmentId string                 `json:"environmentId"`
+	Input         map[string]interface{} `json:"input"`
+	Merge         bool                   `json:"merge"`
+}
+
+// GetEnvironmentId returns __stageEnvironmentChangesInput.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *__stageEnvironmentChangesInput) GetEnvironmentId() string { return v.EnvironmentId }
+
+// GetInput returns __stageEnvironmentChangesInput.Input, and is useful for accessing the field via an interface.
+func (v *__stageEnvironmentChangesInput) GetInput() map[string]interface{} { return v.Input }
+
+// GetMerge returns __stageEnvironmentChangesInput.Merge, and is useful for accessing the field via an interface.
+func (v *__stageEnvironmentChangesInput) GetMerge() bool { return v.Merge }
+
+// __updateBucketInput is used internally by genqlient
+type __updateBucketInput struct {
+	Id    string            `json:"id"`
+	Input BucketUpdateInput `json:"input"`
+}
+
+// GetId returns __updateBucketInput.Id, and is useful for accessing the field via an interface.
+func (v *__updateBucketInput) GetId() string { return v.Id }
+
+// GetInput returns __updateBucketInput.Input, and is useful for accessing the field via an interface.
+func (v *__updateBucketInput) GetInput() BucketUpdateInput { return v.Input }
+
 // __updateCustomDomainInput is used internally by genqlient
 type __updateCustomDomainInput struct {
 	EnvironmentId string `json:"environmentId"`
@@ -1200,6 +2544,32 @@ func (v *__updateCustomDomainInput) GetId() string { return v.Id }
 // GetTargetPort returns __updateCustomDomainInput.TargetPort, and is useful for accessing the field via an interface.
 func (v *__updateCustomDomainInput) GetTargetPort() *int { return v.TargetPort }
 
+// __updateIntegrationInput is used internally by genqlient
+type __updateIntegrationInput struct {
+	Id    string                 `json:"id"`
+	Input IntegrationUpdateInput `json:"input"`
+}
+
+// GetId returns __updateIntegrationInput.Id, and is useful for accessing the field via an interface.
+func (v *__updateIntegrationInput) GetId() string { return v.Id }
+
+// GetInput returns __updateIntegrationInput.Input, and is useful for accessing the field via an interface.
+func (v *__updateIntegrationInput) GetInput() IntegrationUpdateInput { return v.Input }
+
+// __updateObservabilityDashboardInput is used internally by genqlient
+type __updateObservabilityDashboardInput struct {
+	Id    string                              `json:"id"`
+	Input []ObservabilityDashboardUpdateInput `json:"input"`
+}
+
+// GetId returns __updateObservabilityDashboardInput.Id, and is useful for accessing the field via an interface.
+func (v *__updateObservabilityDashboardInput) GetId() string { return v.Id }
+
+// GetInput returns __updateObservabilityDashboardInput.Input, and is useful for accessing the field via an interface.
+func (v *__updateObservabilityDashboardInput) GetInput() []ObservabilityDashboardUpdateInput {
+	return v.Input
+}
+
 // __updateProjectInput is used internally by genqlient
 type __updateProjectInput struct {
 	Id    string             `json:"id"`
@@ -1284,20 +2654,95 @@ type __upsertVariableInput struct {
 // GetInput returns __upsertVariableInput.Input, and is useful for accessing the field via an interface.
 func (v *__upsertVariableInput) GetInput() VariableUpsertInput { return v.Input }
 
-// connectServiceResponse is returned by connectService on success.
-type connectServiceResponse struct {
-	// Connect a service to a source
-	ServiceConnect connectServiceServiceConnectService `json:"serviceConnect"`
+// cancelScheduledDeleteProjectResponse is returned by cancelScheduledDeleteProject on success.
+type cancelScheduledDeleteProjectResponse struct {
+	// Cancel scheduled deletion of a project
+	ProjectScheduleDeleteCancel bool `json:"projectScheduleDeleteCancel"`
 }
 
-// GetServiceConnect returns connectServiceResponse.ServiceConnect, and is useful for accessing the field via an interface.
-func (v *connectServiceResponse) GetServiceConnect() connectServiceServiceConnectService {
-	return v.ServiceConnect
+// GetProjectScheduleDeleteCancel returns cancelScheduledDeleteProjectResponse.ProjectScheduleDeleteCancel, and is useful for accessing the field via an interface.
+func (v *cancelScheduledDeleteProjectResponse) GetProjectScheduleDeleteCancel() bool {
+	return v.ProjectScheduleDeleteCancel
 }
 
-// connectServiceServiceConnectService includes the requested fields of the GraphQL type Service.
-type connectServiceServiceConnectService struct {
-	Service `json:"-"`
+// checkServiceDomainAvailableResponse is returned by checkServiceDomainAvailable on success.
+type checkServiceDomainAvailableResponse struct {
+	// Checks if a service domain is available
+	ServiceDomainAvailable checkServiceDomainAvailableServiceDomainAvailable `json:"serviceDomainAvailable"`
+}
+
+// GetServiceDomainAvailable returns checkServiceDomainAvailableResponse.ServiceDomainAvailable, and is useful for accessing the field via an interface.
+func (v *checkServiceDomainAvailableResponse) GetServiceDomainAvailable() checkServiceDomainAvailableServiceDomainAvailable {
+	return v.ServiceDomainAvailable
+}
+
+// checkServiceDomainAvailableServiceDomainAvailable includes the requested fields of the GraphQL type DomainAvailable.
+type checkServiceDomainAvailableServiceDomainAvailable struct {
+	Available bool   `json:"available"`
+	Message   string `json:"message"`
+}
+
+// GetAvailable returns checkServiceDomainAvailableServiceDomainAvailable.Available, and is useful for accessing the field via an interface.
+func (v *checkServiceDomainAvailableServiceDomainAvailable) GetAvailable() bool { return v.Available }
+
+// GetMessage returns checkServiceDomainAvailableServiceDomainAvailable.Message, and is useful for accessing the field via an interface.
+func (v *checkServiceDomainAvailableServiceDomainAvailable) GetMessage() string { return v.Message }
+
+// clearEgressGatewaysResponse is returned by clearEgressGateways on success.
+type clearEgressGatewaysResponse struct {
+	// Clear all egress gateway associations for a service instance
+	EgressGatewayAssociationsClear bool `json:"egressGatewayAssociationsClear"`
+}
+
+// GetEgressGatewayAssociationsClear returns clearEgressGatewaysResponse.EgressGatewayAssociationsClear, and is useful for accessing the field via an interface.
+func (v *clearEgressGatewaysResponse) GetEgressGatewayAssociationsClear() bool {
+	return v.EgressGatewayAssociationsClear
+}
+
+// commitStagedChangesResponse is returned by commitStagedChanges on success.
+type commitStagedChangesResponse struct {
+	// Commits the staged changes for a single environment.
+	EnvironmentPatchCommitStaged string `json:"environmentPatchCommitStaged"`
+}
+
+// GetEnvironmentPatchCommitStaged returns commitStagedChangesResponse.EnvironmentPatchCommitStaged, and is useful for accessing the field via an interface.
+func (v *commitStagedChangesResponse) GetEnvironmentPatchCommitStaged() string {
+	return v.EnvironmentPatchCommitStaged
+}
+
+// configureSharedVariableResponse is returned by configureSharedVariable on success.
+type configureSharedVariableResponse struct {
+	// Configure a shared variable.
+	SharedVariableConfigure configureSharedVariableSharedVariableConfigureVariable `json:"sharedVariableConfigure"`
+}
+
+// GetSharedVariableConfigure returns configureSharedVariableResponse.SharedVariableConfigure, and is useful for accessing the field via an interface.
+func (v *configureSharedVariableResponse) GetSharedVariableConfigure() configureSharedVariableSharedVariableConfigureVariable {
+	return v.SharedVariableConfigure
+}
+
+// configureSharedVariableSharedVariableConfigureVariable includes the requested fields of the GraphQL type Variable.
+type configureSharedVariableSharedVariableConfigureVariable struct {
+	Id string `json:"id"`
+}
+
+// GetId returns configureSharedVariableSharedVariableConfigureVariable.Id, and is useful for accessing the field via an interface.
+func (v *configureSharedVariableSharedVariableConfigureVariable) GetId() string { return v.Id }
+
+// connectServiceResponse is returned by connectService on success.
+type connectServiceResponse struct {
+	// Connect a service to a source
+	ServiceConnect connectServiceServiceConnectService `json:"serviceConnect"`
+}
+
+// GetServiceConnect returns connectServiceResponse.ServiceConnect, and is useful for accessing the field via an interface.
+func (v *connectServiceResponse) GetServiceConnect() connectServiceServiceConnectService {
+	return v.ServiceConnect
+}
+
+// connectServiceServiceConnectService includes the requested fields of the GraphQL type Service.
+type connectServiceServiceConnectService struct {
+	Service `json:"-"`
 }
 
 // GetId returns connectServiceServiceConnectService.Id, and is useful for accessing the field via an interface.
@@ -1359,6 +2804,90 @@ func (v *connectServiceServiceConnectService) __premarshalJSON() (*__premarshalc
 	return &retval, nil
 }
 
+// createApiTokenResponse is returned by createApiToken on success.
+type createApiTokenResponse struct {
+	// Creates a new API token.
+	ApiTokenCreate string `json:"apiTokenCreate"`
+}
+
+// GetApiTokenCreate returns createApiTokenResponse.ApiTokenCreate, and is useful for accessing the field via an interface.
+func (v *createApiTokenResponse) GetApiTokenCreate() string { return v.ApiTokenCreate }
+
+// createBucketBucketCreateBucket includes the requested fields of the GraphQL type Bucket.
+type createBucketBucketCreateBucket struct {
+	Bucket `json:"-"`
+}
+
+// GetId returns createBucketBucketCreateBucket.Id, and is useful for accessing the field via an interface.
+func (v *createBucketBucketCreateBucket) GetId() string { return v.Bucket.Id }
+
+// GetName returns createBucketBucketCreateBucket.Name, and is useful for accessing the field via an interface.
+func (v *createBucketBucketCreateBucket) GetName() string { return v.Bucket.Name }
+
+// GetProjectId returns createBucketBucketCreateBucket.ProjectId, and is useful for accessing the field via an interface.
+func (v *createBucketBucketCreateBucket) GetProjectId() string { return v.Bucket.ProjectId }
+
+func (v *createBucketBucketCreateBucket) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*createBucketBucketCreateBucket
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.createBucketBucketCreateBucket = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Bucket)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalcreateBucketBucketCreateBucket struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	ProjectId string `json:"projectId"`
+}
+
+func (v *createBucketBucketCreateBucket) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *createBucketBucketCreateBucket) __premarshalJSON() (*__premarshalcreateBucketBucketCreateBucket, error) {
+	var retval __premarshalcreateBucketBucketCreateBucket
+
+	retval.Id = v.Bucket.Id
+	retval.Name = v.Bucket.Name
+	retval.ProjectId = v.Bucket.ProjectId
+	return &retval, nil
+}
+
+// createBucketResponse is returned by createBucket on success.
+type createBucketResponse struct {
+	// Create a bucket in a project
+	BucketCreate createBucketBucketCreateBucket `json:"bucketCreate"`
+}
+
+// GetBucketCreate returns createBucketResponse.BucketCreate, and is useful for accessing the field via an interface.
+func (v *createBucketResponse) GetBucketCreate() createBucketBucketCreateBucket {
+	return v.BucketCreate
+}
+
 // createCustomDomainCustomDomainCreateCustomDomain includes the requested fields of the GraphQL type CustomDomain.
 type createCustomDomainCustomDomainCreateCustomDomain struct {
 	CustomDomain `json:"-"`
@@ -1462,6 +2991,79 @@ func (v *createCustomDomainResponse) GetCustomDomainCreate() createCustomDomainC
 	return v.CustomDomainCreate
 }
 
+// createEgressGatewayEgressGatewayAssociationCreateEgressGateway includes the requested fields of the GraphQL type EgressGateway.
+type createEgressGatewayEgressGatewayAssociationCreateEgressGateway struct {
+	EgressGateway `json:"-"`
+}
+
+// GetIpv4 returns createEgressGatewayEgressGatewayAssociationCreateEgressGateway.Ipv4, and is useful for accessing the field via an interface.
+func (v *createEgressGatewayEgressGatewayAssociationCreateEgressGateway) GetIpv4() string {
+	return v.EgressGateway.Ipv4
+}
+
+// GetRegion returns createEgressGatewayEgressGatewayAssociationCreateEgressGateway.Region, and is useful for accessing the field via an interface.
+func (v *createEgressGatewayEgressGatewayAssociationCreateEgressGateway) GetRegion() string {
+	return v.EgressGateway.Region
+}
+
+func (v *createEgressGatewayEgressGatewayAssociationCreateEgressGateway) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*createEgressGatewayEgressGatewayAssociationCreateEgressGateway
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.createEgressGatewayEgressGatewayAssociationCreateEgressGateway = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.EgressGateway)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalcreateEgressGatewayEgressGatewayAssociationCreateEgressGateway struct {
+	Ipv4 string `json:"ipv4"`
+
+	Region string `json:"region"`
+}
+
+func (v *createEgressGatewayEgressGatewayAssociationCreateEgressGateway) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *createEgressGatewayEgressGatewayAssociationCreateEgressGateway) __premarshalJSON() (*__premarshalcreateEgressGatewayEgressGatewayAssociationCreateEgressGateway, error) {
+	var retval __premarshalcreateEgressGatewayEgressGatewayAssociationCreateEgressGateway
+
+	retval.Ipv4 = v.EgressGateway.Ipv4
+	retval.Region = v.EgressGateway.Region
+	return &retval, nil
+}
+
+// createEgressGatewayResponse is returned by createEgressGateway on success.
+type createEgressGatewayResponse struct {
+	// Create a new egress gateway association for a service instance
+	EgressGatewayAssociationCreate []createEgressGatewayEgressGatewayAssociationCreateEgressGateway `json:"egressGatewayAssociationCreate"`
+}
+
+// GetEgressGatewayAssociationCreate returns createEgressGatewayResponse.EgressGatewayAssociationCreate, and is useful for accessing the field via an interface.
+func (v *createEgressGatewayResponse) GetEgressGatewayAssociationCreate() []createEgressGatewayEgressGatewayAssociationCreateEgressGateway {
+	return v.EgressGatewayAssociationCreate
+}
+
 // createEnvironmentEnvironmentCreateEnvironment includes the requested fields of the GraphQL type Environment.
 type createEnvironmentEnvironmentCreateEnvironment struct {
 	Environment `json:"-"`
@@ -1539,47 +3141,38 @@ func (v *createEnvironmentResponse) GetEnvironmentCreate() createEnvironmentEnvi
 	return v.EnvironmentCreate
 }
 
-// createProjectProjectCreateProject includes the requested fields of the GraphQL type Project.
-type createProjectProjectCreateProject struct {
-	Project `json:"-"`
+// createIntegrationIntegrationCreateIntegration includes the requested fields of the GraphQL type Integration.
+type createIntegrationIntegrationCreateIntegration struct {
+	Integration `json:"-"`
 }
 
-// GetId returns createProjectProjectCreateProject.Id, and is useful for accessing the field via an interface.
-func (v *createProjectProjectCreateProject) GetId() string { return v.Project.Id }
-
-// GetName returns createProjectProjectCreateProject.Name, and is useful for accessing the field via an interface.
-func (v *createProjectProjectCreateProject) GetName() string { return v.Project.Name }
-
-// GetDescription returns createProjectProjectCreateProject.Description, and is useful for accessing the field via an interface.
-func (v *createProjectProjectCreateProject) GetDescription() string { return v.Project.Description }
-
-// GetIsPublic returns createProjectProjectCreateProject.IsPublic, and is useful for accessing the field via an interface.
-func (v *createProjectProjectCreateProject) GetIsPublic() bool { return v.Project.IsPublic }
+// GetId returns createIntegrationIntegrationCreateIntegration.Id, and is useful for accessing the field via an interface.
+func (v *createIntegrationIntegrationCreateIntegration) GetId() string { return v.Integration.Id }
 
-// GetPrDeploys returns createProjectProjectCreateProject.PrDeploys, and is useful for accessing the field via an interface.
-func (v *createProjectProjectCreateProject) GetPrDeploys() bool { return v.Project.PrDeploys }
+// GetName returns createIntegrationIntegrationCreateIntegration.Name, and is useful for accessing the field via an interface.
+func (v *createIntegrationIntegrationCreateIntegration) GetName() string { return v.Integration.Name }
 
-// GetWorkspace returns createProjectProjectCreateProject.Workspace, and is useful for accessing the field via an interface.
-func (v *createProjectProjectCreateProject) GetWorkspace() *ProjectWorkspace {
-	return v.Project.Workspace
+// GetProjectId returns createIntegrationIntegrationCreateIntegration.ProjectId, and is useful for accessing the field via an interface.
+func (v *createIntegrationIntegrationCreateIntegration) GetProjectId() string {
+	return v.Integration.ProjectId
 }
 
-// GetEnvironments returns createProjectProjectCreateProject.Environments, and is useful for accessing the field via an interface.
-func (v *createProjectProjectCreateProject) GetEnvironments() ProjectEnvironmentsProjectEnvironmentsConnection {
-	return v.Project.Environments
+// GetConfig returns createIntegrationIntegrationCreateIntegration.Config, and is useful for accessing the field via an interface.
+func (v *createIntegrationIntegrationCreateIntegration) GetConfig() map[string]interface{} {
+	return v.Integration.Config
 }
 
-func (v *createProjectProjectCreateProject) UnmarshalJSON(b []byte) error {
+func (v *createIntegrationIntegrationCreateIntegration) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*createProjectProjectCreateProject
+		*createIntegrationIntegrationCreateIntegration
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.createProjectProjectCreateProject = v
+	firstPass.createIntegrationIntegrationCreateIntegration = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -1587,30 +3180,24 @@ func (v *createProjectProjectCreateProject) UnmarshalJSON(b []byte) error {
 	}
 
 	err = json.Unmarshal(
-		b, &v.Project)
+		b, &v.Integration)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-type __premarshalcreateProjectProjectCreateProject struct {
+type __premarshalcreateIntegrationIntegrationCreateIntegration struct {
 	Id string `json:"id"`
 
 	Name string `json:"name"`
 
-	Description string `json:"description"`
-
-	IsPublic bool `json:"isPublic"`
-
-	PrDeploys bool `json:"prDeploys"`
-
-	Workspace *ProjectWorkspace `json:"workspace"`
+	ProjectId string `json:"projectId"`
 
-	Environments ProjectEnvironmentsProjectEnvironmentsConnection `json:"environments"`
+	Config map[string]interface{} `json:"config"`
 }
 
-func (v *createProjectProjectCreateProject) MarshalJSON() ([]byte, error) {
+func (v *createIntegrationIntegrationCreateIntegration) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -1618,82 +3205,79 @@ func (v *createProjectProjectCreateProject) MarshalJSON() ([]byte, error) {
 	return json.Marshal(premarshaled)
 }
 
-func (v *createProjectProjectCreateProject) __premarshalJSON() (*__premarshalcreateProjectProjectCreateProject, error) {
-	var retval __premarshalcreateProjectProjectCreateProject
+func (v *createIntegrationIntegrationCreateIntegration) __premarshalJSON() (*__premarshalcreateIntegrationIntegrationCreateIntegration, error) {
+	var retval __premarshalcreateIntegrationIntegrationCreateIntegration
 
-	retval.Id = v.Project.Id
-	retval.Name = v.Project.Name
-	retval.Description = v.Project.Description
-	retval.IsPublic = v.Project.IsPublic
-	retval.PrDeploys = v.Project.PrDeploys
-	retval.Workspace = v.Project.Workspace
-	retval.Environments = v.Project.Environments
+	retval.Id = v.Integration.Id
+	retval.Name = v.Integration.Name
+	retval.ProjectId = v.Integration.ProjectId
+	retval.Config = v.Integration.Config
 	return &retval, nil
 }
 
-// createProjectResponse is returned by createProject on success.
-type createProjectResponse struct {
-	// Creates a new project.
-	ProjectCreate createProjectProjectCreateProject `json:"projectCreate"`
+// createIntegrationResponse is returned by createIntegration on success.
+type createIntegrationResponse struct {
+	// Create an integration for a project
+	IntegrationCreate createIntegrationIntegrationCreateIntegration `json:"integrationCreate"`
 }
 
-// GetProjectCreate returns createProjectResponse.ProjectCreate, and is useful for accessing the field via an interface.
-func (v *createProjectResponse) GetProjectCreate() createProjectProjectCreateProject {
-	return v.ProjectCreate
+// GetIntegrationCreate returns createIntegrationResponse.IntegrationCreate, and is useful for accessing the field via an interface.
+func (v *createIntegrationResponse) GetIntegrationCreate() createIntegrationIntegrationCreateIntegration {
+	return v.IntegrationCreate
 }
 
-// createServiceDomainResponse is returned by createServiceDomain on success.
-type createServiceDomainResponse struct {
-	// Creates a new service domain.
-	ServiceDomainCreate createServiceDomainServiceDomainCreateServiceDomain `json:"serviceDomainCreate"`
+// createObservabilityDashboardResponse is returned by createObservabilityDashboard on success.
+type createObservabilityDashboardResponse struct {
+	// Create an observability dashboard
+	ObservabilityDashboardCreate bool `json:"observabilityDashboardCreate"`
 }
 
-// GetServiceDomainCreate returns createServiceDomainResponse.ServiceDomainCreate, and is useful for accessing the field via an interface.
-func (v *createServiceDomainResponse) GetServiceDomainCreate() createServiceDomainServiceDomainCreateServiceDomain {
-	return v.ServiceDomainCreate
+// GetObservabilityDashboardCreate returns createObservabilityDashboardResponse.ObservabilityDashboardCreate, and is useful for accessing the field via an interface.
+func (v *createObservabilityDashboardResponse) GetObservabilityDashboardCreate() bool {
+	return v.ObservabilityDashboardCreate
 }
 
-// createServiceDomainServiceDomainCreateServiceDomain includes the requested fields of the GraphQL type ServiceDomain.
-type createServiceDomainServiceDomainCreateServiceDomain struct {
-	ServiceDomain `json:"-"`
+// createOrGetPrivateNetworkEndpointPrivateNetworkEndpointCreateOrGetPrivateNetworkEndpoint includes the requested fields of the GraphQL type PrivateNetworkEndpoint.
+type createOrGetPrivateNetworkEndpointPrivateNetworkEndpointCreateOrGetPrivateNetworkEndpoint struct {
+	PrivateNetworkEndpoint `json:"-"`
 }
 
-// GetId returns createServiceDomainServiceDomainCreateServiceDomain.Id, and is useful for accessing the field via an interface.
-func (v *createServiceDomainServiceDomainCreateServiceDomain) GetId() string {
-	return v.ServiceDomain.Id
+// GetPublicId returns createOrGetPrivateNetworkEndpointPrivateNetworkEndpointCreateOrGetPrivateNetworkEndpoint.PublicId, and is useful for accessing the field via an interface.
+func (v *createOrGetPrivateNetworkEndpointPrivateNetworkEndpointCreateOrGetPrivateNetworkEndpoint) GetPublicId() string {
+	return v.PrivateNetworkEndpoint.PublicId
 }
 
-// GetDomain returns createServiceDomainServiceDomainCreateServiceDomain.Domain, and is useful for accessing the field via an interface.
-func (v *createServiceDomainServiceDomainCreateServiceDomain) GetDomain() string {
-	return v.ServiceDomain.Domain
+// GetDnsName returns createOrGetPrivateNetworkEndpointPrivateNetworkEndpointCreateOrGetPrivateNetworkEndpoint.DnsName, and is useful for accessing the field via an interface.
+func (v *createOrGetPrivateNetworkEndpointPrivateNetworkEndpointCreateOrGetPrivateNetworkEndpoint) GetDnsName() string {
+	return v.PrivateNetworkEndpoint.DnsName
 }
 
-// GetSuffix returns createServiceDomainServiceDomainCreateServiceDomain.Suffix, and is useful for accessing the field via an interface.
-func (v *createServiceDomainServiceDomainCreateServiceDomain) GetSuffix() string {
-	return v.ServiceDomain.Suffix
+// GetPrivateIps returns createOrGetPrivateNetworkEndpointPrivateNetworkEndpointCreateOrGetPrivateNetworkEndpoint.PrivateIps, and is useful for accessing the field via an interface.
+func (v *createOrGetPrivateNetworkEndpointPrivateNetworkEndpointCreateOrGetPrivateNetworkEndpoint) GetPrivateIps() []string {
+	return v.PrivateNetworkEndpoint.PrivateIps
 }
 
-// GetEnvironmentId returns createServiceDomainServiceDomainCreateServiceDomain.EnvironmentId, and is useful for accessing the field via an interface.
-func (v *createServiceDomainServiceDomainCreateServiceDomain) GetEnvironmentId() string {
-	return v.ServiceDomain.EnvironmentId
+// GetServiceInstanceId returns createOrGetPrivateNetworkEndpointPrivateNetworkEndpointCreateOrGetPrivateNetworkEndpoint.ServiceInstanceId, and is useful for accessing the field via an interface.
+func (v *createOrGetPrivateNetworkEndpointPrivateNetworkEndpointCreateOrGetPrivateNetworkEndpoint) GetServiceInstanceId() string {
+	return v.PrivateNetworkEndpoint.ServiceInstanceId
 }
 
-// GetServiceId returns createServiceDomainServiceDomainCreateServiceDomain.ServiceId, and is useful for accessing the field via an interface.
-func (v *createServiceDomainServiceDomainCreateServiceDomain) GetServiceId() string {
-	return v.ServiceDomain.ServiceId
+// GetTags returns createOrGetPrivateNetworkEndpointPrivateNetworkEndpointCreateOrGetPrivateNetworkEndpoint.Tags, and is useful for accessing the field via an interface.
+func (v *createOrGetPrivateNetworkEndpointPrivateNetworkEndpointCreateOrGetPrivateNetworkEndpoint) GetTags() []string {
+	return v.PrivateNetworkEndpoint.Tags
 }
 
-func (v *createServiceDomainServiceDomainCreateServiceDomain) UnmarshalJSON(b []byte) error {
+func (v *createOrGetPrivateNetworkEndpointPrivateNetworkEndpointCreateOrGetPrivateNetworkEndpoint) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*createServiceDomainServiceDomainCreateServiceDomain
+		*createOrGetPrivateNetworkEndpointPrivateNetworkEndpointCreateOrGetPrivateNetworkEndpoint
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.createServiceDomainServiceDomainCreateServiceDomain = v
+	firstPass.createOrGetPrivateNetworkEndpointPrivateNetworkEndpointCreateOrGetPrivateNetworkEndpoint = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -1701,26 +3285,26 @@ func (v *createServiceDomainServiceDomainCreateServiceDomain) UnmarshalJSON(b []
 	}
 
 	err = json.Unmarshal(
-		b, &v.ServiceDomain)
+		b, &v.PrivateNetworkEndpoint)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-type __premarshalcreateServiceDomainServiceDomainCreateServiceDomain struct {
-	Id string `json:"id"`
+type __premarshalcreateOrGetPrivateNetworkEndpointPrivateNetworkEndpointCreateOrGetPrivateNetworkEndpoint struct {
+	PublicId string `json:"publicId"`
 
-	Domain string `json:"domain"`
+	DnsName string `json:"dnsName"`
 
-	Suffix string `json:"suffix"`
+	PrivateIps []string `json:"privateIps"`
 
-	EnvironmentId string `json:"environmentId"`
+	ServiceInstanceId string `json:"serviceInstanceId"`
 
-	ServiceId string `json:"serviceId"`
+	Tags []string `json:"tags"`
 }
 
-func (v *createServiceDomainServiceDomainCreateServiceDomain) MarshalJSON() ([]byte, error) {
+func (v *createOrGetPrivateNetworkEndpointPrivateNetworkEndpointCreateOrGetPrivateNetworkEndpoint) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -1728,53 +3312,99 @@ func (v *createServiceDomainServiceDomainCreateServiceDomain) MarshalJSON() ([]b
 	return json.Marshal(premarshaled)
 }
 
-func (v *createServiceDomainServiceDomainCreateServiceDomain) __premarshalJSON() (*__premarshalcreateServiceDomainServiceDomainCreateServiceDomain, error) {
-	var retval __premarshalcreateServiceDomainServiceDomainCreateServiceDomain
+func (v *createOrGetPrivateNetworkEndpointPrivateNetworkEndpointCreateOrGetPrivateNetworkEndpoint) __premarshalJSON() (*__premarshalcreateOrGetPrivateNetworkEndpointPrivateNetworkEndpointCreateOrGetPrivateNetworkEndpoint, error) {
+	var retval __premarshalcreateOrGetPrivateNetworkEndpointPrivateNetworkEndpointCreateOrGetPrivateNetworkEndpoint
 
-	retval.Id = v.ServiceDomain.Id
-	retval.Domain = v.ServiceDomain.Domain
-	retval.Suffix = v.ServiceDomain.Suffix
-	retval.EnvironmentId = v.ServiceDomain.EnvironmentId
-	retval.ServiceId = v.ServiceDomain.ServiceId
+	retval.PublicId = v.PrivateNetworkEndpoint.PublicId
+	retval.DnsName = v.PrivateNetworkEndpoint.DnsName
+	retval.PrivateIps = v.PrivateNetworkEndpoint.PrivateIps
+	retval.ServiceInstanceId = v.PrivateNetworkEndpoint.ServiceInstanceId
+	retval.Tags = v.PrivateNetworkEndpoint.Tags
 	return &retval, nil
 }
 
-// createServiceResponse is returned by createService on success.
-type createServiceResponse struct {
-	// Creates a new service.
-	ServiceCreate createServiceServiceCreateService `json:"serviceCreate"`
+// createOrGetPrivateNetworkEndpointResponse is returned by createOrGetPrivateNetworkEndpoint on success.
+type createOrGetPrivateNetworkEndpointResponse struct {
+	// Create or get a private network endpoint.
+	PrivateNetworkEndpointCreateOrGet createOrGetPrivateNetworkEndpointPrivateNetworkEndpointCreateOrGetPrivateNetworkEndpoint `json:"privateNetworkEndpointCreateOrGet"`
 }
 
-// GetServiceCreate returns createServiceResponse.ServiceCreate, and is useful for accessing the field via an interface.
-func (v *createServiceResponse) GetServiceCreate() createServiceServiceCreateService {
-	return v.ServiceCreate
+// GetPrivateNetworkEndpointCreateOrGet returns createOrGetPrivateNetworkEndpointResponse.PrivateNetworkEndpointCreateOrGet, and is useful for accessing the field via an interface.
+func (v *createOrGetPrivateNetworkEndpointResponse) GetPrivateNetworkEndpointCreateOrGet() createOrGetPrivateNetworkEndpointPrivateNetworkEndpointCreateOrGetPrivateNetworkEndpoint {
+	return v.PrivateNetworkEndpointCreateOrGet
 }
 
-// createServiceServiceCreateService includes the requested fields of the GraphQL type Service.
-type createServiceServiceCreateService struct {
-	Service `json:"-"`
+// createOrGetPrivateNetworkPrivateNetworkCreateOrGetPrivateNetwork includes the requested fields of the GraphQL type PrivateNetwork.
+type createOrGetPrivateNetworkPrivateNetworkCreateOrGetPrivateNetwork struct {
+	PublicId string `json:"publicId"`
+	Name     string `json:"name"`
 }
 
-// GetId returns createServiceServiceCreateService.Id, and is useful for accessing the field via an interface.
-func (v *createServiceServiceCreateService) GetId() string { return v.Service.Id }
-
-// GetName returns createServiceServiceCreateService.Name, and is useful for accessing the field via an interface.
-func (v *createServiceServiceCreateService) GetName() string { return v.Service.Name }
+// GetPublicId returns createOrGetPrivateNetworkPrivateNetworkCreateOrGetPrivateNetwork.PublicId, and is useful for accessing the field via an interface.
+func (v *createOrGetPrivateNetworkPrivateNetworkCreateOrGetPrivateNetwork) GetPublicId() string {
+	return v.PublicId
+}
 
-// GetProjectId returns createServiceServiceCreateService.ProjectId, and is useful for accessing the field via an interface.
-func (v *createServiceServiceCreateService) GetProjectId() string { return v.Service.ProjectId }
+// GetName returns createOrGetPrivateNetworkPrivateNetworkCreateOrGetPrivateNetwork.Name, and is useful for accessing the field via an interface.
+func (v *createOrGetPrivateNetworkPrivateNetworkCreateOrGetPrivateNetwork) GetName() string {
+	return v.Name
+}
 
-func (v *createServiceServiceCreateService) UnmarshalJSON(b []byte) error {
+// createOrGetPrivateNetworkResponse is returned by createOrGetPrivateNetwork on success.
+type createOrGetPrivateNetworkResponse struct {
+	// Create or get a private network.
+	PrivateNetworkCreateOrGet createOrGetPrivateNetworkPrivateNetworkCreateOrGetPrivateNetwork `json:"privateNetworkCreateOrGet"`
+}
 
-	if string(b) == "null" {
-		return nil
-	}
+// GetPrivateNetworkCreateOrGet returns createOrGetPrivateNetworkResponse.PrivateNetworkCreateOrGet, and is useful for accessing the field via an interface.
+func (v *createOrGetPrivateNetworkResponse) GetPrivateNetworkCreateOrGet() createOrGetPrivateNetworkPrivateNetworkCreateOrGetPrivateNetwork {
+	return v.PrivateNetworkCreateOrGet
+}
+
+// createProjectProjectCreateProject includes the requested fields of the GraphQL type Project.
+type createProjectProjectCreateProject struct {
+	Project `json:"-"`
+}
+
+// GetId returns createProjectProjectCreateProject.Id, and is useful for accessing the field via an interface.
+func (v *createProjectProjectCreateProject) GetId() string { return v.Project.Id }
+
+// GetName returns createProjectProjectCreateProject.Name, and is useful for accessing the field via an interface.
+func (v *createProjectProjectCreateProject) GetName() string { return v.Project.Name }
+
+// GetDescription returns createProjectProjectCreateProject.Description, and is useful for accessing the field via an interface.
+func (v *createProjectProjectCreateProject) GetDescription() string { return v.Project.Description }
+
+// GetIsPublic returns createProjectProjectCreateProject.IsPublic, and is useful for accessing the field via an interface.
+func (v *createProjectProjectCreateProject) GetIsPublic() bool { return v.Project.IsPublic }
+
+// GetPrDeploys returns createProjectProjectCreateProject.PrDeploys, and is useful for accessing the field via an interface.
+func (v *createProjectProjectCreateProject) GetPrDeploys() bool { return v.Project.PrDeploys }
+
+// GetDeletedAt returns createProjectProjectCreateProject.DeletedAt, and is useful for accessing the field via an interface.
+func (v *createProjectProjectCreateProject) GetDeletedAt() time.Time { return v.Project.DeletedAt }
+
+// GetWorkspace returns createProjectProjectCreateProject.Workspace, and is useful for accessing the field via an interface.
+func (v *createProjectProjectCreateProject) GetWorkspace() *ProjectWorkspace {
+	return v.Project.Workspace
+}
+
+// GetEnvironments returns createProjectProjectCreateProject.Environments, and is useful for accessing the field via an interface.
+func (v *createProjectProjectCreateProject) GetEnvironments() ProjectEnvironmentsProjectEnvironmentsConnection {
+	return v.Project.Environments
+}
+
+func (v *createProjectProjectCreateProject) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
 
 	var firstPass struct {
-		*createServiceServiceCreateService
+		*createProjectProjectCreateProject
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.createServiceServiceCreateService = v
+	firstPass.createProjectProjectCreateProject = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -1782,22 +3412,32 @@ func (v *createServiceServiceCreateService) UnmarshalJSON(b []byte) error {
 	}
 
 	err = json.Unmarshal(
-		b, &v.Service)
+		b, &v.Project)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-type __premarshalcreateServiceServiceCreateService struct {
+type __premarshalcreateProjectProjectCreateProject struct {
 	Id string `json:"id"`
 
 	Name string `json:"name"`
 
-	ProjectId string `json:"projectId"`
+	Description string `json:"description"`
+
+	IsPublic bool `json:"isPublic"`
+
+	PrDeploys bool `json:"prDeploys"`
+
+	DeletedAt time.Time `json:"deletedAt"`
+
+	Workspace *ProjectWorkspace `json:"workspace"`
+
+	Environments ProjectEnvironmentsProjectEnvironmentsConnection `json:"environments"`
 }
 
-func (v *createServiceServiceCreateService) MarshalJSON() ([]byte, error) {
+func (v *createProjectProjectCreateProject) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -1805,64 +3445,88 @@ func (v *createServiceServiceCreateService) MarshalJSON() ([]byte, error) {
 	return json.Marshal(premarshaled)
 }
 
-func (v *createServiceServiceCreateService) __premarshalJSON() (*__premarshalcreateServiceServiceCreateService, error) {
-	var retval __premarshalcreateServiceServiceCreateService
+func (v *createProjectProjectCreateProject) __premarshalJSON() (*__premarshalcreateProjectProjectCreateProject, error) {
+	var retval __premarshalcreateProjectProjectCreateProject
 
-	retval.Id = v.Service.Id
-	retval.Name = v.Service.Name
-	retval.ProjectId = v.Service.ProjectId
+	retval.Id = v.Project.Id
+	retval.Name = v.Project.Name
+	retval.Description = v.Project.Description
+	retval.IsPublic = v.Project.IsPublic
+	retval.PrDeploys = v.Project.PrDeploys
+	retval.DeletedAt = v.Project.DeletedAt
+	retval.Workspace = v.Project.Workspace
+	retval.Environments = v.Project.Environments
 	return &retval, nil
 }
 
-// createTcpProxyResponse is returned by createTcpProxy on success.
-type createTcpProxyResponse struct {
-	// Creates a new TCP proxy for a service instance.
-	TcpProxyCreate createTcpProxyTcpProxyCreateTCPProxy `json:"tcpProxyCreate"`
+// createProjectResponse is returned by createProject on success.
+type createProjectResponse struct {
+	// Creates a new project.
+	ProjectCreate createProjectProjectCreateProject `json:"projectCreate"`
 }
 
-// GetTcpProxyCreate returns createTcpProxyResponse.TcpProxyCreate, and is useful for accessing the field via an interface.
-func (v *createTcpProxyResponse) GetTcpProxyCreate() createTcpProxyTcpProxyCreateTCPProxy {
-	return v.TcpProxyCreate
+// GetProjectCreate returns createProjectResponse.ProjectCreate, and is useful for accessing the field via an interface.
+func (v *createProjectResponse) GetProjectCreate() createProjectProjectCreateProject {
+	return v.ProjectCreate
 }
 
-// createTcpProxyTcpProxyCreateTCPProxy includes the requested fields of the GraphQL type TCPProxy.
-type createTcpProxyTcpProxyCreateTCPProxy struct {
-	TCPProxy `json:"-"`
+// createServiceDomainResponse is returned by createServiceDomain on success.
+type createServiceDomainResponse struct {
+	// Creates a new service domain.
+	ServiceDomainCreate createServiceDomainServiceDomainCreateServiceDomain `json:"serviceDomainCreate"`
 }
 
-// GetId returns createTcpProxyTcpProxyCreateTCPProxy.Id, and is useful for accessing the field via an interface.
-func (v *createTcpProxyTcpProxyCreateTCPProxy) GetId() string { return v.TCPProxy.Id }
+// GetServiceDomainCreate returns createServiceDomainResponse.ServiceDomainCreate, and is useful for accessing the field via an interface.
+func (v *createServiceDomainResponse) GetServiceDomainCreate() createServiceDomainServiceDomainCreateServiceDomain {
+	return v.ServiceDomainCreate
+}
 
-// GetApplicationPort returns createTcpProxyTcpProxyCreateTCPProxy.ApplicationPort, and is useful for accessing the field via an interface.
-func (v *createTcpProxyTcpProxyCreateTCPProxy) GetApplicationPort() int {
-	return v.TCPProxy.ApplicationPort
+// createServiceDomainServiceDomainCreateServiceDomain includes the requested fields of the GraphQL type ServiceDomain.
+type createServiceDomainServiceDomainCreateServiceDomain struct {
+	ServiceDomain `json:"-"`
 }
 
-// GetProxyPort returns createTcpProxyTcpProxyCreateTCPProxy.ProxyPort, and is useful for accessing the field via an interface.
-func (v *createTcpProxyTcpProxyCreateTCPProxy) GetProxyPort() int { return v.TCPProxy.ProxyPort }
+// GetId returns createServiceDomainServiceDomainCreateServiceDomain.Id, and is useful for accessing the field via an interface.
+func (v *createServiceDomainServiceDomainCreateServiceDomain) GetId() string {
+	return v.ServiceDomain.Id
+}
 
-// GetDomain returns createTcpProxyTcpProxyCreateTCPProxy.Domain, and is useful for accessing the field via an interface.
-func (v *createTcpProxyTcpProxyCreateTCPProxy) GetDomain() string { return v.TCPProxy.Domain }
+// GetDomain returns createServiceDomainServiceDomainCreateServiceDomain.Domain, and is useful for accessing the field via an interface.
+func (v *createServiceDomainServiceDomainCreateServiceDomain) GetDomain() string {
+	return v.ServiceDomain.Domain
+}
 
-// GetEnvironmentId returns createTcpProxyTcpProxyCreateTCPProxy.EnvironmentId, and is useful for accessing the field via an interface.
-func (v *createTcpProxyTcpProxyCreateTCPProxy) GetEnvironmentId() string {
-	return v.TCPProxy.EnvironmentId
+// GetSuffix returns createServiceDomainServiceDomainCreateServiceDomain.Suffix, and is useful for accessing the field via an interface.
+func (v *createServiceDomainServiceDomainCreateServiceDomain) GetSuffix() string {
+	return v.ServiceDomain.Suffix
 }
 
-// GetServiceId returns createTcpProxyTcpProxyCreateTCPProxy.ServiceId, and is useful for accessing the field via an interface.
-func (v *createTcpProxyTcpProxyCreateTCPProxy) GetServiceId() string { return v.TCPProxy.ServiceId }
+// GetTargetPort returns createServiceDomainServiceDomainCreateServiceDomain.TargetPort, and is useful for accessing the field via an interface.
+func (v *createServiceDomainServiceDomainCreateServiceDomain) GetTargetPort() int {
+	return v.ServiceDomain.TargetPort
+}
 
-func (v *createTcpProxyTcpProxyCreateTCPProxy) UnmarshalJSON(b []byte) error {
+// GetEnvironmentId returns createServiceDomainServiceDomainCreateServiceDomain.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *createServiceDomainServiceDomainCreateServiceDomain) GetEnvironmentId() string {
+	return v.ServiceDomain.EnvironmentId
+}
+
+// GetServiceId returns createServiceDomainServiceDomainCreateServiceDomain.ServiceId, and is useful for accessing the field via an interface.
+func (v *createServiceDomainServiceDomainCreateServiceDomain) GetServiceId() string {
+	return v.ServiceDomain.ServiceId
+}
+
+func (v *createServiceDomainServiceDomainCreateServiceDomain) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*createTcpProxyTcpProxyCreateTCPProxy
+		*createServiceDomainServiceDomainCreateServiceDomain
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.createTcpProxyTcpProxyCreateTCPProxy = v
+	firstPass.createServiceDomainServiceDomainCreateServiceDomain = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -1870,28 +3534,28 @@ func (v *createTcpProxyTcpProxyCreateTCPProxy) UnmarshalJSON(b []byte) error {
 	}
 
 	err = json.Unmarshal(
-		b, &v.TCPProxy)
+		b, &v.ServiceDomain)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-type __premarshalcreateTcpProxyTcpProxyCreateTCPProxy struct {
+type __premarshalcreateServiceDomainServiceDomainCreateServiceDomain struct {
 	Id string `json:"id"`
 
-	ApplicationPort int `json:"applicationPort"`
+	Domain string `json:"domain"`
 
-	ProxyPort int `json:"proxyPort"`
+	Suffix string `json:"suffix"`
 
-	Domain string `json:"domain"`
+	TargetPort int `json:"targetPort"`
 
 	EnvironmentId string `json:"environmentId"`
 
 	ServiceId string `json:"serviceId"`
 }
 
-func (v *createTcpProxyTcpProxyCreateTCPProxy) MarshalJSON() ([]byte, error) {
+func (v *createServiceDomainServiceDomainCreateServiceDomain) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -1899,56 +3563,54 @@ func (v *createTcpProxyTcpProxyCreateTCPProxy) MarshalJSON() ([]byte, error) {
 	return json.Marshal(premarshaled)
 }
 
-func (v *createTcpProxyTcpProxyCreateTCPProxy) __premarshalJSON() (*__premarshalcreateTcpProxyTcpProxyCreateTCPProxy, error) {
-	var retval __premarshalcreateTcpProxyTcpProxyCreateTCPProxy
+func (v *createServiceDomainServiceDomainCreateServiceDomain) __premarshalJSON() (*__premarshalcreateServiceDomainServiceDomainCreateServiceDomain, error) {
+	var retval __premarshalcreateServiceDomainServiceDomainCreateServiceDomain
 
-	retval.Id = v.TCPProxy.Id
-	retval.ApplicationPort = v.TCPProxy.ApplicationPort
-	retval.ProxyPort = v.TCPProxy.ProxyPort
-	retval.Domain = v.TCPProxy.Domain
-	retval.EnvironmentId = v.TCPProxy.EnvironmentId
-	retval.ServiceId = v.TCPProxy.ServiceId
+	retval.Id = v.ServiceDomain.Id
+	retval.Domain = v.ServiceDomain.Domain
+	retval.Suffix = v.ServiceDomain.Suffix
+	retval.TargetPort = v.ServiceDomain.TargetPort
+	retval.EnvironmentId = v.ServiceDomain.EnvironmentId
+	retval.ServiceId = v.ServiceDomain.ServiceId
 	return &retval, nil
 }
 
-// createVolumeResponse is returned by createVolume on success.
-type createVolumeResponse struct {
-	// Create a persistent volume in a project
-	VolumeCreate createVolumeVolumeCreateVolume `json:"volumeCreate"`
+// createServiceResponse is returned by createService on success.
+type createServiceResponse struct {
+	// Creates a new service.
+	ServiceCreate createServiceServiceCreateService `json:"serviceCreate"`
 }
 
-// GetVolumeCreate returns createVolumeResponse.VolumeCreate, and is useful for accessing the field via an interface.
-func (v *createVolumeResponse) GetVolumeCreate() createVolumeVolumeCreateVolume {
-	return v.VolumeCreate
+// GetServiceCreate returns createServiceResponse.ServiceCreate, and is useful for accessing the field via an interface.
+func (v *createServiceResponse) GetServiceCreate() createServiceServiceCreateService {
+	return v.ServiceCreate
 }
 
-// createVolumeVolumeCreateVolume includes the requested fields of the GraphQL type Volume.
-type createVolumeVolumeCreateVolume struct {
-	Volume `json:"-"`
+// createServiceServiceCreateService includes the requested fields of the GraphQL type Service.
+type createServiceServiceCreateService struct {
+	Service `json:"-"`
 }
 
-// GetId returns createVolumeVolumeCreateVolume.Id, and is useful for accessing the field via an interface.
-func (v *createVolumeVolumeCreateVolume) GetId() string { return v.Volume.Id }
+// GetId returns createServiceServiceCreateService.Id, and is useful for accessing the field via an interface.
+func (v *createServiceServiceCreateService) GetId() string { return v.Service.Id }
 
-// GetName returns createVolumeVolumeCreateVolume.Name, and is useful for accessing the field via an interface.
-func (v *createVolumeVolumeCreateVolume) GetName() string { return v.Volume.Name }
+// GetName returns createServiceServiceCreateService.Name, and is useful for accessing the field via an interface.
+func (v *createServiceServiceCreateService) GetName() string { return v.Service.Name }
 
-// GetVolumeInstances returns createVolumeVolumeCreateVolume.VolumeInstances, and is useful for accessing the field via an interface.
-func (v *createVolumeVolumeCreateVolume) GetVolumeInstances() VolumeVolumeInstancesVolumeVolumeInstancesConnection {
-	return v.Volume.VolumeInstances
-}
+// GetProjectId returns createServiceServiceCreateService.ProjectId, and is useful for accessing the field via an interface.
+func (v *createServiceServiceCreateService) GetProjectId() string { return v.Service.ProjectId }
 
-func (v *createVolumeVolumeCreateVolume) UnmarshalJSON(b []byte) error {
+func (v *createServiceServiceCreateService) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*createVolumeVolumeCreateVolume
+		*createServiceServiceCreateService
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.createVolumeVolumeCreateVolume = v
+	firstPass.createServiceServiceCreateService = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -1956,22 +3618,22 @@ func (v *createVolumeVolumeCreateVolume) UnmarshalJSON(b []byte) error {
 	}
 
 	err = json.Unmarshal(
-		b, &v.Volume)
+		b, &v.Service)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-type __premarshalcreateVolumeVolumeCreateVolume struct {
+type __premarshalcreateServiceServiceCreateService struct {
 	Id string `json:"id"`
 
 	Name string `json:"name"`
 
-	VolumeInstances VolumeVolumeInstancesVolumeVolumeInstancesConnection `json:"volumeInstances"`
+	ProjectId string `json:"projectId"`
 }
 
-func (v *createVolumeVolumeCreateVolume) MarshalJSON() ([]byte, error) {
+func (v *createServiceServiceCreateService) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -1979,131 +3641,60 @@ func (v *createVolumeVolumeCreateVolume) MarshalJSON() ([]byte, error) {
 	return json.Marshal(premarshaled)
 }
 
-func (v *createVolumeVolumeCreateVolume) __premarshalJSON() (*__premarshalcreateVolumeVolumeCreateVolume, error) {
-	var retval __premarshalcreateVolumeVolumeCreateVolume
+func (v *createServiceServiceCreateService) __premarshalJSON() (*__premarshalcreateServiceServiceCreateService, error) {
+	var retval __premarshalcreateServiceServiceCreateService
 
-	retval.Id = v.Volume.Id
-	retval.Name = v.Volume.Name
-	retval.VolumeInstances = v.Volume.VolumeInstances
+	retval.Id = v.Service.Id
+	retval.Name = v.Service.Name
+	retval.ProjectId = v.Service.ProjectId
 	return &retval, nil
 }
 
-// deleteCustomDomainResponse is returned by deleteCustomDomain on success.
-type deleteCustomDomainResponse struct {
-	// Deletes a custom domain.
-	CustomDomainDelete bool `json:"customDomainDelete"`
+// createSshPublicKeyResponse is returned by createSshPublicKey on success.
+type createSshPublicKeyResponse struct {
+	// Creates a new SSH public key for the authenticated user.
+	SshPublicKeyCreate createSshPublicKeySshPublicKeyCreateSshPublicKey `json:"sshPublicKeyCreate"`
 }
 
-// GetCustomDomainDelete returns deleteCustomDomainResponse.CustomDomainDelete, and is useful for accessing the field via an interface.
-func (v *deleteCustomDomainResponse) GetCustomDomainDelete() bool { return v.CustomDomainDelete }
+// GetSshPublicKeyCreate returns createSshPublicKeyResponse.SshPublicKeyCreate, and is useful for accessing the field via an interface.
+func (v *createSshPublicKeyResponse) GetSshPublicKeyCreate() createSshPublicKeySshPublicKeyCreateSshPublicKey {
+	return v.SshPublicKeyCreate
+}
 
-// deleteEnvironmentResponse is returned by deleteEnvironment on success.
-type deleteEnvironmentResponse struct {
-	// Deletes an environment.
-	EnvironmentDelete bool `json:"environmentDelete"`
+// createSshPublicKeySshPublicKeyCreateSshPublicKey includes the requested fields of the GraphQL type SshPublicKey.
+type createSshPublicKeySshPublicKeyCreateSshPublicKey struct {
+	SshPublicKey `json:"-"`
 }
 
-// GetEnvironmentDelete returns deleteEnvironmentResponse.EnvironmentDelete, and is useful for accessing the field via an interface.
-func (v *deleteEnvironmentResponse) GetEnvironmentDelete() bool { return v.EnvironmentDelete }
+// GetId returns createSshPublicKeySshPublicKeyCreateSshPublicKey.Id, and is useful for accessing the field via an interface.
+func (v *createSshPublicKeySshPublicKeyCreateSshPublicKey) GetId() string { return v.SshPublicKey.Id }
 
-// deleteProjectResponse is returned by deleteProject on success.
-type deleteProjectResponse struct {
-	// Deletes a project.
-	ProjectDelete bool `json:"projectDelete"`
+// GetName returns createSshPublicKeySshPublicKeyCreateSshPublicKey.Name, and is useful for accessing the field via an interface.
+func (v *createSshPublicKeySshPublicKeyCreateSshPublicKey) GetName() string {
+	return v.SshPublicKey.Name
 }
 
-// GetProjectDelete returns deleteProjectResponse.ProjectDelete, and is useful for accessing the field via an interface.
-func (v *deleteProjectResponse) GetProjectDelete() bool { return v.ProjectDelete }
+// GetPublicKey returns createSshPublicKeySshPublicKeyCreateSshPublicKey.PublicKey, and is useful for accessing the field via an interface.
+func (v *createSshPublicKeySshPublicKeyCreateSshPublicKey) GetPublicKey() string {
+	return v.SshPublicKey.PublicKey
+}
 
-// deleteServiceDomainResponse is returned by deleteServiceDomain on success.
-type deleteServiceDomainResponse struct {
-	// Deletes a service domain.
-	ServiceDomainDelete bool `json:"serviceDomainDelete"`
+// GetFingerprint returns createSshPublicKeySshPublicKeyCreateSshPublicKey.Fingerprint, and is useful for accessing the field via an interface.
+func (v *createSshPublicKeySshPublicKeyCreateSshPublicKey) GetFingerprint() string {
+	return v.SshPublicKey.Fingerprint
 }
 
-// GetServiceDomainDelete returns deleteServiceDomainResponse.ServiceDomainDelete, and is useful for accessing the field via an interface.
-func (v *deleteServiceDomainResponse) GetServiceDomainDelete() bool { return v.ServiceDomainDelete }
-
-// deleteServiceResponse is returned by deleteService on success.
-type deleteServiceResponse struct {
-	// Deletes a service.
-	ServiceDelete bool `json:"serviceDelete"`
-}
-
-// GetServiceDelete returns deleteServiceResponse.ServiceDelete, and is useful for accessing the field via an interface.
-func (v *deleteServiceResponse) GetServiceDelete() bool { return v.ServiceDelete }
-
-// deleteTcpProxyResponse is returned by deleteTcpProxy on success.
-type deleteTcpProxyResponse struct {
-	// Deletes a TCP proxy by id
-	TcpProxyDelete bool `json:"tcpProxyDelete"`
-}
-
-// GetTcpProxyDelete returns deleteTcpProxyResponse.TcpProxyDelete, and is useful for accessing the field via an interface.
-func (v *deleteTcpProxyResponse) GetTcpProxyDelete() bool { return v.TcpProxyDelete }
-
-// deleteVariableResponse is returned by deleteVariable on success.
-type deleteVariableResponse struct {
-	// Deletes a variable.
-	VariableDelete bool `json:"variableDelete"`
-}
-
-// GetVariableDelete returns deleteVariableResponse.VariableDelete, and is useful for accessing the field via an interface.
-func (v *deleteVariableResponse) GetVariableDelete() bool { return v.VariableDelete }
-
-// deleteVolumeResponse is returned by deleteVolume on success.
-type deleteVolumeResponse struct {
-	// Delete a persistent volume in a project
-	VolumeDelete bool `json:"volumeDelete"`
-}
-
-// GetVolumeDelete returns deleteVolumeResponse.VolumeDelete, and is useful for accessing the field via an interface.
-func (v *deleteVolumeResponse) GetVolumeDelete() bool { return v.VolumeDelete }
-
-// disconnectServiceResponse is returned by disconnectService on success.
-type disconnectServiceResponse struct {
-	// Disconnect a service from a repo
-	ServiceDisconnect disconnectServiceServiceDisconnectService `json:"serviceDisconnect"`
-}
-
-// GetServiceDisconnect returns disconnectServiceResponse.ServiceDisconnect, and is useful for accessing the field via an interface.
-func (v *disconnectServiceResponse) GetServiceDisconnect() disconnectServiceServiceDisconnectService {
-	return v.ServiceDisconnect
-}
-
-// disconnectServiceServiceDisconnectService includes the requested fields of the GraphQL type Service.
-type disconnectServiceServiceDisconnectService struct {
-	Id string `json:"id"`
-}
-
-// GetId returns disconnectServiceServiceDisconnectService.Id, and is useful for accessing the field via an interface.
-func (v *disconnectServiceServiceDisconnectService) GetId() string { return v.Id }
-
-// getEnvironmentEnvironment includes the requested fields of the GraphQL type Environment.
-type getEnvironmentEnvironment struct {
-	Environment `json:"-"`
-}
-
-// GetId returns getEnvironmentEnvironment.Id, and is useful for accessing the field via an interface.
-func (v *getEnvironmentEnvironment) GetId() string { return v.Environment.Id }
-
-// GetName returns getEnvironmentEnvironment.Name, and is useful for accessing the field via an interface.
-func (v *getEnvironmentEnvironment) GetName() string { return v.Environment.Name }
-
-// GetProjectId returns getEnvironmentEnvironment.ProjectId, and is useful for accessing the field via an interface.
-func (v *getEnvironmentEnvironment) GetProjectId() string { return v.Environment.ProjectId }
-
-func (v *getEnvironmentEnvironment) UnmarshalJSON(b []byte) error {
+func (v *createSshPublicKeySshPublicKeyCreateSshPublicKey) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*getEnvironmentEnvironment
+		*createSshPublicKeySshPublicKeyCreateSshPublicKey
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.getEnvironmentEnvironment = v
+	firstPass.createSshPublicKeySshPublicKeyCreateSshPublicKey = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -2111,22 +3702,24 @@ func (v *getEnvironmentEnvironment) UnmarshalJSON(b []byte) error {
 	}
 
 	err = json.Unmarshal(
-		b, &v.Environment)
+		b, &v.SshPublicKey)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-type __premarshalgetEnvironmentEnvironment struct {
+type __premarshalcreateSshPublicKeySshPublicKeyCreateSshPublicKey struct {
 	Id string `json:"id"`
 
 	Name string `json:"name"`
 
-	ProjectId string `json:"projectId"`
+	PublicKey string `json:"publicKey"`
+
+	Fingerprint string `json:"fingerprint"`
 }
 
-func (v *getEnvironmentEnvironment) MarshalJSON() ([]byte, error) {
+func (v *createSshPublicKeySshPublicKeyCreateSshPublicKey) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -2134,75 +3727,65 @@ func (v *getEnvironmentEnvironment) MarshalJSON() ([]byte, error) {
 	return json.Marshal(premarshaled)
 }
 
-func (v *getEnvironmentEnvironment) __premarshalJSON() (*__premarshalgetEnvironmentEnvironment, error) {
-	var retval __premarshalgetEnvironmentEnvironment
+func (v *createSshPublicKeySshPublicKeyCreateSshPublicKey) __premarshalJSON() (*__premarshalcreateSshPublicKeySshPublicKeyCreateSshPublicKey, error) {
+	var retval __premarshalcreateSshPublicKeySshPublicKeyCreateSshPublicKey
 
-	retval.Id = v.Environment.Id
-	retval.Name = v.Environment.Name
-	retval.ProjectId = v.Environment.ProjectId
+	retval.Id = v.SshPublicKey.Id
+	retval.Name = v.SshPublicKey.Name
+	retval.PublicKey = v.SshPublicKey.PublicKey
+	retval.Fingerprint = v.SshPublicKey.Fingerprint
 	return &retval, nil
 }
 
-// getEnvironmentResponse is returned by getEnvironment on success.
-type getEnvironmentResponse struct {
-	// Find a single environment
-	Environment getEnvironmentEnvironment `json:"environment"`
+// createTcpProxyResponse is returned by createTcpProxy on success.
+type createTcpProxyResponse struct {
+	// Creates a new TCP proxy for a service instance.
+	TcpProxyCreate createTcpProxyTcpProxyCreateTCPProxy `json:"tcpProxyCreate"`
 }
 
-// GetEnvironment returns getEnvironmentResponse.Environment, and is useful for accessing the field via an interface.
-func (v *getEnvironmentResponse) GetEnvironment() getEnvironmentEnvironment { return v.Environment }
-
-// getEnvironmentsEnvironmentsQueryEnvironmentsConnection includes the requested fields of the GraphQL type QueryEnvironmentsConnection.
-type getEnvironmentsEnvironmentsQueryEnvironmentsConnection struct {
-	Edges []getEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdge `json:"edges"`
+// GetTcpProxyCreate returns createTcpProxyResponse.TcpProxyCreate, and is useful for accessing the field via an interface.
+func (v *createTcpProxyResponse) GetTcpProxyCreate() createTcpProxyTcpProxyCreateTCPProxy {
+	return v.TcpProxyCreate
 }
 
-// GetEdges returns getEnvironmentsEnvironmentsQueryEnvironmentsConnection.Edges, and is useful for accessing the field via an interface.
-func (v *getEnvironmentsEnvironmentsQueryEnvironmentsConnection) GetEdges() []getEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdge {
-	return v.Edges
+// createTcpProxyTcpProxyCreateTCPProxy includes the requested fields of the GraphQL type TCPProxy.
+type createTcpProxyTcpProxyCreateTCPProxy struct {
+	TCPProxy `json:"-"`
 }
 
-// getEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdge includes the requested fields of the GraphQL type QueryEnvironmentsConnectionEdge.
-type getEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdge struct {
-	Node getEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment `json:"node"`
-}
+// GetId returns createTcpProxyTcpProxyCreateTCPProxy.Id, and is useful for accessing the field via an interface.
+func (v *createTcpProxyTcpProxyCreateTCPProxy) GetId() string { return v.TCPProxy.Id }
 
-// GetNode returns getEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdge.Node, and is useful for accessing the field via an interface.
-func (v *getEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdge) GetNode() getEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment {
-	return v.Node
+// GetApplicationPort returns createTcpProxyTcpProxyCreateTCPProxy.ApplicationPort, and is useful for accessing the field via an interface.
+func (v *createTcpProxyTcpProxyCreateTCPProxy) GetApplicationPort() int {
+	return v.TCPProxy.ApplicationPort
 }
 
-// getEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment includes the requested fields of the GraphQL type Environment.
-type getEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment struct {
-	Environment `json:"-"`
-}
+// GetProxyPort returns createTcpProxyTcpProxyCreateTCPProxy.ProxyPort, and is useful for accessing the field via an interface.
+func (v *createTcpProxyTcpProxyCreateTCPProxy) GetProxyPort() int { return v.TCPProxy.ProxyPort }
 
-// GetId returns getEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment.Id, and is useful for accessing the field via an interface.
-func (v *getEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment) GetId() string {
-	return v.Environment.Id
-}
+// GetDomain returns createTcpProxyTcpProxyCreateTCPProxy.Domain, and is useful for accessing the field via an interface.
+func (v *createTcpProxyTcpProxyCreateTCPProxy) GetDomain() string { return v.TCPProxy.Domain }
 
-// GetName returns getEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment.Name, and is useful for accessing the field via an interface.
-func (v *getEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment) GetName() string {
-	return v.Environment.Name
+// GetEnvironmentId returns createTcpProxyTcpProxyCreateTCPProxy.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *createTcpProxyTcpProxyCreateTCPProxy) GetEnvironmentId() string {
+	return v.TCPProxy.EnvironmentId
 }
 
-// GetProjectId returns getEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment.ProjectId, and is useful for accessing the field via an interface.
-func (v *getEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment) GetProjectId() string {
-	return v.Environment.ProjectId
-}
+// GetServiceId returns createTcpProxyTcpProxyCreateTCPProxy.ServiceId, and is useful for accessing the field via an interface.
+func (v *createTcpProxyTcpProxyCreateTCPProxy) GetServiceId() string { return v.TCPProxy.ServiceId }
 
-func (v *getEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment) UnmarshalJSON(b []byte) error {
+func (v *createTcpProxyTcpProxyCreateTCPProxy) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*getEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment
+		*createTcpProxyTcpProxyCreateTCPProxy
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.getEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment = v
+	firstPass.createTcpProxyTcpProxyCreateTCPProxy = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -2210,22 +3793,28 @@ func (v *getEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnviron
 	}
 
 	err = json.Unmarshal(
-		b, &v.Environment)
+		b, &v.TCPProxy)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-type __premarshalgetEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment struct {
+type __premarshalcreateTcpProxyTcpProxyCreateTCPProxy struct {
 	Id string `json:"id"`
 
-	Name string `json:"name"`
+	ApplicationPort int `json:"applicationPort"`
 
-	ProjectId string `json:"projectId"`
+	ProxyPort int `json:"proxyPort"`
+
+	Domain string `json:"domain"`
+
+	EnvironmentId string `json:"environmentId"`
+
+	ServiceId string `json:"serviceId"`
 }
 
-func (v *getEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment) MarshalJSON() ([]byte, error) {
+func (v *createTcpProxyTcpProxyCreateTCPProxy) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -2233,65 +3822,56 @@ func (v *getEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnviron
 	return json.Marshal(premarshaled)
 }
 
-func (v *getEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment) __premarshalJSON() (*__premarshalgetEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment, error) {
-	var retval __premarshalgetEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment
+func (v *createTcpProxyTcpProxyCreateTCPProxy) __premarshalJSON() (*__premarshalcreateTcpProxyTcpProxyCreateTCPProxy, error) {
+	var retval __premarshalcreateTcpProxyTcpProxyCreateTCPProxy
 
-	retval.Id = v.Environment.Id
-	retval.Name = v.Environment.Name
-	retval.ProjectId = v.Environment.ProjectId
+	retval.Id = v.TCPProxy.Id
+	retval.ApplicationPort = v.TCPProxy.ApplicationPort
+	retval.ProxyPort = v.TCPProxy.ProxyPort
+	retval.Domain = v.TCPProxy.Domain
+	retval.EnvironmentId = v.TCPProxy.EnvironmentId
+	retval.ServiceId = v.TCPProxy.ServiceId
 	return &retval, nil
 }
 
-// getEnvironmentsResponse is returned by getEnvironments on success.
-type getEnvironmentsResponse struct {
-	// Gets all environments for a project.
-	Environments getEnvironmentsEnvironmentsQueryEnvironmentsConnection `json:"environments"`
+// createVolumeResponse is returned by createVolume on success.
+type createVolumeResponse struct {
+	// Create a persistent volume in a project
+	VolumeCreate createVolumeVolumeCreateVolume `json:"volumeCreate"`
 }
 
-// GetEnvironments returns getEnvironmentsResponse.Environments, and is useful for accessing the field via an interface.
-func (v *getEnvironmentsResponse) GetEnvironments() getEnvironmentsEnvironmentsQueryEnvironmentsConnection {
-	return v.Environments
+// GetVolumeCreate returns createVolumeResponse.VolumeCreate, and is useful for accessing the field via an interface.
+func (v *createVolumeResponse) GetVolumeCreate() createVolumeVolumeCreateVolume {
+	return v.VolumeCreate
 }
 
-// getProjectProject includes the requested fields of the GraphQL type Project.
-type getProjectProject struct {
-	Project `json:"-"`
+// createVolumeVolumeCreateVolume includes the requested fields of the GraphQL type Volume.
+type createVolumeVolumeCreateVolume struct {
+	Volume `json:"-"`
 }
 
-// GetId returns getProjectProject.Id, and is useful for accessing the field via an interface.
-func (v *getProjectProject) GetId() string { return v.Project.Id }
-
-// GetName returns getProjectProject.Name, and is useful for accessing the field via an interface.
-func (v *getProjectProject) GetName() string { return v.Project.Name }
-
-// GetDescription returns getProjectProject.Description, and is useful for accessing the field via an interface.
-func (v *getProjectProject) GetDescription() string { return v.Project.Description }
-
-// GetIsPublic returns getProjectProject.IsPublic, and is useful for accessing the field via an interface.
-func (v *getProjectProject) GetIsPublic() bool { return v.Project.IsPublic }
-
-// GetPrDeploys returns getProjectProject.PrDeploys, and is useful for accessing the field via an interface.
-func (v *getProjectProject) GetPrDeploys() bool { return v.Project.PrDeploys }
+// GetId returns createVolumeVolumeCreateVolume.Id, and is useful for accessing the field via an interface.
+func (v *createVolumeVolumeCreateVolume) GetId() string { return v.Volume.Id }
 
-// GetWorkspace returns getProjectProject.Workspace, and is useful for accessing the field via an interface.
-func (v *getProjectProject) GetWorkspace() *ProjectWorkspace { return v.Project.Workspace }
+// GetName returns createVolumeVolumeCreateVolume.Name, and is useful for accessing the field via an interface.
+func (v *createVolumeVolumeCreateVolume) GetName() string { return v.Volume.Name }
 
-// GetEnvironments returns getProjectProject.Environments, and is useful for accessing the field via an interface.
-func (v *getProjectProject) GetEnvironments() ProjectEnvironmentsProjectEnvironmentsConnection {
-	return v.Project.Environments
+// GetVolumeInstances returns createVolumeVolumeCreateVolume.VolumeInstances, and is useful for accessing the field via an interface.
+func (v *createVolumeVolumeCreateVolume) GetVolumeInstances() VolumeVolumeInstancesVolumeVolumeInstancesConnection {
+	return v.Volume.VolumeInstances
 }
 
-func (v *getProjectProject) UnmarshalJSON(b []byte) error {
+func (v *createVolumeVolumeCreateVolume) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*getProjectProject
+		*createVolumeVolumeCreateVolume
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.getProjectProject = v
+	firstPass.createVolumeVolumeCreateVolume = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -2299,30 +3879,22 @@ func (v *getProjectProject) UnmarshalJSON(b []byte) error {
 	}
 
 	err = json.Unmarshal(
-		b, &v.Project)
+		b, &v.Volume)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-type __premarshalgetProjectProject struct {
+type __premarshalcreateVolumeVolumeCreateVolume struct {
 	Id string `json:"id"`
 
 	Name string `json:"name"`
 
-	Description string `json:"description"`
-
-	IsPublic bool `json:"isPublic"`
-
-	PrDeploys bool `json:"prDeploys"`
-
-	Workspace *ProjectWorkspace `json:"workspace"`
-
-	Environments ProjectEnvironmentsProjectEnvironmentsConnection `json:"environments"`
+	VolumeInstances VolumeVolumeInstancesVolumeVolumeInstancesConnection `json:"volumeInstances"`
 }
 
-func (v *getProjectProject) MarshalJSON() ([]byte, error) {
+func (v *createVolumeVolumeCreateVolume) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -2330,181 +3902,228 @@ func (v *getProjectProject) MarshalJSON() ([]byte, error) {
 	return json.Marshal(premarshaled)
 }
 
-func (v *getProjectProject) __premarshalJSON() (*__premarshalgetProjectProject, error) {
-	var retval __premarshalgetProjectProject
+func (v *createVolumeVolumeCreateVolume) __premarshalJSON() (*__premarshalcreateVolumeVolumeCreateVolume, error) {
+	var retval __premarshalcreateVolumeVolumeCreateVolume
 
-	retval.Id = v.Project.Id
-	retval.Name = v.Project.Name
-	retval.Description = v.Project.Description
-	retval.IsPublic = v.Project.IsPublic
-	retval.PrDeploys = v.Project.PrDeploys
-	retval.Workspace = v.Project.Workspace
-	retval.Environments = v.Project.Environments
+	retval.Id = v.Volume.Id
+	retval.Name = v.Volume.Name
+	retval.VolumeInstances = v.Volume.VolumeInstances
 	return &retval, nil
 }
 
-// getProjectResponse is returned by getProject on success.
-type getProjectResponse struct {
-	// Get a project by ID
-	Project getProjectProject `json:"project"`
+// deleteApiTokenResponse is returned by deleteApiToken on success.
+type deleteApiTokenResponse struct {
+	// Deletes an API token.
+	ApiTokenDelete bool `json:"apiTokenDelete"`
 }
 
-// GetProject returns getProjectResponse.Project, and is useful for accessing the field via an interface.
-func (v *getProjectResponse) GetProject() getProjectProject { return v.Project }
+// GetApiTokenDelete returns deleteApiTokenResponse.ApiTokenDelete, and is useful for accessing the field via an interface.
+func (v *deleteApiTokenResponse) GetApiTokenDelete() bool { return v.ApiTokenDelete }
 
-// getServiceInstanceResponse is returned by getServiceInstance on success.
-type getServiceInstanceResponse struct {
-	// Get a service instance belonging to a service and environment
-	ServiceInstance getServiceInstanceServiceInstance `json:"serviceInstance"`
+// deleteCustomDomainResponse is returned by deleteCustomDomain on success.
+type deleteCustomDomainResponse struct {
+	// Deletes a custom domain.
+	CustomDomainDelete bool `json:"customDomainDelete"`
 }
 
-// GetServiceInstance returns getServiceInstanceResponse.ServiceInstance, and is useful for accessing the field via an interface.
-func (v *getServiceInstanceResponse) GetServiceInstance() getServiceInstanceServiceInstance {
-	return v.ServiceInstance
+// GetCustomDomainDelete returns deleteCustomDomainResponse.CustomDomainDelete, and is useful for accessing the field via an interface.
+func (v *deleteCustomDomainResponse) GetCustomDomainDelete() bool { return v.CustomDomainDelete }
+
+// deleteEnvironmentResponse is returned by deleteEnvironment on success.
+type deleteEnvironmentResponse struct {
+	// Deletes an environment.
+	EnvironmentDelete bool `json:"environmentDelete"`
 }
 
-// getServiceInstanceServiceInstance includes the requested fields of the GraphQL type ServiceInstance.
-type getServiceInstanceServiceInstance struct {
-	Source            *getServiceInstanceServiceInstanceSourceServiceSource `json:"source"`
-	RootDirectory     *string                                               `json:"rootDirectory"`
-	RailwayConfigFile *string                                               `json:"railwayConfigFile"`
-	CronSchedule      *string                                               `json:"cronSchedule"`
-	// The most recent deployment for this service instance
-	LatestDeployment getServiceInstanceServiceInstanceLatestDeployment `json:"latestDeployment"`
+// GetEnvironmentDelete returns deleteEnvironmentResponse.EnvironmentDelete, and is useful for accessing the field via an interface.
+func (v *deleteEnvironmentResponse) GetEnvironmentDelete() bool { return v.EnvironmentDelete }
+
+// deleteIntegrationResponse is returned by deleteIntegration on success.
+type deleteIntegrationResponse struct {
+	// Delete an integration for a project
+	IntegrationDelete bool `json:"integrationDelete"`
 }
 
-// GetSource returns getServiceInstanceServiceInstance.Source, and is useful for accessing the field via an interface.
-func (v *getServiceInstanceServiceInstance) GetSource() *getServiceInstanceServiceInstanceSourceServiceSource {
-	return v.Source
+// GetIntegrationDelete returns deleteIntegrationResponse.IntegrationDelete, and is useful for accessing the field via an interface.
+func (v *deleteIntegrationResponse) GetIntegrationDelete() bool { return v.IntegrationDelete }
+
+// deletePrivateNetworkEndpointResponse is returned by deletePrivateNetworkEndpoint on success.
+type deletePrivateNetworkEndpointResponse struct {
+	// Delete a private network endpoint.
+	PrivateNetworkEndpointDelete bool `json:"privateNetworkEndpointDelete"`
 }
 
-// GetRootDirectory returns getServiceInstanceServiceInstance.RootDirectory, and is useful for accessing the field via an interface.
-func (v *getServiceInstanceServiceInstance) GetRootDirectory() *string { return v.RootDirectory }
+// GetPrivateNetworkEndpointDelete returns deletePrivateNetworkEndpointResponse.PrivateNetworkEndpointDelete, and is useful for accessing the field via an interface.
+func (v *deletePrivateNetworkEndpointResponse) GetPrivateNetworkEndpointDelete() bool {
+	return v.PrivateNetworkEndpointDelete
+}
 
-// GetRailwayConfigFile returns getServiceInstanceServiceInstance.RailwayConfigFile, and is useful for accessing the field via an interface.
-func (v *getServiceInstanceServiceInstance) GetRailwayConfigFile() *string {
-	return v.RailwayConfigFile
+// deleteProjectResponse is returned by deleteProject on success.
+type deleteProjectResponse struct {
+	// Deletes a project.
+	ProjectDelete bool `json:"projectDelete"`
 }
 
-// GetCronSchedule returns getServiceInstanceServiceInstance.CronSchedule, and is useful for accessing the field via an interface.
-func (v *getServiceInstanceServiceInstance) GetCronSchedule() *string { return v.CronSchedule }
+// GetProjectDelete returns deleteProjectResponse.ProjectDelete, and is useful for accessing the field via an interface.
+func (v *deleteProjectResponse) GetProjectDelete() bool { return v.ProjectDelete }
 
-// GetLatestDeployment returns getServiceInstanceServiceInstance.LatestDeployment, and is useful for accessing the field via an interface.
-func (v *getServiceInstanceServiceInstance) GetLatestDeployment() getServiceInstanceServiceInstanceLatestDeployment {
-	return v.LatestDeployment
+// deleteServiceDomainResponse is returned by deleteServiceDomain on success.
+type deleteServiceDomainResponse struct {
+	// Deletes a service domain.
+	ServiceDomainDelete bool `json:"serviceDomainDelete"`
 }
 
-// getServiceInstanceServiceInstanceLatestDeployment includes the requested fields of the GraphQL type Deployment.
-type getServiceInstanceServiceInstanceLatestDeployment struct {
-	Meta map[string]interface{} `json:"meta"`
+// GetServiceDomainDelete returns deleteServiceDomainResponse.ServiceDomainDelete, and is useful for accessing the field via an interface.
+func (v *deleteServiceDomainResponse) GetServiceDomainDelete() bool { return v.ServiceDomainDelete }
+
+// deleteServiceResponse is returned by deleteService on success.
+type deleteServiceResponse struct {
+	// Deletes a service.
+	ServiceDelete bool `json:"serviceDelete"`
 }
 
-// GetMeta returns getServiceInstanceServiceInstanceLatestDeployment.Meta, and is useful for accessing the field via an interface.
-func (v *getServiceInstanceServiceInstanceLatestDeployment) GetMeta() map[string]interface{} {
-	return v.Meta
+// GetServiceDelete returns deleteServiceResponse.ServiceDelete, and is useful for accessing the field via an interface.
+func (v *deleteServiceResponse) GetServiceDelete() bool { return v.ServiceDelete }
+
+// deleteSshPublicKeyResponse is returned by deleteSshPublicKey on success.
+type deleteSshPublicKeyResponse struct {
+	// Deletes an SSH public key.
+	SshPublicKeyDelete bool `json:"sshPublicKeyDelete"`
 }
 
-// getServiceInstanceServiceInstanceSourceServiceSource includes the requested fields of the GraphQL type ServiceSource.
-type getServiceInstanceServiceInstanceSourceServiceSource struct {
-	Image *string `json:"image"`
-	Repo  *string `json:"repo"`
+// GetSshPublicKeyDelete returns deleteSshPublicKeyResponse.SshPublicKeyDelete, and is useful for accessing the field via an interface.
+func (v *deleteSshPublicKeyResponse) GetSshPublicKeyDelete() bool { return v.SshPublicKeyDelete }
+
+// deleteTcpProxyResponse is returned by deleteTcpProxy on success.
+type deleteTcpProxyResponse struct {
+	// Deletes a TCP proxy by id
+	TcpProxyDelete bool `json:"tcpProxyDelete"`
 }
 
-// GetImage returns getServiceInstanceServiceInstanceSourceServiceSource.Image, and is useful for accessing the field via an interface.
-func (v *getServiceInstanceServiceInstanceSourceServiceSource) GetImage() *string { return v.Image }
+// GetTcpProxyDelete returns deleteTcpProxyResponse.TcpProxyDelete, and is useful for accessing the field via an interface.
+func (v *deleteTcpProxyResponse) GetTcpProxyDelete() bool { return v.TcpProxyDelete }
 
-// GetRepo returns getServiceInstanceServiceInstanceSourceServiceSource.Repo, and is useful for accessing the field via an interface.
-func (v *getServiceInstanceServiceInstanceSourceServiceSource) GetRepo() *string { return v.Repo }
+// deleteVariableResponse is returned by deleteVariable on success.
+type deleteVariableResponse struct {
+	// Deletes a variable.
+	VariableDelete bool `json:"variableDelete"`
+}
 
-// getServiceInstancesResponse is returned by getServiceInstances on success.
-type getServiceInstancesResponse struct {
-	// Get a service by ID
-	Service getServiceInstancesService `json:"service"`
+// GetVariableDelete returns deleteVariableResponse.VariableDelete, and is useful for accessing the field via an interface.
+func (v *deleteVariableResponse) GetVariableDelete() bool { return v.VariableDelete }
+
+// deleteVolumeResponse is returned by deleteVolume on success.
+type deleteVolumeResponse struct {
+	// Delete a persistent volume in a project
+	VolumeDelete bool `json:"volumeDelete"`
 }
 
-// GetService returns getServiceInstancesResponse.Service, and is useful for accessing the field via an interface.
-func (v *getServiceInstancesResponse) GetService() getServiceInstancesService { return v.Service }
+// GetVolumeDelete returns deleteVolumeResponse.VolumeDelete, and is useful for accessing the field via an interface.
+func (v *deleteVolumeResponse) GetVolumeDelete() bool { return v.VolumeDelete }
 
-// getServiceInstancesService includes the requested fields of the GraphQL type Service.
-type getServiceInstancesService struct {
-	ServiceInstances getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnection `json:"serviceInstances"`
+// deployServiceInstanceResponse is returned by deployServiceInstance on success.
+type deployServiceInstanceResponse struct {
+	// Deploy a service instance. Returns a deployment ID
+	ServiceInstanceDeployV2 string `json:"serviceInstanceDeployV2"`
 }
 
-// GetServiceInstances returns getServiceInstancesService.ServiceInstances, and is useful for accessing the field via an interface.
-func (v *getServiceInstancesService) GetServiceInstances() getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnection {
-	return v.ServiceInstances
+// GetServiceInstanceDeployV2 returns deployServiceInstanceResponse.ServiceInstanceDeployV2, and is useful for accessing the field via an interface.
+func (v *deployServiceInstanceResponse) GetServiceInstanceDeployV2() string {
+	return v.ServiceInstanceDeployV2
 }
 
-// getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnection includes the requested fields of the GraphQL type ServiceServiceInstancesConnection.
-type getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnection struct {
-	Edges []getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnectionEdgesServiceServiceInstancesConnectionEdge `json:"edges"`
+// deployTemplateResponse is returned by deployTemplate on success.
+type deployTemplateResponse struct {
+	// Deploys a template using the serialized template config
+	TemplateDeployV2 deployTemplateTemplateDeployV2TemplateDeployPayload `json:"templateDeployV2"`
 }
 
-// GetEdges returns getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnection.Edges, and is useful for accessing the field via an interface.
-func (v *getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnection) GetEdges() []getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnectionEdgesServiceServiceInstancesConnectionEdge {
-	return v.Edges
+// GetTemplateDeployV2 returns deployTemplateResponse.TemplateDeployV2, and is useful for accessing the field via an interface.
+func (v *deployTemplateResponse) GetTemplateDeployV2() deployTemplateTemplateDeployV2TemplateDeployPayload {
+	return v.TemplateDeployV2
 }
 
-// getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnectionEdgesServiceServiceInstancesConnectionEdge includes the requested fields of the GraphQL type ServiceServiceInstancesConnectionEdge.
-type getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnectionEdgesServiceServiceInstancesConnectionEdge struct {
-	Node getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnectionEdgesServiceServiceInstancesConnectionEdgeNodeServiceInstance `json:"node"`
+// deployTemplateTemplateDeployV2TemplateDeployPayload includes the requested fields of the GraphQL type TemplateDeployPayload.
+type deployTemplateTemplateDeployV2TemplateDeployPayload struct {
+	ProjectId  string  `json:"projectId"`
+	WorkflowId *string `json:"workflowId"`
 }
 
-// GetNode returns getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnectionEdgesServiceServiceInstancesConnectionEdge.Node, and is useful for accessing the field via an interface.
-func (v *getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnectionEdgesServiceServiceInstancesConnectionEdge) GetNode() getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnectionEdgesServiceServiceInstancesConnectionEdgeNodeServiceInstance {
-	return v.Node
+// GetProjectId returns deployTemplateTemplateDeployV2TemplateDeployPayload.ProjectId, and is useful for accessing the field via an interface.
+func (v *deployTemplateTemplateDeployV2TemplateDeployPayload) GetProjectId() string {
+	return v.ProjectId
 }
 
-// getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnectionEdgesServiceServiceInstancesConnectionEdgeNodeServiceInstance includes the requested fields of the GraphQL type ServiceInstance.
-type getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnectionEdgesServiceServiceInstancesConnectionEdgeNodeServiceInstance struct {
-	Id            string `json:"id"`
-	EnvironmentId string `json:"environmentId"`
+// GetWorkflowId returns deployTemplateTemplateDeployV2TemplateDeployPayload.WorkflowId, and is useful for accessing the field via an interface.
+func (v *deployTemplateTemplateDeployV2TemplateDeployPayload) GetWorkflowId() *string {
+	return v.WorkflowId
 }
 
-// GetId returns getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnectionEdgesServiceServiceInstancesConnectionEdgeNodeServiceInstance.Id, and is useful for accessing the field via an interface.
-func (v *getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnectionEdgesServiceServiceInstancesConnectionEdgeNodeServiceInstance) GetId() string {
-	return v.Id
+// disconnectServiceResponse is returned by disconnectService on success.
+type disconnectServiceResponse struct {
+	// Disconnect a service from a repo
+	ServiceDisconnect disconnectServiceServiceDisconnectService `json:"serviceDisconnect"`
 }
 
-// GetEnvironmentId returns getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnectionEdgesServiceServiceInstancesConnectionEdgeNodeServiceInstance.EnvironmentId, and is useful for accessing the field via an interface.
-func (v *getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnectionEdgesServiceServiceInstancesConnectionEdgeNodeServiceInstance) GetEnvironmentId() string {
-	return v.EnvironmentId
+// GetServiceDisconnect returns disconnectServiceResponse.ServiceDisconnect, and is useful for accessing the field via an interface.
+func (v *disconnectServiceResponse) GetServiceDisconnect() disconnectServiceServiceDisconnectService {
+	return v.ServiceDisconnect
 }
 
-// getServiceResponse is returned by getService on success.
-type getServiceResponse struct {
-	// Get a service by ID
-	Service getServiceService `json:"service"`
+// disconnectServiceServiceDisconnectService includes the requested fields of the GraphQL type Service.
+type disconnectServiceServiceDisconnectService struct {
+	Id string `json:"id"`
 }
 
-// GetService returns getServiceResponse.Service, and is useful for accessing the field via an interface.
-func (v *getServiceResponse) GetService() getServiceService { return v.Service }
+// GetId returns disconnectServiceServiceDisconnectService.Id, and is useful for accessing the field via an interface.
+func (v *disconnectServiceServiceDisconnectService) GetId() string { return v.Id }
 
-// getServiceService includes the requested fields of the GraphQL type Service.
-type getServiceService struct {
-	Service `json:"-"`
+// getBucketCredentialsBucketS3CredentialsBucketS3CompatibleCredentials includes the requested fields of the GraphQL type BucketS3CompatibleCredentials.
+type getBucketCredentialsBucketS3CredentialsBucketS3CompatibleCredentials struct {
+	BucketS3CompatibleCredentials `json:"-"`
 }
 
-// GetId returns getServiceService.Id, and is useful for accessing the field via an interface.
-func (v *getServiceService) GetId() string { return v.Service.Id }
+// GetAccessKeyId returns getBucketCredentialsBucketS3CredentialsBucketS3CompatibleCredentials.AccessKeyId, and is useful for accessing the field via an interface.
+func (v *getBucketCredentialsBucketS3CredentialsBucketS3CompatibleCredentials) GetAccessKeyId() string {
+	return v.BucketS3CompatibleCredentials.AccessKeyId
+}
 
-// GetName returns getServiceService.Name, and is useful for accessing the field via an interface.
-func (v *getServiceService) GetName() string { return v.Service.Name }
+// GetSecretAccessKey returns getBucketCredentialsBucketS3CredentialsBucketS3CompatibleCredentials.SecretAccessKey, and is useful for accessing the field via an interface.
+func (v *getBucketCredentialsBucketS3CredentialsBucketS3CompatibleCredentials) GetSecretAccessKey() string {
+	return v.BucketS3CompatibleCredentials.SecretAccessKey
+}
 
-// GetProjectId returns getServiceService.ProjectId, and is useful for accessing the field via an interface.
-func (v *getServiceService) GetProjectId() string { return v.Service.ProjectId }
+// GetBucketName returns getBucketCredentialsBucketS3CredentialsBucketS3CompatibleCredentials.BucketName, and is useful for accessing the field via an interface.
+func (v *getBucketCredentialsBucketS3CredentialsBucketS3CompatibleCredentials) GetBucketName() string {
+	return v.BucketS3CompatibleCredentials.BucketName
+}
 
-func (v *getServiceService) UnmarshalJSON(b []byte) error {
+// GetEndpoint returns getBucketCredentialsBucketS3CredentialsBucketS3CompatibleCredentials.Endpoint, and is useful for accessing the field via an interface.
+func (v *getBucketCredentialsBucketS3CredentialsBucketS3CompatibleCredentials) GetEndpoint() string {
+	return v.BucketS3CompatibleCredentials.Endpoint
+}
+
+// GetRegion returns getBucketCredentialsBucketS3CredentialsBucketS3CompatibleCredentials.Region, and is useful for accessing the field via an interface.
+func (v *getBucketCredentialsBucketS3CredentialsBucketS3CompatibleCredentials) GetRegion() string {
+	return v.BucketS3CompatibleCredentials.Region
+}
+
+// GetUrlStyle returns getBucketCredentialsBucketS3CredentialsBucketS3CompatibleCredentials.UrlStyle, and is useful for accessing the field via an interface.
+func (v *getBucketCredentialsBucketS3CredentialsBucketS3CompatibleCredentials) GetUrlStyle() string {
+	return v.BucketS3CompatibleCredentials.UrlStyle
+}
+
+func (v *getBucketCredentialsBucketS3CredentialsBucketS3CompatibleCredentials) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*getServiceService
+		*getBucketCredentialsBucketS3CredentialsBucketS3CompatibleCredentials
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.getServiceService = v
+	firstPass.getBucketCredentialsBucketS3CredentialsBucketS3CompatibleCredentials = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -2512,22 +4131,28 @@ func (v *getServiceService) UnmarshalJSON(b []byte) error {
 	}
 
 	err = json.Unmarshal(
-		b, &v.Service)
+		b, &v.BucketS3CompatibleCredentials)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-type __premarshalgetServiceService struct {
-	Id string `json:"id"`
+type __premarshalgetBucketCredentialsBucketS3CredentialsBucketS3CompatibleCredentials struct {
+	AccessKeyId string `json:"accessKeyId"`
 
-	Name string `json:"name"`
+	SecretAccessKey string `json:"secretAccessKey"`
 
-	ProjectId string `json:"projectId"`
+	BucketName string `json:"bucketName"`
+
+	Endpoint string `json:"endpoint"`
+
+	Region string `json:"region"`
+
+	UrlStyle string `json:"urlStyle"`
 }
 
-func (v *getServiceService) MarshalJSON() ([]byte, error) {
+func (v *getBucketCredentialsBucketS3CredentialsBucketS3CompatibleCredentials) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -2535,67 +4160,63 @@ func (v *getServiceService) MarshalJSON() ([]byte, error) {
 	return json.Marshal(premarshaled)
 }
 
-func (v *getServiceService) __premarshalJSON() (*__premarshalgetServiceService, error) {
-	var retval __premarshalgetServiceService
+func (v *getBucketCredentialsBucketS3CredentialsBucketS3CompatibleCredentials) __premarshalJSON() (*__premarshalgetBucketCredentialsBucketS3CredentialsBucketS3CompatibleCredentials, error) {
+	var retval __premarshalgetBucketCredentialsBucketS3CredentialsBucketS3CompatibleCredentials
 
-	retval.Id = v.Service.Id
-	retval.Name = v.Service.Name
-	retval.ProjectId = v.Service.ProjectId
+	retval.AccessKeyId = v.BucketS3CompatibleCredentials.AccessKeyId
+	retval.SecretAccessKey = v.BucketS3CompatibleCredentials.SecretAccessKey
+	retval.BucketName = v.BucketS3CompatibleCredentials.BucketName
+	retval.Endpoint = v.BucketS3CompatibleCredentials.Endpoint
+	retval.Region = v.BucketS3CompatibleCredentials.Region
+	retval.UrlStyle = v.BucketS3CompatibleCredentials.UrlStyle
 	return &retval, nil
 }
 
-// getSharedVariablesResponse is returned by getSharedVariables on success.
-type getSharedVariablesResponse struct {
-	// All variables by pluginId or serviceId. If neither are provided, all shared variables are returned.
-	Variables map[string]interface{} `json:"variables"`
+// getBucketCredentialsResponse is returned by getBucketCredentials on success.
+type getBucketCredentialsResponse struct {
+	// Get the S3-compatible credentials for a bucket
+	BucketS3Credentials []getBucketCredentialsBucketS3CredentialsBucketS3CompatibleCredentials `json:"bucketS3Credentials"`
 }
 
-// GetVariables returns getSharedVariablesResponse.Variables, and is useful for accessing the field via an interface.
-func (v *getSharedVariablesResponse) GetVariables() map[string]interface{} { return v.Variables }
-
-// getTcpProxyResponse is returned by getTcpProxy on success.
-type getTcpProxyResponse struct {
-	// All TCP proxies for a service instance
-	TcpProxies []getTcpProxyTcpProxiesTCPProxy `json:"tcpProxies"`
+// GetBucketS3Credentials returns getBucketCredentialsResponse.BucketS3Credentials, and is useful for accessing the field via an interface.
+func (v *getBucketCredentialsResponse) GetBucketS3Credentials() []getBucketCredentialsBucketS3CredentialsBucketS3CompatibleCredentials {
+	return v.BucketS3Credentials
 }
 
-// GetTcpProxies returns getTcpProxyResponse.TcpProxies, and is useful for accessing the field via an interface.
-func (v *getTcpProxyResponse) GetTcpProxies() []getTcpProxyTcpProxiesTCPProxy { return v.TcpProxies }
-
-// getTcpProxyTcpProxiesTCPProxy includes the requested fields of the GraphQL type TCPProxy.
-type getTcpProxyTcpProxiesTCPProxy struct {
-	TCPProxy `json:"-"`
+// getCustomDomainCustomDomain includes the requested fields of the GraphQL type CustomDomain.
+type getCustomDomainCustomDomain struct {
+	CustomDomain `json:"-"`
 }
 
-// GetId returns getTcpProxyTcpProxiesTCPProxy.Id, and is useful for accessing the field via an interface.
-func (v *getTcpProxyTcpProxiesTCPProxy) GetId() string { return v.TCPProxy.Id }
+// GetId returns getCustomDomainCustomDomain.Id, and is useful for accessing the field via an interface.
+func (v *getCustomDomainCustomDomain) GetId() string { return v.CustomDomain.Id }
 
-// GetApplicationPort returns getTcpProxyTcpProxiesTCPProxy.ApplicationPort, and is useful for accessing the field via an interface.
-func (v *getTcpProxyTcpProxiesTCPProxy) GetApplicationPort() int { return v.TCPProxy.ApplicationPort }
+// GetDomain returns getCustomDomainCustomDomain.Domain, and is useful for accessing the field via an interface.
+func (v *getCustomDomainCustomDomain) GetDomain() string { return v.CustomDomain.Domain }
 
-// GetProxyPort returns getTcpProxyTcpProxiesTCPProxy.ProxyPort, and is useful for accessing the field via an interface.
-func (v *getTcpProxyTcpProxiesTCPProxy) GetProxyPort() int { return v.TCPProxy.ProxyPort }
+// GetTargetPort returns getCustomDomainCustomDomain.TargetPort, and is useful for accessing the field via an interface.
+func (v *getCustomDomainCustomDomain) GetTargetPort() int { return v.CustomDomain.TargetPort }
 
-// GetDomain returns getTcpProxyTcpProxiesTCPProxy.Domain, and is useful for accessing the field via an interface.
-func (v *getTcpProxyTcpProxiesTCPProxy) GetDomain() string { return v.TCPProxy.Domain }
+// GetStatus returns getCustomDomainCustomDomain.Status, and is useful for accessing the field via an interface.
+func (v *getCustomDomainCustomDomain) GetStatus() CustomDomainStatus { return v.CustomDomain.Status }
 
-// GetEnvironmentId returns getTcpProxyTcpProxiesTCPProxy.EnvironmentId, and is useful for accessing the field via an interface.
-func (v *getTcpProxyTcpProxiesTCPProxy) GetEnvironmentId() string { return v.TCPProxy.EnvironmentId }
+// GetEnvironmentId returns getCustomDomainCustomDomain.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *getCustomDomainCustomDomain) GetEnvironmentId() string { return v.CustomDomain.EnvironmentId }
 
-// GetServiceId returns getTcpProxyTcpProxiesTCPProxy.ServiceId, and is useful for accessing the field via an interface.
-func (v *getTcpProxyTcpProxiesTCPProxy) GetServiceId() string { return v.TCPProxy.ServiceId }
+// GetServiceId returns getCustomDomainCustomDomain.ServiceId, and is useful for accessing the field via an interface.
+func (v *getCustomDomainCustomDomain) GetServiceId() string { return v.CustomDomain.ServiceId }
 
-func (v *getTcpProxyTcpProxiesTCPProxy) UnmarshalJSON(b []byte) error {
+func (v *getCustomDomainCustomDomain) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*getTcpProxyTcpProxiesTCPProxy
+		*getCustomDomainCustomDomain
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.getTcpProxyTcpProxiesTCPProxy = v
+	firstPass.getCustomDomainCustomDomain = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -2603,28 +4224,28 @@ func (v *getTcpProxyTcpProxiesTCPProxy) UnmarshalJSON(b []byte) error {
 	}
 
 	err = json.Unmarshal(
-		b, &v.TCPProxy)
+		b, &v.CustomDomain)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-type __premarshalgetTcpProxyTcpProxiesTCPProxy struct {
+type __premarshalgetCustomDomainCustomDomain struct {
 	Id string `json:"id"`
 
-	ApplicationPort int `json:"applicationPort"`
+	Domain string `json:"domain"`
 
-	ProxyPort int `json:"proxyPort"`
+	TargetPort int `json:"targetPort"`
 
-	Domain string `json:"domain"`
+	Status CustomDomainStatus `json:"status"`
 
 	EnvironmentId string `json:"environmentId"`
 
 	ServiceId string `json:"serviceId"`
 }
 
-func (v *getTcpProxyTcpProxiesTCPProxy) MarshalJSON() ([]byte, error) {
+func (v *getCustomDomainCustomDomain) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -2632,88 +4253,175 @@ func (v *getTcpProxyTcpProxiesTCPProxy) MarshalJSON() ([]byte, error) {
 	return json.Marshal(premarshaled)
 }
 
-func (v *getTcpProxyTcpProxiesTCPProxy) __premarshalJSON() (*__premarshalgetTcpProxyTcpProxiesTCPProxy, error) {
-	var retval __premarshalgetTcpProxyTcpProxiesTCPProxy
+func (v *getCustomDomainCustomDomain) __premarshalJSON() (*__premarshalgetCustomDomainCustomDomain, error) {
+	var retval __premarshalgetCustomDomainCustomDomain
 
-	retval.Id = v.TCPProxy.Id
-	retval.ApplicationPort = v.TCPProxy.ApplicationPort
-	retval.ProxyPort = v.TCPProxy.ProxyPort
-	retval.Domain = v.TCPProxy.Domain
-	retval.EnvironmentId = v.TCPProxy.EnvironmentId
-	retval.ServiceId = v.TCPProxy.ServiceId
+	retval.Id = v.CustomDomain.Id
+	retval.Domain = v.CustomDomain.Domain
+	retval.TargetPort = v.CustomDomain.TargetPort
+	retval.Status = v.CustomDomain.Status
+	retval.EnvironmentId = v.CustomDomain.EnvironmentId
+	retval.ServiceId = v.CustomDomain.ServiceId
 	return &retval, nil
 }
 
-// getVariablesResponse is returned by getVariables on success.
-type getVariablesResponse struct {
-	// All variables by pluginId or serviceId. If neither are provided, all shared variables are returned.
-	Variables map[string]interface{} `json:"variables"`
+// getCustomDomainResponse is returned by getCustomDomain on success.
+type getCustomDomainResponse struct {
+	// Fetch details for a custom domain
+	CustomDomain getCustomDomainCustomDomain `json:"customDomain"`
 }
 
-// GetVariables returns getVariablesResponse.Variables, and is useful for accessing the field via an interface.
-func (v *getVariablesResponse) GetVariables() map[string]interface{} { return v.Variables }
+// GetCustomDomain returns getCustomDomainResponse.CustomDomain, and is useful for accessing the field via an interface.
+func (v *getCustomDomainResponse) GetCustomDomain() getCustomDomainCustomDomain {
+	return v.CustomDomain
+}
 
-// getVolumeInstancesProject includes the requested fields of the GraphQL type Project.
-type getVolumeInstancesProject struct {
-	Volumes getVolumeInstancesProjectVolumesProjectVolumesConnection `json:"volumes"`
+// getDeploymentDeployment includes the requested fields of the GraphQL type Deployment.
+type getDeploymentDeployment struct {
+	Deployment `json:"-"`
 }
 
-// GetVolumes returns getVolumeInstancesProject.Volumes, and is useful for accessing the field via an interface.
-func (v *getVolumeInstancesProject) GetVolumes() getVolumeInstancesProjectVolumesProjectVolumesConnection {
-	return v.Volumes
-}
+// GetId returns getDeploymentDeployment.Id, and is useful for accessing the field via an interface.
+func (v *getDeploymentDeployment) GetId() string { return v.Deployment.Id }
 
-// getVolumeInstancesProjectVolumesProjectVolumesConnection includes the requested fields of the GraphQL type ProjectVolumesConnection.
-type getVolumeInstancesProjectVolumesProjectVolumesConnection struct {
-	Edges []getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdge `json:"edges"`
-}
+// GetStatus returns getDeploymentDeployment.Status, and is useful for accessing the field via an interface.
+func (v *getDeploymentDeployment) GetStatus() DeploymentStatus { return v.Deployment.Status }
 
-// GetEdges returns getVolumeInstancesProjectVolumesProjectVolumesConnection.Edges, and is useful for accessing the field via an interface.
-func (v *getVolumeInstancesProjectVolumesProjectVolumesConnection) GetEdges() []getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdge {
-	return v.Edges
-}
+// GetUrl returns getDeploymentDeployment.Url, and is useful for accessing the field via an interface.
+func (v *getDeploymentDeployment) GetUrl() string { return v.Deployment.Url }
 
-// getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdge includes the requested fields of the GraphQL type ProjectVolumesConnectionEdge.
-type getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdge struct {
-	Node getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume `json:"node"`
+// GetStaticUrl returns getDeploymentDeployment.StaticUrl, and is useful for accessing the field via an interface.
+func (v *getDeploymentDeployment) GetStaticUrl() string { return v.Deployment.StaticUrl }
+
+// GetCanRollback returns getDeploymentDeployment.CanRollback, and is useful for accessing the field via an interface.
+func (v *getDeploymentDeployment) GetCanRollback() bool { return v.Deployment.CanRollback }
+
+// GetCreatedAt returns getDeploymentDeployment.CreatedAt, and is useful for accessing the field via an interface.
+func (v *getDeploymentDeployment) GetCreatedAt() time.Time { return v.Deployment.CreatedAt }
+
+// GetSnapshotId returns getDeploymentDeployment.SnapshotId, and is useful for accessing the field via an interface.
+func (v *getDeploymentDeployment) GetSnapshotId() string { return v.Deployment.SnapshotId }
+
+// GetMeta returns getDeploymentDeployment.Meta, and is useful for accessing the field via an interface.
+func (v *getDeploymentDeployment) GetMeta() map[string]interface{} { return v.Deployment.Meta }
+
+// GetProjectId returns getDeploymentDeployment.ProjectId, and is useful for accessing the field via an interface.
+func (v *getDeploymentDeployment) GetProjectId() string { return v.Deployment.ProjectId }
+
+// GetEnvironmentId returns getDeploymentDeployment.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *getDeploymentDeployment) GetEnvironmentId() string { return v.Deployment.EnvironmentId }
+
+// GetServiceId returns getDeploymentDeployment.ServiceId, and is useful for accessing the field via an interface.
+func (v *getDeploymentDeployment) GetServiceId() string { return v.Deployment.ServiceId }
+
+func (v *getDeploymentDeployment) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getDeploymentDeployment
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getDeploymentDeployment = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Deployment)
+	if err != nil {
+		return err
+	}
+	return nil
 }
 
-// GetNode returns getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdge.Node, and is useful for accessing the field via an interface.
-func (v *getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdge) GetNode() getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume {
-	return v.Node
+type __premarshalgetDeploymentDeployment struct {
+	Id string `json:"id"`
+
+	Status DeploymentStatus `json:"status"`
+
+	Url string `json:"url"`
+
+	StaticUrl string `json:"staticUrl"`
+
+	CanRollback bool `json:"canRollback"`
+
+	CreatedAt time.Time `json:"createdAt"`
+
+	SnapshotId string `json:"snapshotId"`
+
+	Meta map[string]interface{} `json:"meta"`
+
+	ProjectId string `json:"projectId"`
+
+	EnvironmentId string `json:"environmentId"`
+
+	ServiceId string `json:"serviceId"`
 }
 
-// getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume includes the requested fields of the GraphQL type Volume.
-type getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume struct {
-	Volume `json:"-"`
+func (v *getDeploymentDeployment) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
 }
 
-// GetId returns getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume.Id, and is useful for accessing the field via an interface.
-func (v *getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume) GetId() string {
-	return v.Volume.Id
+func (v *getDeploymentDeployment) __premarshalJSON() (*__premarshalgetDeploymentDeployment, error) {
+	var retval __premarshalgetDeploymentDeployment
+
+	retval.Id = v.Deployment.Id
+	retval.Status = v.Deployment.Status
+	retval.Url = v.Deployment.Url
+	retval.StaticUrl = v.Deployment.StaticUrl
+	retval.CanRollback = v.Deployment.CanRollback
+	retval.CreatedAt = v.Deployment.CreatedAt
+	retval.SnapshotId = v.Deployment.SnapshotId
+	retval.Meta = v.Deployment.Meta
+	retval.ProjectId = v.Deployment.ProjectId
+	retval.EnvironmentId = v.Deployment.EnvironmentId
+	retval.ServiceId = v.Deployment.ServiceId
+	return &retval, nil
 }
 
-// GetName returns getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume.Name, and is useful for accessing the field via an interface.
-func (v *getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume) GetName() string {
-	return v.Volume.Name
+// getDeploymentResponse is returned by getDeployment on success.
+type getDeploymentResponse struct {
+	// Find a single deployment
+	Deployment getDeploymentDeployment `json:"deployment"`
 }
 
-// GetVolumeInstances returns getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume.VolumeInstances, and is useful for accessing the field via an interface.
-func (v *getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume) GetVolumeInstances() VolumeVolumeInstancesVolumeVolumeInstancesConnection {
-	return v.Volume.VolumeInstances
+// GetDeployment returns getDeploymentResponse.Deployment, and is useful for accessing the field via an interface.
+func (v *getDeploymentResponse) GetDeployment() getDeploymentDeployment { return v.Deployment }
+
+// getEnvironmentEnvironment includes the requested fields of the GraphQL type Environment.
+type getEnvironmentEnvironment struct {
+	Environment `json:"-"`
 }
 
-func (v *getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume) UnmarshalJSON(b []byte) error {
+// GetId returns getEnvironmentEnvironment.Id, and is useful for accessing the field via an interface.
+func (v *getEnvironmentEnvironment) GetId() string { return v.Environment.Id }
+
+// GetName returns getEnvironmentEnvironment.Name, and is useful for accessing the field via an interface.
+func (v *getEnvironmentEnvironment) GetName() string { return v.Environment.Name }
+
+// GetProjectId returns getEnvironmentEnvironment.ProjectId, and is useful for accessing the field via an interface.
+func (v *getEnvironmentEnvironment) GetProjectId() string { return v.Environment.ProjectId }
+
+func (v *getEnvironmentEnvironment) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume
+		*getEnvironmentEnvironment
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume = v
+	firstPass.getEnvironmentEnvironment = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -2721,22 +4429,22 @@ func (v *getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVol
 	}
 
 	err = json.Unmarshal(
-		b, &v.Volume)
+		b, &v.Environment)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-type __premarshalgetVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume struct {
+type __premarshalgetEnvironmentEnvironment struct {
 	Id string `json:"id"`
 
 	Name string `json:"name"`
 
-	VolumeInstances VolumeVolumeInstancesVolumeVolumeInstancesConnection `json:"volumeInstances"`
+	ProjectId string `json:"projectId"`
 }
 
-func (v *getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume) MarshalJSON() ([]byte, error) {
+func (v *getEnvironmentEnvironment) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -2744,80 +4452,75 @@ func (v *getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVol
 	return json.Marshal(premarshaled)
 }
 
-func (v *getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume) __premarshalJSON() (*__premarshalgetVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume, error) {
-	var retval __premarshalgetVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume
+func (v *getEnvironmentEnvironment) __premarshalJSON() (*__premarshalgetEnvironmentEnvironment, error) {
+	var retval __premarshalgetEnvironmentEnvironment
 
-	retval.Id = v.Volume.Id
-	retval.Name = v.Volume.Name
-	retval.VolumeInstances = v.Volume.VolumeInstances
+	retval.Id = v.Environment.Id
+	retval.Name = v.Environment.Name
+	retval.ProjectId = v.Environment.ProjectId
 	return &retval, nil
 }
 
-// getVolumeInstancesResponse is returned by getVolumeInstances on success.
-type getVolumeInstancesResponse struct {
-	// Get a project by ID
-	Project getVolumeInstancesProject `json:"project"`
+// getEnvironmentResponse is returned by getEnvironment on success.
+type getEnvironmentResponse struct {
+	// Find a single environment
+	Environment getEnvironmentEnvironment `json:"environment"`
 }
 
-// GetProject returns getVolumeInstancesResponse.Project, and is useful for accessing the field via an interface.
-func (v *getVolumeInstancesResponse) GetProject() getVolumeInstancesProject { return v.Project }
-
-// listCustomDomainsDomainsAllDomains includes the requested fields of the GraphQL type AllDomains.
-type listCustomDomainsDomainsAllDomains struct {
-	CustomDomains []listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain `json:"customDomains"`
-}
+// GetEnvironment returns getEnvironmentResponse.Environment, and is useful for accessing the field via an interface.
+func (v *getEnvironmentResponse) GetEnvironment() getEnvironmentEnvironment { return v.Environment }
 
-// GetCustomDomains returns listCustomDomainsDomainsAllDomains.CustomDomains, and is useful for accessing the field via an interface.
-func (v *listCustomDomainsDomainsAllDomains) GetCustomDomains() []listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain {
-	return v.CustomDomains
+// getEnvironmentsEnvironmentsQueryEnvironmentsConnection includes the requested fields of the GraphQL type QueryEnvironmentsConnection.
+type getEnvironmentsEnvironmentsQueryEnvironmentsConnection struct {
+	Edges []getEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdge `json:"edges"`
 }
 
-// listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain includes the requested fields of the GraphQL type CustomDomain.
-type listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain struct {
-	CustomDomain `json:"-"`
+// GetEdges returns getEnvironmentsEnvironmentsQueryEnvironmentsConnection.Edges, and is useful for accessing the field via an interface.
+func (v *getEnvironmentsEnvironmentsQueryEnvironmentsConnection) GetEdges() []getEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdge {
+	return v.Edges
 }
 
-// GetId returns listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain.Id, and is useful for accessing the field via an interface.
-func (v *listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain) GetId() string {
-	return v.CustomDomain.Id
+// getEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdge includes the requested fields of the GraphQL type QueryEnvironmentsConnectionEdge.
+type getEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdge struct {
+	Node getEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment `json:"node"`
 }
 
-// GetDomain returns listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain.Domain, and is useful for accessing the field via an interface.
-func (v *listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain) GetDomain() string {
-	return v.CustomDomain.Domain
+// GetNode returns getEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdge.Node, and is useful for accessing the field via an interface.
+func (v *getEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdge) GetNode() getEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment {
+	return v.Node
 }
 
-// GetTargetPort returns listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain.TargetPort, and is useful for accessing the field via an interface.
-func (v *listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain) GetTargetPort() int {
-	return v.CustomDomain.TargetPort
+// getEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment includes the requested fields of the GraphQL type Environment.
+type getEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment struct {
+	Environment `json:"-"`
 }
 
-// GetStatus returns listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain.Status, and is useful for accessing the field via an interface.
-func (v *listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain) GetStatus() CustomDomainStatus {
-	return v.CustomDomain.Status
+// GetId returns getEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment.Id, and is useful for accessing the field via an interface.
+func (v *getEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment) GetId() string {
+	return v.Environment.Id
 }
 
-// GetEnvironmentId returns listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain.EnvironmentId, and is useful for accessing the field via an interface.
-func (v *listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain) GetEnvironmentId() string {
-	return v.CustomDomain.EnvironmentId
+// GetName returns getEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment.Name, and is useful for accessing the field via an interface.
+func (v *getEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment) GetName() string {
+	return v.Environment.Name
 }
 
-// GetServiceId returns listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain.ServiceId, and is useful for accessing the field via an interface.
-func (v *listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain) GetServiceId() string {
-	return v.CustomDomain.ServiceId
+// GetProjectId returns getEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment.ProjectId, and is useful for accessing the field via an interface.
+func (v *getEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment) GetProjectId() string {
+	return v.Environment.ProjectId
 }
 
-func (v *listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain) UnmarshalJSON(b []byte) error {
+func (v *getEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain
+		*getEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain = v
+	firstPass.getEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -2825,28 +4528,22 @@ func (v *listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain) UnmarshalJ
 	}
 
 	err = json.Unmarshal(
-		b, &v.CustomDomain)
+		b, &v.Environment)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-type __premarshallistCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain struct {
+type __premarshalgetEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment struct {
 	Id string `json:"id"`
 
-	Domain string `json:"domain"`
-
-	TargetPort int `json:"targetPort"`
-
-	Status CustomDomainStatus `json:"status"`
-
-	EnvironmentId string `json:"environmentId"`
+	Name string `json:"name"`
 
-	ServiceId string `json:"serviceId"`
+	ProjectId string `json:"projectId"`
 }
 
-func (v *listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain) MarshalJSON() ([]byte, error) {
+func (v *getEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -2854,125 +4551,97 @@ func (v *listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain) MarshalJSO
 	return json.Marshal(premarshaled)
 }
 
-func (v *listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain) __premarshalJSON() (*__premarshallistCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain, error) {
-	var retval __premarshallistCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain
+func (v *getEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment) __premarshalJSON() (*__premarshalgetEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment, error) {
+	var retval __premarshalgetEnvironmentsEnvironmentsQueryEnvironmentsConnectionEdgesQueryEnvironmentsConnectionEdgeNodeEnvironment
 
-	retval.Id = v.CustomDomain.Id
-	retval.Domain = v.CustomDomain.Domain
-	retval.TargetPort = v.CustomDomain.TargetPort
-	retval.Status = v.CustomDomain.Status
-	retval.EnvironmentId = v.CustomDomain.EnvironmentId
-	retval.ServiceId = v.CustomDomain.ServiceId
+	retval.Id = v.Environment.Id
+	retval.Name = v.Environment.Name
+	retval.ProjectId = v.Environment.ProjectId
 	return &retval, nil
 }
 
-// listCustomDomainsResponse is returned by listCustomDomains on success.
-type listCustomDomainsResponse struct {
-	// All domains for a service instance
-	Domains listCustomDomainsDomainsAllDomains `json:"domains"`
+// getEnvironmentsResponse is returned by getEnvironments on success.
+type getEnvironmentsResponse struct {
+	// Gets all environments for a project.
+	Environments getEnvironmentsEnvironmentsQueryEnvironmentsConnection `json:"environments"`
 }
 
-// GetDomains returns listCustomDomainsResponse.Domains, and is useful for accessing the field via an interface.
-func (v *listCustomDomainsResponse) GetDomains() listCustomDomainsDomainsAllDomains { return v.Domains }
+// GetEnvironments returns getEnvironmentsResponse.Environments, and is useful for accessing the field via an interface.
+func (v *getEnvironmentsResponse) GetEnvironments() getEnvironmentsEnvironmentsQueryEnvironmentsConnection {
+	return v.Environments
+}
 
-// listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnection includes the requested fields of the GraphQL type QueryDeploymentTriggersConnection.
-type listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnection struct {
-	Edges []listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnectionEdgesQueryDeploymentTriggersConnectionEdge `json:"edges"`
+// getEstimatedUsageEstimatedUsage includes the requested fields of the GraphQL type EstimatedUsage.
+// The GraphQL type's documentation follows.
+//
+// The estimated usage of a single measurement.
+type getEstimatedUsageEstimatedUsage struct {
+	// The measurement that was estimated.
+	Measurement MetricMeasurement `json:"measurement"`
+	// The estimated value.
+	EstimatedValue float64 `json:"estimatedValue"`
 }
 
-// GetEdges returns listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnection.Edges, and is useful for accessing the field via an interface.
-func (v *listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnection) GetEdges() []listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnectionEdgesQueryDeploymentTriggersConnectionEdge {
-	return v.Edges
+// GetMeasurement returns getEstimatedUsageEstimatedUsage.Measurement, and is useful for accessing the field via an interface.
+func (v *getEstimatedUsageEstimatedUsage) GetMeasurement() MetricMeasurement { return v.Measurement }
+
+// GetEstimatedValue returns getEstimatedUsageEstimatedUsage.EstimatedValue, and is useful for accessing the field via an interface.
+func (v *getEstimatedUsageEstimatedUsage) GetEstimatedValue() float64 { return v.EstimatedValue }
+
+// getEstimatedUsageResponse is returned by getEstimatedUsage on success.
+type getEstimatedUsageResponse struct {
+	// Get the estimated total cost of the project at the end of the current billing
+	// cycle. If no `startDate` is provided, the usage for the current billing period
+	// of the project owner is returned.
+	EstimatedUsage []getEstimatedUsageEstimatedUsage `json:"estimatedUsage"`
 }
 
-// listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnectionEdgesQueryDeploymentTriggersConnectionEdge includes the requested fields of the GraphQL type QueryDeploymentTriggersConnectionEdge.
-type listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnectionEdgesQueryDeploymentTriggersConnectionEdge struct {
-	Node listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnectionEdgesQueryDeploymentTriggersConnectionEdgeNodeDeploymentTrigger `json:"node"`
+// GetEstimatedUsage returns getEstimatedUsageResponse.EstimatedUsage, and is useful for accessing the field via an interface.
+func (v *getEstimatedUsageResponse) GetEstimatedUsage() []getEstimatedUsageEstimatedUsage {
+	return v.EstimatedUsage
 }
 
-// GetNode returns listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnectionEdgesQueryDeploymentTriggersConnectionEdge.Node, and is useful for accessing the field via an interface.
-func (v *listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnectionEdgesQueryDeploymentTriggersConnectionEdge) GetNode() listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnectionEdgesQueryDeploymentTriggersConnectionEdgeNodeDeploymentTrigger {
-	return v.Node
+// getPrivateNetworkEndpointPrivateNetworkEndpoint includes the requested fields of the GraphQL type PrivateNetworkEndpoint.
+type getPrivateNetworkEndpointPrivateNetworkEndpoint struct {
+	PrivateNetworkEndpoint `json:"-"`
 }
 
-// listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnectionEdgesQueryDeploymentTriggersConnectionEdgeNodeDeploymentTrigger includes the requested fields of the GraphQL type DeploymentTrigger.
-type listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnectionEdgesQueryDeploymentTriggersConnectionEdgeNodeDeploymentTrigger struct {
-	Id     string `json:"id"`
-	Branch string `json:"branch"`
+// GetPublicId returns getPrivateNetworkEndpointPrivateNetworkEndpoint.PublicId, and is useful for accessing the field via an interface.
+func (v *getPrivateNetworkEndpointPrivateNetworkEndpoint) GetPublicId() string {
+	return v.PrivateNetworkEndpoint.PublicId
 }
 
-// GetId returns listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnectionEdgesQueryDeploymentTriggersConnectionEdgeNodeDeploymentTrigger.Id, and is useful for accessing the field via an interface.
-func (v *listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnectionEdgesQueryDeploymentTriggersConnectionEdgeNodeDeploymentTrigger) GetId() string {
-	return v.Id
+// GetDnsName returns getPrivateNetworkEndpointPrivateNetworkEndpoint.DnsName, and is useful for accessing the field via an interface.
+func (v *getPrivateNetworkEndpointPrivateNetworkEndpoint) GetDnsName() string {
+	return v.PrivateNetworkEndpoint.DnsName
 }
 
-// GetBranch returns listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnectionEdgesQueryDeploymentTriggersConnectionEdgeNodeDeploymentTrigger.Branch, and is useful for accessing the field via an interface.
-func (v *listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnectionEdgesQueryDeploymentTriggersConnectionEdgeNodeDeploymentTrigger) GetBranch() string {
-	return v.Branch
-}
-
-// listDeploymentTriggersResponse is returned by listDeploymentTriggers on success.
-type listDeploymentTriggersResponse struct {
-	// All deployment triggers.
-	DeploymentTriggers listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnection `json:"deploymentTriggers"`
-}
-
-// GetDeploymentTriggers returns listDeploymentTriggersResponse.DeploymentTriggers, and is useful for accessing the field via an interface.
-func (v *listDeploymentTriggersResponse) GetDeploymentTriggers() listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnection {
-	return v.DeploymentTriggers
-}
-
-// listServiceDomainsDomainsAllDomains includes the requested fields of the GraphQL type AllDomains.
-type listServiceDomainsDomainsAllDomains struct {
-	ServiceDomains []listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain `json:"serviceDomains"`
-}
-
-// GetServiceDomains returns listServiceDomainsDomainsAllDomains.ServiceDomains, and is useful for accessing the field via an interface.
-func (v *listServiceDomainsDomainsAllDomains) GetServiceDomains() []listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain {
-	return v.ServiceDomains
-}
-
-// listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain includes the requested fields of the GraphQL type ServiceDomain.
-type listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain struct {
-	ServiceDomain `json:"-"`
-}
-
-// GetId returns listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain.Id, and is useful for accessing the field via an interface.
-func (v *listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain) GetId() string {
-	return v.ServiceDomain.Id
+// GetPrivateIps returns getPrivateNetworkEndpointPrivateNetworkEndpoint.PrivateIps, and is useful for accessing the field via an interface.
+func (v *getPrivateNetworkEndpointPrivateNetworkEndpoint) GetPrivateIps() []string {
+	return v.PrivateNetworkEndpoint.PrivateIps
 }
 
-// GetDomain returns listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain.Domain, and is useful for accessing the field via an interface.
-func (v *listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain) GetDomain() string {
-	return v.ServiceDomain.Domain
-}
-
-// GetSuffix returns listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain.Suffix, and is useful for accessing the field via an interface.
-func (v *listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain) GetSuffix() string {
-	return v.ServiceDomain.Suffix
+// GetServiceInstanceId returns getPrivateNetworkEndpointPrivateNetworkEndpoint.ServiceInstanceId, and is useful for accessing the field via an interface.
+func (v *getPrivateNetworkEndpointPrivateNetworkEndpoint) GetServiceInstanceId() string {
+	return v.PrivateNetworkEndpoint.ServiceInstanceId
 }
 
-// GetEnvironmentId returns listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain.EnvironmentId, and is useful for accessing the field via an interface.
-func (v *listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain) GetEnvironmentId() string {
-	return v.ServiceDomain.EnvironmentId
-}
-
-// GetServiceId returns listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain.ServiceId, and is useful for accessing the field via an interface.
-func (v *listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain) GetServiceId() string {
-	return v.ServiceDomain.ServiceId
+// GetTags returns getPrivateNetworkEndpointPrivateNetworkEndpoint.Tags, and is useful for accessing the field via an interface.
+func (v *getPrivateNetworkEndpointPrivateNetworkEndpoint) GetTags() []string {
+	return v.PrivateNetworkEndpoint.Tags
 }
 
-func (v *listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain) UnmarshalJSON(b []byte) error {
+func (v *getPrivateNetworkEndpointPrivateNetworkEndpoint) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain
+		*getPrivateNetworkEndpointPrivateNetworkEndpoint
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain = v
+	firstPass.getPrivateNetworkEndpointPrivateNetworkEndpoint = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -2980,26 +4649,26 @@ func (v *listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain) Unmarsh
 	}
 
 	err = json.Unmarshal(
-		b, &v.ServiceDomain)
+		b, &v.PrivateNetworkEndpoint)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-type __premarshallistServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain struct {
-	Id string `json:"id"`
+type __premarshalgetPrivateNetworkEndpointPrivateNetworkEndpoint struct {
+	PublicId string `json:"publicId"`
 
-	Domain string `json:"domain"`
+	DnsName string `json:"dnsName"`
 
-	Suffix string `json:"suffix"`
+	PrivateIps []string `json:"privateIps"`
 
-	EnvironmentId string `json:"environmentId"`
+	ServiceInstanceId string `json:"serviceInstanceId"`
 
-	ServiceId string `json:"serviceId"`
+	Tags []string `json:"tags"`
 }
 
-func (v *listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain) MarshalJSON() ([]byte, error) {
+func (v *getPrivateNetworkEndpointPrivateNetworkEndpoint) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -3007,89 +4676,70 @@ func (v *listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain) Marshal
 	return json.Marshal(premarshaled)
 }
 
-func (v *listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain) __premarshalJSON() (*__premarshallistServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain, error) {
-	var retval __premarshallistServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain
+func (v *getPrivateNetworkEndpointPrivateNetworkEndpoint) __premarshalJSON() (*__premarshalgetPrivateNetworkEndpointPrivateNetworkEndpoint, error) {
+	var retval __premarshalgetPrivateNetworkEndpointPrivateNetworkEndpoint
 
-	retval.Id = v.ServiceDomain.Id
-	retval.Domain = v.ServiceDomain.Domain
-	retval.Suffix = v.ServiceDomain.Suffix
-	retval.EnvironmentId = v.ServiceDomain.EnvironmentId
-	retval.ServiceId = v.ServiceDomain.ServiceId
+	retval.PublicId = v.PrivateNetworkEndpoint.PublicId
+	retval.DnsName = v.PrivateNetworkEndpoint.DnsName
+	retval.PrivateIps = v.PrivateNetworkEndpoint.PrivateIps
+	retval.ServiceInstanceId = v.PrivateNetworkEndpoint.ServiceInstanceId
+	retval.Tags = v.PrivateNetworkEndpoint.Tags
 	return &retval, nil
 }
 
-// listServiceDomainsResponse is returned by listServiceDomains on success.
-type listServiceDomainsResponse struct {
-	// All domains for a service instance
-	Domains listServiceDomainsDomainsAllDomains `json:"domains"`
-}
-
-// GetDomains returns listServiceDomainsResponse.Domains, and is useful for accessing the field via an interface.
-func (v *listServiceDomainsResponse) GetDomains() listServiceDomainsDomainsAllDomains {
-	return v.Domains
-}
-
-// redeployServiceInstanceResponse is returned by redeployServiceInstance on success.
-type redeployServiceInstanceResponse struct {
-	// Redeploy a service instance
-	ServiceInstanceRedeploy bool `json:"serviceInstanceRedeploy"`
-}
-
-// GetServiceInstanceRedeploy returns redeployServiceInstanceResponse.ServiceInstanceRedeploy, and is useful for accessing the field via an interface.
-func (v *redeployServiceInstanceResponse) GetServiceInstanceRedeploy() bool {
-	return v.ServiceInstanceRedeploy
+// getPrivateNetworkEndpointResponse is returned by getPrivateNetworkEndpoint on success.
+type getPrivateNetworkEndpointResponse struct {
+	// Get a private network endpoint for a service instance.
+	PrivateNetworkEndpoint *getPrivateNetworkEndpointPrivateNetworkEndpoint `json:"privateNetworkEndpoint"`
 }
 
-// updateCustomDomainResponse is returned by updateCustomDomain on success.
-type updateCustomDomainResponse struct {
-	// Updates a custom domain.
-	CustomDomainUpdate bool `json:"customDomainUpdate"`
+// GetPrivateNetworkEndpoint returns getPrivateNetworkEndpointResponse.PrivateNetworkEndpoint, and is useful for accessing the field via an interface.
+func (v *getPrivateNetworkEndpointResponse) GetPrivateNetworkEndpoint() *getPrivateNetworkEndpointPrivateNetworkEndpoint {
+	return v.PrivateNetworkEndpoint
 }
 
-// GetCustomDomainUpdate returns updateCustomDomainResponse.CustomDomainUpdate, and is useful for accessing the field via an interface.
-func (v *updateCustomDomainResponse) GetCustomDomainUpdate() bool { return v.CustomDomainUpdate }
-
-// updateProjectProjectUpdateProject includes the requested fields of the GraphQL type Project.
-type updateProjectProjectUpdateProject struct {
+// getProjectProject includes the requested fields of the GraphQL type Project.
+type getProjectProject struct {
 	Project `json:"-"`
 }
 
-// GetId returns updateProjectProjectUpdateProject.Id, and is useful for accessing the field via an interface.
-func (v *updateProjectProjectUpdateProject) GetId() string { return v.Project.Id }
+// GetId returns getProjectProject.Id, and is useful for accessing the field via an interface.
+func (v *getProjectProject) GetId() string { return v.Project.Id }
 
-// GetName returns updateProjectProjectUpdateProject.Name, and is useful for accessing the field via an interface.
-func (v *updateProjectProjectUpdateProject) GetName() string { return v.Project.Name }
+// GetName returns getProjectProject.Name, and is useful for accessing the field via an interface.
+func (v *getProjectProject) GetName() string { return v.Project.Name }
 
-// GetDescription returns updateProjectProjectUpdateProject.Description, and is useful for accessing the field via an interface.
-func (v *updateProjectProjectUpdateProject) GetDescription() string { return v.Project.Description }
+// GetDescription returns getProjectProject.Description, and is useful for accessing the field via an interface.
+func (v *getProjectProject) GetDescription() string { return v.Project.Description }
 
-// GetIsPublic returns updateProjectProjectUpdateProject.IsPublic, and is useful for accessing the field via an interface.
-func (v *updateProjectProjectUpdateProject) GetIsPublic() bool { return v.Project.IsPublic }
+// GetIsPublic returns getProjectProject.IsPublic, and is useful for accessing the field via an interface.
+func (v *getProjectProject) GetIsPublic() bool { return v.Project.IsPublic }
 
-// GetPrDeploys returns updateProjectProjectUpdateProject.PrDeploys, and is useful for accessing the field via an interface.
-func (v *updateProjectProjectUpdateProject) GetPrDeploys() bool { return v.Project.PrDeploys }
+// GetPrDeploys returns getProjectProject.PrDeploys, and is useful for accessing the field via an interface.
+func (v *getProjectProject) GetPrDeploys() bool { return v.Project.PrDeploys }
 
-// GetWorkspace returns updateProjectProjectUpdateProject.Workspace, and is useful for accessing the field via an interface.
-func (v *updateProjectProjectUpdateProject) GetWorkspace() *ProjectWorkspace {
-	return v.Project.Workspace
-}
+// GetDeletedAt returns getProjectProject.DeletedAt, and is useful for accessing the field via an interface.
+func (v *getProjectProject) GetDeletedAt() time.Time { return v.Project.DeletedAt }
 
-// GetEnvironments returns updateProjectProjectUpdateProject.Environments, and is useful for accessing the field via an interface.
-func (v *updateProjectProjectUpdateProject) GetEnvironments() ProjectEnvironmentsProjectEnvironmentsConnection {
+// GetWorkspace returns getProjectProject.Workspace, and is useful for accessing the field via an interface.
+func (v *getProjectProject) GetWorkspace() *ProjectWorkspace { return v.Project.Workspace }
+
+// GetEnvironments returns getProjectProject.Environments, and is useful for accessing the field via an interface.
+func (v *getProjectProject) GetEnvironments() ProjectEnvironmentsProjectEnvironmentsConnection {
 	return v.Project.Environments
 }
 
-func (v *updateProjectProjectUpdateProject) UnmarshalJSON(b []byte) error {
+func (v *getProjectProject) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*updateProjectProjectUpdateProject
+		*getProjectProject
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.updateProjectProjectUpdateProject = v
+	firstPass.getProjectProject = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -3104,7 +4754,7 @@ func (v *updateProjectProjectUpdateProject) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-type __premarshalupdateProjectProjectUpdateProject struct {
+type __premarshalgetProjectProject struct {
 	Id string `json:"id"`
 
 	Name string `json:"name"`
@@ -3115,12 +4765,14 @@ type __premarshalupdateProjectProjectUpdateProject struct {
 
 	PrDeploys bool `json:"prDeploys"`
 
+	DeletedAt time.Time `json:"deletedAt"`
+
 	Workspace *ProjectWorkspace `json:"workspace"`
 
 	Environments ProjectEnvironmentsProjectEnvironmentsConnection `json:"environments"`
 }
 
-func (v *updateProjectProjectUpdateProject) MarshalJSON() ([]byte, error) {
+func (v *getProjectProject) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -3128,90 +4780,190 @@ func (v *updateProjectProjectUpdateProject) MarshalJSON() ([]byte, error) {
 	return json.Marshal(premarshaled)
 }
 
-func (v *updateProjectProjectUpdateProject) __premarshalJSON() (*__premarshalupdateProjectProjectUpdateProject, error) {
-	var retval __premarshalupdateProjectProjectUpdateProject
+func (v *getProjectProject) __premarshalJSON() (*__premarshalgetProjectProject, error) {
+	var retval __premarshalgetProjectProject
 
 	retval.Id = v.Project.Id
 	retval.Name = v.Project.Name
 	retval.Description = v.Project.Description
 	retval.IsPublic = v.Project.IsPublic
 	retval.PrDeploys = v.Project.PrDeploys
+	retval.DeletedAt = v.Project.DeletedAt
 	retval.Workspace = v.Project.Workspace
 	retval.Environments = v.Project.Environments
 	return &retval, nil
 }
 
-// updateProjectResponse is returned by updateProject on success.
-type updateProjectResponse struct {
-	// Updates a project.
-	ProjectUpdate updateProjectProjectUpdateProject `json:"projectUpdate"`
+// getProjectResponse is returned by getProject on success.
+type getProjectResponse struct {
+	// Get a project by ID
+	Project getProjectProject `json:"project"`
 }
 
-// GetProjectUpdate returns updateProjectResponse.ProjectUpdate, and is useful for accessing the field via an interface.
-func (v *updateProjectResponse) GetProjectUpdate() updateProjectProjectUpdateProject {
-	return v.ProjectUpdate
+// GetProject returns getProjectResponse.Project, and is useful for accessing the field via an interface.
+func (v *getProjectResponse) GetProject() getProjectProject { return v.Project }
+
+// getServiceInstanceResponse is returned by getServiceInstance on success.
+type getServiceInstanceResponse struct {
+	// Get a service instance belonging to a service and environment
+	ServiceInstance getServiceInstanceServiceInstance `json:"serviceInstance"`
 }
 
-// updateServiceDomainResponse is returned by updateServiceDomain on success.
-type updateServiceDomainResponse struct {
-	// Updates a service domain.
-	ServiceDomainUpdate bool `json:"serviceDomainUpdate"`
+// GetServiceInstance returns getServiceInstanceResponse.ServiceInstance, and is useful for accessing the field via an interface.
+func (v *getServiceInstanceResponse) GetServiceInstance() getServiceInstanceServiceInstance {
+	return v.ServiceInstance
 }
 
-// GetServiceDomainUpdate returns updateServiceDomainResponse.ServiceDomainUpdate, and is useful for accessing the field via an interface.
-func (v *updateServiceDomainResponse) GetServiceDomainUpdate() bool { return v.ServiceDomainUpdate }
+// getServiceInstanceServiceInstance includes the requested fields of the GraphQL type ServiceInstance.
+type getServiceInstanceServiceInstance struct {
+	Source            *getServiceInstanceServiceInstanceSourceServiceSource `json:"source"`
+	RootDirectory     *string                                               `json:"rootDirectory"`
+	RailwayConfigFile *string                                               `json:"railwayConfigFile"`
+	CronSchedule      *string                                               `json:"cronSchedule"`
+	NextCronRunAt     time.Time                                             `json:"nextCronRunAt"`
+	// The most recent deployment for this service instance
+	LatestDeployment getServiceInstanceServiceInstanceLatestDeployment `json:"latestDeployment"`
+}
 
-// updateServiceInstanceResponse is returned by updateServiceInstance on success.
-type updateServiceInstanceResponse struct {
-	// Update a service instance
-	ServiceInstanceUpdate bool `json:"serviceInstanceUpdate"`
+// GetSource returns getServiceInstanceServiceInstance.Source, and is useful for accessing the field via an interface.
+func (v *getServiceInstanceServiceInstance) GetSource() *getServiceInstanceServiceInstanceSourceServiceSource {
+	return v.Source
 }
 
-// GetServiceInstanceUpdate returns updateServiceInstanceResponse.ServiceInstanceUpdate, and is useful for accessing the field via an interface.
-func (v *updateServiceInstanceResponse) GetServiceInstanceUpdate() bool {
-	return v.ServiceInstanceUpdate
+// GetRootDirectory returns getServiceInstanceServiceInstance.RootDirectory, and is useful for accessing the field via an interface.
+func (v *getServiceInstanceServiceInstance) GetRootDirectory() *string { return v.RootDirectory }
+
+// GetRailwayConfigFile returns getServiceInstanceServiceInstance.RailwayConfigFile, and is useful for accessing the field via an interface.
+func (v *getServiceInstanceServiceInstance) GetRailwayConfigFile() *string {
+	return v.RailwayConfigFile
 }
 
-// updateServiceResponse is returned by updateService on success.
-type updateServiceResponse struct {
-	// Updates a service.
-	ServiceUpdate updateServiceServiceUpdateService `json:"serviceUpdate"`
+// GetCronSchedule returns getServiceInstanceServiceInstance.CronSchedule, and is useful for accessing the field via an interface.
+func (v *getServiceInstanceServiceInstance) GetCronSchedule() *string { return v.CronSchedule }
+
+// GetNextCronRunAt returns getServiceInstanceServiceInstance.NextCronRunAt, and is useful for accessing the field via an interface.
+func (v *getServiceInstanceServiceInstance) GetNextCronRunAt() time.Time { return v.NextCronRunAt }
+
+// GetLatestDeployment returns getServiceInstanceServiceInstance.LatestDeployment, and is useful for accessing the field via an interface.
+func (v *getServiceInstanceServiceInstance) GetLatestDeployment() getServiceInstanceServiceInstanceLatestDeployment {
+	return v.LatestDeployment
 }
 
-// GetServiceUpdate returns updateServiceResponse.ServiceUpdate, and is useful for accessing the field via an interface.
-func (v *updateServiceResponse) GetServiceUpdate() updateServiceServiceUpdateService {
-	return v.ServiceUpdate
+// getServiceInstanceServiceInstanceLatestDeployment includes the requested fields of the GraphQL type Deployment.
+type getServiceInstanceServiceInstanceLatestDeployment struct {
+	Meta map[string]interface{} `json:"meta"`
 }
 
-// updateServiceServiceUpdateService includes the requested fields of the GraphQL type Service.
-type updateServiceServiceUpdateService struct {
-	Service `json:"-"`
+// GetMeta returns getServiceInstanceServiceInstanceLatestDeployment.Meta, and is useful for accessing the field via an interface.
+func (v *getServiceInstanceServiceInstanceLatestDeployment) GetMeta() map[string]interface{} {
+	return v.Meta
 }
 
-// GetId returns updateServiceServiceUpdateService.Id, and is useful for accessing the field via an interface.
-func (v *updateServiceServiceUpdateService) GetId() string { return v.Service.Id }
+// getServiceInstanceServiceInstanceSourceServiceSource includes the requested fields of the GraphQL type ServiceSource.
+type getServiceInstanceServiceInstanceSourceServiceSource struct {
+	Image *string `json:"image"`
+	Repo  *string `json:"repo"`
+}
 
-// GetName returns updateServiceServiceUpdateService.Name, and is useful for accessing the field via an interface.
-func (v *updateServiceServiceUpdateService) GetName() string { return v.Service.Name }
+// GetImage returns getServiceInstanceServiceInstanceSourceServiceSource.Image, and is useful for accessing the field via an interface.
+func (v *getServiceInstanceServiceInstanceSourceServiceSource) GetImage() *string { return v.Image }
 
-// GetProjectId returns updateServiceServiceUpdateService.ProjectId, and is useful for accessing the field via an interface.
-func (v *updateServiceServiceUpdateService) GetProjectId() string { return v.Service.ProjectId }
+// GetRepo returns getServiceInstanceServiceInstanceSourceServiceSource.Repo, and is useful for accessing the field via an interface.
+func (v *getServiceInstanceServiceInstanceSourceServiceSource) GetRepo() *string { return v.Repo }
 
-func (v *updateServiceServiceUpdateService) UnmarshalJSON(b []byte) error {
+// getServiceInstancesResponse is returned by getServiceInstances on success.
+type getServiceInstancesResponse struct {
+	// Get a service by ID
+	Service getServiceInstancesService `json:"service"`
+}
 
-	if string(b) == "null" {
-		return nil
-	}
+// GetService returns getServiceInstancesResponse.Service, and is useful for accessing the field via an interface.
+func (v *getServiceInstancesResponse) GetService() getServiceInstancesService { return v.Service }
 
-	var firstPass struct {
-		*updateServiceServiceUpdateService
-		graphql.NoUnmarshalJSON
-	}
-	firstPass.updateServiceServiceUpdateService = v
+// getServiceInstancesService includes the requested fields of the GraphQL type Service.
+type getServiceInstancesService struct {
+	ServiceInstances getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnection `json:"serviceInstances"`
+}
 
-	err := json.Unmarshal(b, &firstPass)
-	if err != nil {
-		return err
+// GetServiceInstances returns getServiceInstancesService.ServiceInstances, and is useful for accessing the field via an interface.
+func (v *getServiceInstancesService) GetServiceInstances() getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnection {
+	return v.ServiceInstances
+}
+
+// getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnection includes the requested fields of the GraphQL type ServiceServiceInstancesConnection.
+type getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnection struct {
+	Edges []getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnectionEdgesServiceServiceInstancesConnectionEdge `json:"edges"`
+}
+
+// GetEdges returns getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnection.Edges, and is useful for accessing the field via an interface.
+func (v *getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnection) GetEdges() []getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnectionEdgesServiceServiceInstancesConnectionEdge {
+	return v.Edges
+}
+
+// getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnectionEdgesServiceServiceInstancesConnectionEdge includes the requested fields of the GraphQL type ServiceServiceInstancesConnectionEdge.
+type getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnectionEdgesServiceServiceInstancesConnectionEdge struct {
+	Node getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnectionEdgesServiceServiceInstancesConnectionEdgeNodeServiceInstance `json:"node"`
+}
+
+// GetNode returns getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnectionEdgesServiceServiceInstancesConnectionEdge.Node, and is useful for accessing the field via an interface.
+func (v *getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnectionEdgesServiceServiceInstancesConnectionEdge) GetNode() getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnectionEdgesServiceServiceInstancesConnectionEdgeNodeServiceInstance {
+	return v.Node
+}
+
+// getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnectionEdgesServiceServiceInstancesConnectionEdgeNodeServiceInstance includes the requested fields of the GraphQL type ServiceInstance.
+type getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnectionEdgesServiceServiceInstancesConnectionEdgeNodeServiceInstance struct {
+	Id            string `json:"id"`
+	EnvironmentId string `json:"environmentId"`
+}
+
+// GetId returns getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnectionEdgesServiceServiceInstancesConnectionEdgeNodeServiceInstance.Id, and is useful for accessing the field via an interface.
+func (v *getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnectionEdgesServiceServiceInstancesConnectionEdgeNodeServiceInstance) GetId() string {
+	return v.Id
+}
+
+// GetEnvironmentId returns getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnectionEdgesServiceServiceInstancesConnectionEdgeNodeServiceInstance.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *getServiceInstancesServiceServiceInstancesServiceServiceInstancesConnectionEdgesServiceServiceInstancesConnectionEdgeNodeServiceInstance) GetEnvironmentId() string {
+	return v.EnvironmentId
+}
+
+// getServiceResponse is returned by getService on success.
+type getServiceResponse struct {
+	// Get a service by ID
+	Service getServiceService `json:"service"`
+}
+
+// GetService returns getServiceResponse.Service, and is useful for accessing the field via an interface.
+func (v *getServiceResponse) GetService() getServiceService { return v.Service }
+
+// getServiceService includes the requested fields of the GraphQL type Service.
+type getServiceService struct {
+	Service `json:"-"`
+}
+
+// GetId returns getServiceService.Id, and is useful for accessing the field via an interface.
+func (v *getServiceService) GetId() string { return v.Service.Id }
+
+// GetName returns getServiceService.Name, and is useful for accessing the field via an interface.
+func (v *getServiceService) GetName() string { return v.Service.Name }
+
+// GetProjectId returns getServiceService.ProjectId, and is useful for accessing the field via an interface.
+func (v *getServiceService) GetProjectId() string { return v.Service.ProjectId }
+
+func (v *getServiceService) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getServiceService
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getServiceService = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
 	}
 
 	err = json.Unmarshal(
@@ -3222,7 +4974,7 @@ func (v *updateServiceServiceUpdateService) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-type __premarshalupdateServiceServiceUpdateService struct {
+type __premarshalgetServiceService struct {
 	Id string `json:"id"`
 
 	Name string `json:"name"`
@@ -3230,7 +4982,7 @@ type __premarshalupdateServiceServiceUpdateService struct {
 	ProjectId string `json:"projectId"`
 }
 
-func (v *updateServiceServiceUpdateService) MarshalJSON() ([]byte, error) {
+func (v *getServiceService) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -3238,8 +4990,8 @@ func (v *updateServiceServiceUpdateService) MarshalJSON() ([]byte, error) {
 	return json.Marshal(premarshaled)
 }
 
-func (v *updateServiceServiceUpdateService) __premarshalJSON() (*__premarshalupdateServiceServiceUpdateService, error) {
-	var retval __premarshalupdateServiceServiceUpdateService
+func (v *getServiceService) __premarshalJSON() (*__premarshalgetServiceService, error) {
+	var retval __premarshalgetServiceService
 
 	retval.Id = v.Service.Id
 	retval.Name = v.Service.Name
@@ -3247,53 +4999,83 @@ func (v *updateServiceServiceUpdateService) __premarshalJSON() (*__premarshalupd
 	return &retval, nil
 }
 
-// updateVolumeInstanceResponse is returned by updateVolumeInstance on success.
-type updateVolumeInstanceResponse struct {
-	// Update a volume instance. If no environmentId is provided, all volume instances for the volume will be updated.
-	VolumeInstanceUpdate bool `json:"volumeInstanceUpdate"`
+// getSharedVariablesResponse is returned by getSharedVariables on success.
+type getSharedVariablesResponse struct {
+	// All variables by pluginId or serviceId. If neither are provided, all shared variables are returned.
+	Variables map[string]interface{} `json:"variables"`
 }
 
-// GetVolumeInstanceUpdate returns updateVolumeInstanceResponse.VolumeInstanceUpdate, and is useful for accessing the field via an interface.
-func (v *updateVolumeInstanceResponse) GetVolumeInstanceUpdate() bool { return v.VolumeInstanceUpdate }
+// GetVariables returns getSharedVariablesResponse.Variables, and is useful for accessing the field via an interface.
+func (v *getSharedVariablesResponse) GetVariables() map[string]interface{} { return v.Variables }
 
-// updateVolumeResponse is returned by updateVolume on success.
-type updateVolumeResponse struct {
-	// Update a persistent volume in a project
-	VolumeUpdate updateVolumeVolumeUpdateVolume `json:"volumeUpdate"`
+// getStagedChangesEnvironmentStagedChangesEnvironmentPatch includes the requested fields of the GraphQL type EnvironmentPatch.
+type getStagedChangesEnvironmentStagedChangesEnvironmentPatch struct {
+	Id    string                 `json:"id"`
+	Patch map[string]interface{} `json:"patch"`
 }
 
-// GetVolumeUpdate returns updateVolumeResponse.VolumeUpdate, and is useful for accessing the field via an interface.
-func (v *updateVolumeResponse) GetVolumeUpdate() updateVolumeVolumeUpdateVolume {
-	return v.VolumeUpdate
+// GetId returns getStagedChangesEnvironmentStagedChangesEnvironmentPatch.Id, and is useful for accessing the field via an interface.
+func (v *getStagedChangesEnvironmentStagedChangesEnvironmentPatch) GetId() string { return v.Id }
+
+// GetPatch returns getStagedChangesEnvironmentStagedChangesEnvironmentPatch.Patch, and is useful for accessing the field via an interface.
+func (v *getStagedChangesEnvironmentStagedChangesEnvironmentPatch) GetPatch() map[string]interface{} {
+	return v.Patch
 }
 
-// updateVolumeVolumeUpdateVolume includes the requested fields of the GraphQL type Volume.
-type updateVolumeVolumeUpdateVolume struct {
-	Volume `json:"-"`
+// getStagedChangesResponse is returned by getStagedChanges on success.
+type getStagedChangesResponse struct {
+	// Get the latest staged commit for a single environment.
+	EnvironmentStagedChanges getStagedChangesEnvironmentStagedChangesEnvironmentPatch `json:"environmentStagedChanges"`
 }
 
-// GetId returns updateVolumeVolumeUpdateVolume.Id, and is useful for accessing the field via an interface.
-func (v *updateVolumeVolumeUpdateVolume) GetId() string { return v.Volume.Id }
+// GetEnvironmentStagedChanges returns getStagedChangesResponse.EnvironmentStagedChanges, and is useful for accessing the field via an interface.
+func (v *getStagedChangesResponse) GetEnvironmentStagedChanges() getStagedChangesEnvironmentStagedChangesEnvironmentPatch {
+	return v.EnvironmentStagedChanges
+}
 
-// GetName returns updateVolumeVolumeUpdateVolume.Name, and is useful for accessing the field via an interface.
-func (v *updateVolumeVolumeUpdateVolume) GetName() string { return v.Volume.Name }
+// getTcpProxyResponse is returned by getTcpProxy on success.
+type getTcpProxyResponse struct {
+	// All TCP proxies for a service instance
+	TcpProxies []getTcpProxyTcpProxiesTCPProxy `json:"tcpProxies"`
+}
 
-// GetVolumeInstances returns updateVolumeVolumeUpdateVolume.VolumeInstances, and is useful for accessing the field via an interface.
-func (v *updateVolumeVolumeUpdateVolume) GetVolumeInstances() VolumeVolumeInstancesVolumeVolumeInstancesConnection {
-	return v.Volume.VolumeInstances
+// GetTcpProxies returns getTcpProxyResponse.TcpProxies, and is useful for accessing the field via an interface.
+func (v *getTcpProxyResponse) GetTcpProxies() []getTcpProxyTcpProxiesTCPProxy { return v.TcpProxies }
+
+// getTcpProxyTcpProxiesTCPProxy includes the requested fields of the GraphQL type TCPProxy.
+type getTcpProxyTcpProxiesTCPProxy struct {
+	TCPProxy `json:"-"`
 }
 
-func (v *updateVolumeVolumeUpdateVolume) UnmarshalJSON(b []byte) error {
+// GetId returns getTcpProxyTcpProxiesTCPProxy.Id, and is useful for accessing the field via an interface.
+func (v *getTcpProxyTcpProxiesTCPProxy) GetId() string { return v.TCPProxy.Id }
+
+// GetApplicationPort returns getTcpProxyTcpProxiesTCPProxy.ApplicationPort, and is useful for accessing the field via an interface.
+func (v *getTcpProxyTcpProxiesTCPProxy) GetApplicationPort() int { return v.TCPProxy.ApplicationPort }
+
+// GetProxyPort returns getTcpProxyTcpProxiesTCPProxy.ProxyPort, and is useful for accessing the field via an interface.
+func (v *getTcpProxyTcpProxiesTCPProxy) GetProxyPort() int { return v.TCPProxy.ProxyPort }
+
+// GetDomain returns getTcpProxyTcpProxiesTCPProxy.Domain, and is useful for accessing the field via an interface.
+func (v *getTcpProxyTcpProxiesTCPProxy) GetDomain() string { return v.TCPProxy.Domain }
+
+// GetEnvironmentId returns getTcpProxyTcpProxiesTCPProxy.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *getTcpProxyTcpProxiesTCPProxy) GetEnvironmentId() string { return v.TCPProxy.EnvironmentId }
+
+// GetServiceId returns getTcpProxyTcpProxiesTCPProxy.ServiceId, and is useful for accessing the field via an interface.
+func (v *getTcpProxyTcpProxiesTCPProxy) GetServiceId() string { return v.TCPProxy.ServiceId }
+
+func (v *getTcpProxyTcpProxiesTCPProxy) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*updateVolumeVolumeUpdateVolume
+		*getTcpProxyTcpProxiesTCPProxy
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.updateVolumeVolumeUpdateVolume = v
+	firstPass.getTcpProxyTcpProxiesTCPProxy = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -3301,22 +5083,28 @@ func (v *updateVolumeVolumeUpdateVolume) UnmarshalJSON(b []byte) error {
 	}
 
 	err = json.Unmarshal(
-		b, &v.Volume)
+		b, &v.TCPProxy)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-type __premarshalupdateVolumeVolumeUpdateVolume struct {
+type __premarshalgetTcpProxyTcpProxiesTCPProxy struct {
 	Id string `json:"id"`
 
-	Name string `json:"name"`
+	ApplicationPort int `json:"applicationPort"`
 
-	VolumeInstances VolumeVolumeInstancesVolumeVolumeInstancesConnection `json:"volumeInstances"`
+	ProxyPort int `json:"proxyPort"`
+
+	Domain string `json:"domain"`
+
+	EnvironmentId string `json:"environmentId"`
+
+	ServiceId string `json:"serviceId"`
 }
 
-func (v *updateVolumeVolumeUpdateVolume) MarshalJSON() ([]byte, error) {
+func (v *getTcpProxyTcpProxiesTCPProxy) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -3324,63 +5112,4499 @@ func (v *updateVolumeVolumeUpdateVolume) MarshalJSON() ([]byte, error) {
 	return json.Marshal(premarshaled)
 }
 
-func (v *updateVolumeVolumeUpdateVolume) __premarshalJSON() (*__premarshalupdateVolumeVolumeUpdateVolume, error) {
-	var retval __premarshalupdateVolumeVolumeUpdateVolume
+func (v *getTcpProxyTcpProxiesTCPProxy) __premarshalJSON() (*__premarshalgetTcpProxyTcpProxiesTCPProxy, error) {
+	var retval __premarshalgetTcpProxyTcpProxiesTCPProxy
 
-	retval.Id = v.Volume.Id
-	retval.Name = v.Volume.Name
-	retval.VolumeInstances = v.Volume.VolumeInstances
+	retval.Id = v.TCPProxy.Id
+	retval.ApplicationPort = v.TCPProxy.ApplicationPort
+	retval.ProxyPort = v.TCPProxy.ProxyPort
+	retval.Domain = v.TCPProxy.Domain
+	retval.EnvironmentId = v.TCPProxy.EnvironmentId
+	retval.ServiceId = v.TCPProxy.ServiceId
 	return &retval, nil
 }
 
-// upsertVariableCollectionResponse is returned by upsertVariableCollection on success.
-type upsertVariableCollectionResponse struct {
-	// Upserts a collection of variables.
-	VariableCollectionUpsert bool `json:"variableCollectionUpsert"`
+// getTemplateResponse is returned by getTemplate on success.
+type getTemplateResponse struct {
+	// Get a template by code or ID or GitHub owner and repo.
+	Template getTemplateTemplate `json:"template"`
 }
 
-// GetVariableCollectionUpsert returns upsertVariableCollectionResponse.VariableCollectionUpsert, and is useful for accessing the field via an interface.
-func (v *upsertVariableCollectionResponse) GetVariableCollectionUpsert() bool {
-	return v.VariableCollectionUpsert
+// GetTemplate returns getTemplateResponse.Template, and is useful for accessing the field via an interface.
+func (v *getTemplateResponse) GetTemplate() getTemplateTemplate { return v.Template }
+
+// getTemplateTemplate includes the requested fields of the GraphQL type Template.
+type getTemplateTemplate struct {
+	Id               string                  `json:"id"`
+	Code             string                  `json:"code"`
+	SerializedConfig *map[string]interface{} `json:"serializedConfig"`
+}
+
+// GetId returns getTemplateTemplate.Id, and is useful for accessing the field via an interface.
+func (v *getTemplateTemplate) GetId() string { return v.Id }
+
+// GetCode returns getTemplateTemplate.Code, and is useful for accessing the field via an interface.
+func (v *getTemplateTemplate) GetCode() string { return v.Code }
+
+// GetSerializedConfig returns getTemplateTemplate.SerializedConfig, and is useful for accessing the field via an interface.
+func (v *getTemplateTemplate) GetSerializedConfig() *map[string]interface{} {
+	return v.SerializedConfig
+}
+
+// getUsageResponse is returned by getUsage on success.
+type getUsageResponse struct {
+	// Get the usage for a single project or all projects for a user/workspace. If no
+	// `projectId` or `workspaceId` is provided, the usage for the current user is
+	// returned. If no `startDate` is provided, the usage for the current billing
+	// period of the project owner is returned.
+	Usage []getUsageUsageAggregatedUsage `json:"usage"`
+}
+
+// GetUsage returns getUsageResponse.Usage, and is useful for accessing the field via an interface.
+func (v *getUsageResponse) GetUsage() []getUsageUsageAggregatedUsage { return v.Usage }
+
+// getUsageUsageAggregatedUsage includes the requested fields of the GraphQL type AggregatedUsage.
+// The GraphQL type's documentation follows.
+//
+// The aggregated usage of a single measurement.
+type getUsageUsageAggregatedUsage struct {
+	// The measurement that was aggregated.
+	Measurement MetricMeasurement `json:"measurement"`
+	// The aggregated value.
+	Value float64 `json:"value"`
+}
+
+// GetMeasurement returns getUsageUsageAggregatedUsage.Measurement, and is useful for accessing the field via an interface.
+func (v *getUsageUsageAggregatedUsage) GetMeasurement() MetricMeasurement { return v.Measurement }
+
+// GetValue returns getUsageUsageAggregatedUsage.Value, and is useful for accessing the field via an interface.
+func (v *getUsageUsageAggregatedUsage) GetValue() float64 { return v.Value }
+
+// getVariablesResponse is returned by getVariables on success.
+type getVariablesResponse struct {
+	// All variables by pluginId or serviceId. If neither are provided, all shared variables are returned.
+	Variables map[string]interface{} `json:"variables"`
+}
+
+// GetVariables returns getVariablesResponse.Variables, and is useful for accessing the field via an interface.
+func (v *getVariablesResponse) GetVariables() map[string]interface{} { return v.Variables }
+
+// getVolumeInstancesProject includes the requested fields of the GraphQL type Project.
+type getVolumeInstancesProject struct {
+	Volumes getVolumeInstancesProjectVolumesProjectVolumesConnection `json:"volumes"`
+}
+
+// GetVolumes returns getVolumeInstancesProject.Volumes, and is useful for accessing the field via an interface.
+func (v *getVolumeInstancesProject) GetVolumes() getVolumeInstancesProjectVolumesProjectVolumesConnection {
+	return v.Volumes
+}
+
+// getVolumeInstancesProjectVolumesProjectVolumesConnection includes the requested fields of the GraphQL type ProjectVolumesConnection.
+type getVolumeInstancesProjectVolumesProjectVolumesConnection struct {
+	Edges []getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdge `json:"edges"`
+}
+
+// GetEdges returns getVolumeInstancesProjectVolumesProjectVolumesConnection.Edges, and is useful for accessing the field via an interface.
+func (v *getVolumeInstancesProjectVolumesProjectVolumesConnection) GetEdges() []getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdge {
+	return v.Edges
+}
+
+// getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdge includes the requested fields of the GraphQL type ProjectVolumesConnectionEdge.
+type getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdge struct {
+	Node getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume `json:"node"`
+}
+
+// GetNode returns getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdge.Node, and is useful for accessing the field via an interface.
+func (v *getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdge) GetNode() getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume {
+	return v.Node
 }
 
-// upsertVariableResponse is returned by upsertVariable on success.
-type upsertVariableResponse struct {
-	// Upserts a variable.
-	VariableUpsert bool `json:"variableUpsert"`
+// getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume includes the requested fields of the GraphQL type Volume.
+type getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume struct {
+	Volume `json:"-"`
+}
+
+// GetId returns getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume.Id, and is useful for accessing the field via an interface.
+func (v *getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume) GetId() string {
+	return v.Volume.Id
+}
+
+// GetName returns getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume.Name, and is useful for accessing the field via an interface.
+func (v *getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume) GetName() string {
+	return v.Volume.Name
+}
+
+// GetVolumeInstances returns getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume.VolumeInstances, and is useful for accessing the field via an interface.
+func (v *getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume) GetVolumeInstances() VolumeVolumeInstancesVolumeVolumeInstancesConnection {
+	return v.Volume.VolumeInstances
+}
+
+func (v *getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Volume)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	VolumeInstances VolumeVolumeInstancesVolumeVolumeInstancesConnection `json:"volumeInstances"`
+}
+
+func (v *getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume) __premarshalJSON() (*__premarshalgetVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume, error) {
+	var retval __premarshalgetVolumeInstancesProjectVolumesProjectVolumesConnectionEdgesProjectVolumesConnectionEdgeNodeVolume
+
+	retval.Id = v.Volume.Id
+	retval.Name = v.Volume.Name
+	retval.VolumeInstances = v.Volume.VolumeInstances
+	return &retval, nil
+}
+
+// getVolumeInstancesResponse is returned by getVolumeInstances on success.
+type getVolumeInstancesResponse struct {
+	// Get a project by ID
+	Project getVolumeInstancesProject `json:"project"`
+}
+
+// GetProject returns getVolumeInstancesResponse.Project, and is useful for accessing the field via an interface.
+func (v *getVolumeInstancesResponse) GetProject() getVolumeInstancesProject { return v.Project }
+
+// getWorkflowStatusResponse is returned by getWorkflowStatus on success.
+type getWorkflowStatusResponse struct {
+	// Gets the status of a workflow
+	WorkflowStatus getWorkflowStatusWorkflowStatusWorkflowResult `json:"workflowStatus"`
+}
+
+// GetWorkflowStatus returns getWorkflowStatusResponse.WorkflowStatus, and is useful for accessing the field via an interface.
+func (v *getWorkflowStatusResponse) GetWorkflowStatus() getWorkflowStatusWorkflowStatusWorkflowResult {
+	return v.WorkflowStatus
+}
+
+// getWorkflowStatusWorkflowStatusWorkflowResult includes the requested fields of the GraphQL type WorkflowResult.
+type getWorkflowStatusWorkflowStatusWorkflowResult struct {
+	Status WorkflowStatus `json:"status"`
+	Error  *string        `json:"error"`
+}
+
+// GetStatus returns getWorkflowStatusWorkflowStatusWorkflowResult.Status, and is useful for accessing the field via an interface.
+func (v *getWorkflowStatusWorkflowStatusWorkflowResult) GetStatus() WorkflowStatus { return v.Status }
+
+// GetError returns getWorkflowStatusWorkflowStatusWorkflowResult.Error, and is useful for accessing the field via an interface.
+func (v *getWorkflowStatusWorkflowStatusWorkflowResult) GetError() *string { return v.Error }
+
+// getWorkspaceCurrentUsageResponse is returned by getWorkspaceCurrentUsage on success.
+type getWorkspaceCurrentUsageResponse struct {
+	// Get the workspace
+	Workspace getWorkspaceCurrentUsageWorkspace `json:"workspace"`
+}
+
+// GetWorkspace returns getWorkspaceCurrentUsageResponse.Workspace, and is useful for accessing the field via an interface.
+func (v *getWorkspaceCurrentUsageResponse) GetWorkspace() getWorkspaceCurrentUsageWorkspace {
+	return v.Workspace
+}
+
+// getWorkspaceCurrentUsageWorkspace includes the requested fields of the GraphQL type Workspace.
+type getWorkspaceCurrentUsageWorkspace struct {
+	Customer getWorkspaceCurrentUsageWorkspaceCustomer `json:"customer"`
+}
+
+// GetCustomer returns getWorkspaceCurrentUsageWorkspace.Customer, and is useful for accessing the field via an interface.
+func (v *getWorkspaceCurrentUsageWorkspace) GetCustomer() getWorkspaceCurrentUsageWorkspaceCustomer {
+	return v.Customer
+}
+
+// getWorkspaceCurrentUsageWorkspaceCustomer includes the requested fields of the GraphQL type Customer.
+type getWorkspaceCurrentUsageWorkspaceCustomer struct {
+	// The current usage for the customer. This value is cached and may not be up to date.
+	CurrentUsage  float64                                                `json:"currentUsage"`
+	BillingPeriod getWorkspaceCurrentUsageWorkspaceCustomerBillingPeriod `json:"billingPeriod"`
+}
+
+// GetCurrentUsage returns getWorkspaceCurrentUsageWorkspaceCustomer.CurrentUsage, and is useful for accessing the field via an interface.
+func (v *getWorkspaceCurrentUsageWorkspaceCustomer) GetCurrentUsage() float64 { return v.CurrentUsage }
+
+// GetBillingPeriod returns getWorkspaceCurrentUsageWorkspaceCustomer.BillingPeriod, and is useful for accessing the field via an interface.
+func (v *getWorkspaceCurrentUsageWorkspaceCustomer) GetBillingPeriod() getWorkspaceCurrentUsageWorkspaceCustomerBillingPeriod {
+	return v.BillingPeriod
+}
+
+// getWorkspaceCurrentUsageWorkspaceCustomerBillingPeriod includes the requested fields of the GraphQL type BillingPeriod.
+// The GraphQL type's documentation follows.
+//
+// The billing period for a customers subscription.
+type getWorkspaceCurrentUsageWorkspaceCustomerBillingPeriod struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+// GetStart returns getWorkspaceCurrentUsageWorkspaceCustomerBillingPeriod.Start, and is useful for accessing the field via an interface.
+func (v *getWorkspaceCurrentUsageWorkspaceCustomerBillingPeriod) GetStart() time.Time { return v.Start }
+
+// GetEnd returns getWorkspaceCurrentUsageWorkspaceCustomerBillingPeriod.End, and is useful for accessing the field via an interface.
+func (v *getWorkspaceCurrentUsageWorkspaceCustomerBillingPeriod) GetEnd() time.Time { return v.End }
+
+// getWorkspaceUsageLimitResponse is returned by getWorkspaceUsageLimit on success.
+type getWorkspaceUsageLimitResponse struct {
+	// Get the workspace
+	Workspace getWorkspaceUsageLimitWorkspace `json:"workspace"`
+}
+
+// GetWorkspace returns getWorkspaceUsageLimitResponse.Workspace, and is useful for accessing the field via an interface.
+func (v *getWorkspaceUsageLimitResponse) GetWorkspace() getWorkspaceUsageLimitWorkspace {
+	return v.Workspace
+}
+
+// getWorkspaceUsageLimitWorkspace includes the requested fields of the GraphQL type Workspace.
+type getWorkspaceUsageLimitWorkspace struct {
+	Customer getWorkspaceUsageLimitWorkspaceCustomer `json:"customer"`
+}
+
+// GetCustomer returns getWorkspaceUsageLimitWorkspace.Customer, and is useful for accessing the field via an interface.
+func (v *getWorkspaceUsageLimitWorkspace) GetCustomer() getWorkspaceUsageLimitWorkspaceCustomer {
+	return v.Customer
+}
+
+// getWorkspaceUsageLimitWorkspaceCustomer includes the requested fields of the GraphQL type Customer.
+type getWorkspaceUsageLimitWorkspaceCustomer struct {
+	Id         string                                             `json:"id"`
+	UsageLimit *getWorkspaceUsageLimitWorkspaceCustomerUsageLimit `json:"usageLimit"`
+}
+
+// GetId returns getWorkspaceUsageLimitWorkspaceCustomer.Id, and is useful for accessing the field via an interface.
+func (v *getWorkspaceUsageLimitWorkspaceCustomer) GetId() string { return v.Id }
+
+// GetUsageLimit returns getWorkspaceUsageLimitWorkspaceCustomer.UsageLimit, and is useful for accessing the field via an interface.
+func (v *getWorkspaceUsageLimitWorkspaceCustomer) GetUsageLimit() *getWorkspaceUsageLimitWorkspaceCustomerUsageLimit {
+	return v.UsageLimit
+}
+
+// getWorkspaceUsageLimitWorkspaceCustomerUsageLimit includes the requested fields of the GraphQL type UsageLimit.
+type getWorkspaceUsageLimitWorkspaceCustomerUsageLimit struct {
+	SoftLimit int  `json:"softLimit"`
+	HardLimit *int `json:"hardLimit"`
+}
+
+// GetSoftLimit returns getWorkspaceUsageLimitWorkspaceCustomerUsageLimit.SoftLimit, and is useful for accessing the field via an interface.
+func (v *getWorkspaceUsageLimitWorkspaceCustomerUsageLimit) GetSoftLimit() int { return v.SoftLimit }
+
+// GetHardLimit returns getWorkspaceUsageLimitWorkspaceCustomerUsageLimit.HardLimit, and is useful for accessing the field via an interface.
+func (v *getWorkspaceUsageLimitWorkspaceCustomerUsageLimit) GetHardLimit() *int { return v.HardLimit }
+
+// importDockerComposeDockerComposeImport includes the requested fields of the GraphQL type DockerComposeImport.
+type importDockerComposeDockerComposeImport struct {
+	Errors []string               `json:"errors"`
+	Patch  map[string]interface{} `json:"patch"`
+}
+
+// GetErrors returns importDockerComposeDockerComposeImport.Errors, and is useful for accessing the field via an interface.
+func (v *importDockerComposeDockerComposeImport) GetErrors() []string { return v.Errors }
+
+// GetPatch returns importDockerComposeDockerComposeImport.Patch, and is useful for accessing the field via an interface.
+func (v *importDockerComposeDockerComposeImport) GetPatch() map[string]interface{} { return v.Patch }
+
+// importDockerComposeResponse is returned by importDockerCompose on success.
+type importDockerComposeResponse struct {
+	// Create services and volumes from docker compose
+	DockerComposeImport importDockerComposeDockerComposeImport `json:"dockerComposeImport"`
+}
+
+// GetDockerComposeImport returns importDockerComposeResponse.DockerComposeImport, and is useful for accessing the field via an interface.
+func (v *importDockerComposeResponse) GetDockerComposeImport() importDockerComposeDockerComposeImport {
+	return v.DockerComposeImport
+}
+
+// importHerokuVariablesResponse is returned by importHerokuVariables on success.
+type importHerokuVariablesResponse struct {
+	// Import variables from a Heroku app into a Railway service. Returns the number of variables imports
+	HerokuImportVariables int `json:"herokuImportVariables"`
+}
+
+// GetHerokuImportVariables returns importHerokuVariablesResponse.HerokuImportVariables, and is useful for accessing the field via an interface.
+func (v *importHerokuVariablesResponse) GetHerokuImportVariables() int {
+	return v.HerokuImportVariables
+}
+
+// listApiTokensApiTokensQueryApiTokensConnection includes the requested fields of the GraphQL type QueryApiTokensConnection.
+type listApiTokensApiTokensQueryApiTokensConnection struct {
+	Edges []listApiTokensApiTokensQueryApiTokensConnectionEdgesQueryApiTokensConnectionEdge `json:"edges"`
+}
+
+// GetEdges returns listApiTokensApiTokensQueryApiTokensConnection.Edges, and is useful for accessing the field via an interface.
+func (v *listApiTokensApiTokensQueryApiTokensConnection) GetEdges() []listApiTokensApiTokensQueryApiTokensConnectionEdgesQueryApiTokensConnectionEdge {
+	return v.Edges
+}
+
+// listApiTokensApiTokensQueryApiTokensConnectionEdgesQueryApiTokensConnectionEdge includes the requested fields of the GraphQL type QueryApiTokensConnectionEdge.
+type listApiTokensApiTokensQueryApiTokensConnectionEdgesQueryApiTokensConnectionEdge struct {
+	Node listApiTokensApiTokensQueryApiTokensConnectionEdgesQueryApiTokensConnectionEdgeNodeApiToken `json:"node"`
+}
+
+// GetNode returns listApiTokensApiTokensQueryApiTokensConnectionEdgesQueryApiTokensConnectionEdge.Node, and is useful for accessing the field via an interface.
+func (v *listApiTokensApiTokensQueryApiTokensConnectionEdgesQueryApiTokensConnectionEdge) GetNode() listApiTokensApiTokensQueryApiTokensConnectionEdgesQueryApiTokensConnectionEdgeNodeApiToken {
+	return v.Node
+}
+
+// listApiTokensApiTokensQueryApiTokensConnectionEdgesQueryApiTokensConnectionEdgeNodeApiToken includes the requested fields of the GraphQL type ApiToken.
+type listApiTokensApiTokensQueryApiTokensConnectionEdgesQueryApiTokensConnectionEdgeNodeApiToken struct {
+	ApiToken `json:"-"`
+}
+
+// GetId returns listApiTokensApiTokensQueryApiTokensConnectionEdgesQueryApiTokensConnectionEdgeNodeApiToken.Id, and is useful for accessing the field via an interface.
+func (v *listApiTokensApiTokensQueryApiTokensConnectionEdgesQueryApiTokensConnectionEdgeNodeApiToken) GetId() string {
+	return v.ApiToken.Id
+}
+
+// GetName returns listApiTokensApiTokensQueryApiTokensConnectionEdgesQueryApiTokensConnectionEdgeNodeApiToken.Name, and is useful for accessing the field via an interface.
+func (v *listApiTokensApiTokensQueryApiTokensConnectionEdgesQueryApiTokensConnectionEdgeNodeApiToken) GetName() string {
+	return v.ApiToken.Name
+}
+
+// GetDisplayToken returns listApiTokensApiTokensQueryApiTokensConnectionEdgesQueryApiTokensConnectionEdgeNodeApiToken.DisplayToken, and is useful for accessing the field via an interface.
+func (v *listApiTokensApiTokensQueryApiTokensConnectionEdgesQueryApiTokensConnectionEdgeNodeApiToken) GetDisplayToken() string {
+	return v.ApiToken.DisplayToken
+}
+
+// GetWorkspaceId returns listApiTokensApiTokensQueryApiTokensConnectionEdgesQueryApiTokensConnectionEdgeNodeApiToken.WorkspaceId, and is useful for accessing the field via an interface.
+func (v *listApiTokensApiTokensQueryApiTokensConnectionEdgesQueryApiTokensConnectionEdgeNodeApiToken) GetWorkspaceId() string {
+	return v.ApiToken.WorkspaceId
+}
+
+func (v *listApiTokensApiTokensQueryApiTokensConnectionEdgesQueryApiTokensConnectionEdgeNodeApiToken) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*listApiTokensApiTokensQueryApiTokensConnectionEdgesQueryApiTokensConnectionEdgeNodeApiToken
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.listApiTokensApiTokensQueryApiTokensConnectionEdgesQueryApiTokensConnectionEdgeNodeApiToken = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ApiToken)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshallistApiTokensApiTokensQueryApiTokensConnectionEdgesQueryApiTokensConnectionEdgeNodeApiToken struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	DisplayToken string `json:"displayToken"`
+
+	WorkspaceId string `json:"workspaceId"`
+}
+
+func (v *listApiTokensApiTokensQueryApiTokensConnectionEdgesQueryApiTokensConnectionEdgeNodeApiToken) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *listApiTokensApiTokensQueryApiTokensConnectionEdgesQueryApiTokensConnectionEdgeNodeApiToken) __premarshalJSON() (*__premarshallistApiTokensApiTokensQueryApiTokensConnectionEdgesQueryApiTokensConnectionEdgeNodeApiToken, error) {
+	var retval __premarshallistApiTokensApiTokensQueryApiTokensConnectionEdgesQueryApiTokensConnectionEdgeNodeApiToken
+
+	retval.Id = v.ApiToken.Id
+	retval.Name = v.ApiToken.Name
+	retval.DisplayToken = v.ApiToken.DisplayToken
+	retval.WorkspaceId = v.ApiToken.WorkspaceId
+	return &retval, nil
+}
+
+// listApiTokensResponse is returned by listApiTokens on success.
+type listApiTokensResponse struct {
+	// Gets all API tokens for the authenticated user.
+	ApiTokens listApiTokensApiTokensQueryApiTokensConnection `json:"apiTokens"`
+}
+
+// GetApiTokens returns listApiTokensResponse.ApiTokens, and is useful for accessing the field via an interface.
+func (v *listApiTokensResponse) GetApiTokens() listApiTokensApiTokensQueryApiTokensConnection {
+	return v.ApiTokens
+}
+
+// listBucketsProject includes the requested fields of the GraphQL type Project.
+type listBucketsProject struct {
+	Buckets listBucketsProjectBucketsProjectBucketsConnection `json:"buckets"`
+}
+
+// GetBuckets returns listBucketsProject.Buckets, and is useful for accessing the field via an interface.
+func (v *listBucketsProject) GetBuckets() listBucketsProjectBucketsProjectBucketsConnection {
+	return v.Buckets
+}
+
+// listBucketsProjectBucketsProjectBucketsConnection includes the requested fields of the GraphQL type ProjectBucketsConnection.
+type listBucketsProjectBucketsProjectBucketsConnection struct {
+	Edges []listBucketsProjectBucketsProjectBucketsConnectionEdgesProjectBucketsConnectionEdge `json:"edges"`
+}
+
+// GetEdges returns listBucketsProjectBucketsProjectBucketsConnection.Edges, and is useful for accessing the field via an interface.
+func (v *listBucketsProjectBucketsProjectBucketsConnection) GetEdges() []listBucketsProjectBucketsProjectBucketsConnectionEdgesProjectBucketsConnectionEdge {
+	return v.Edges
+}
+
+// listBucketsProjectBucketsProjectBucketsConnectionEdgesProjectBucketsConnectionEdge includes the requested fields of the GraphQL type ProjectBucketsConnectionEdge.
+type listBucketsProjectBucketsProjectBucketsConnectionEdgesProjectBucketsConnectionEdge struct {
+	Node listBucketsProjectBucketsProjectBucketsConnectionEdgesProjectBucketsConnectionEdgeNodeBucket `json:"node"`
+}
+
+// GetNode returns listBucketsProjectBucketsProjectBucketsConnectionEdgesProjectBucketsConnectionEdge.Node, and is useful for accessing the field via an interface.
+func (v *listBucketsProjectBucketsProjectBucketsConnectionEdgesProjectBucketsConnectionEdge) GetNode() listBucketsProjectBucketsProjectBucketsConnectionEdgesProjectBucketsConnectionEdgeNodeBucket {
+	return v.Node
+}
+
+// listBucketsProjectBucketsProjectBucketsConnectionEdgesProjectBucketsConnectionEdgeNodeBucket includes the requested fields of the GraphQL type Bucket.
+type listBucketsProjectBucketsProjectBucketsConnectionEdgesProjectBucketsConnectionEdgeNodeBucket struct {
+	Bucket `json:"-"`
+}
+
+// GetId returns listBucketsProjectBucketsProjectBucketsConnectionEdgesProjectBucketsConnectionEdgeNodeBucket.Id, and is useful for accessing the field via an interface.
+func (v *listBucketsProjectBucketsProjectBucketsConnectionEdgesProjectBucketsConnectionEdgeNodeBucket) GetId() string {
+	return v.Bucket.Id
+}
+
+// GetName returns listBucketsProjectBucketsProjectBucketsConnectionEdgesProjectBucketsConnectionEdgeNodeBucket.Name, and is useful for accessing the field via an interface.
+func (v *listBucketsProjectBucketsProjectBucketsConnectionEdgesProjectBucketsConnectionEdgeNodeBucket) GetName() string {
+	return v.Bucket.Name
+}
+
+// GetProjectId returns listBucketsProjectBucketsProjectBucketsConnectionEdgesProjectBucketsConnectionEdgeNodeBucket.ProjectId, and is useful for accessing the field via an interface.
+func (v *listBucketsProjectBucketsProjectBucketsConnectionEdgesProjectBucketsConnectionEdgeNodeBucket) GetProjectId() string {
+	return v.Bucket.ProjectId
+}
+
+func (v *listBucketsProjectBucketsProjectBucketsConnectionEdgesProjectBucketsConnectionEdgeNodeBucket) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*listBucketsProjectBucketsProjectBucketsConnectionEdgesProjectBucketsConnectionEdgeNodeBucket
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.listBucketsProjectBucketsProjectBucketsConnectionEdgesProjectBucketsConnectionEdgeNodeBucket = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Bucket)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshallistBucketsProjectBucketsProjectBucketsConnectionEdgesProjectBucketsConnectionEdgeNodeBucket struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	ProjectId string `json:"projectId"`
+}
+
+func (v *listBucketsProjectBucketsProjectBucketsConnectionEdgesProjectBucketsConnectionEdgeNodeBucket) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *listBucketsProjectBucketsProjectBucketsConnectionEdgesProjectBucketsConnectionEdgeNodeBucket) __premarshalJSON() (*__premarshallistBucketsProjectBucketsProjectBucketsConnectionEdgesProjectBucketsConnectionEdgeNodeBucket, error) {
+	var retval __premarshallistBucketsProjectBucketsProjectBucketsConnectionEdgesProjectBucketsConnectionEdgeNodeBucket
+
+	retval.Id = v.Bucket.Id
+	retval.Name = v.Bucket.Name
+	retval.ProjectId = v.Bucket.ProjectId
+	return &retval, nil
+}
+
+// listBucketsResponse is returned by listBuckets on success.
+type listBucketsResponse struct {
+	// Get a project by ID
+	Project listBucketsProject `json:"project"`
+}
+
+// GetProject returns listBucketsResponse.Project, and is useful for accessing the field via an interface.
+func (v *listBucketsResponse) GetProject() listBucketsProject { return v.Project }
+
+// listCronExecutionsDeploymentInstanceExecutionsQueryDeploymentInstanceExecutionsConnection includes the requested fields of the GraphQL type QueryDeploymentInstanceExecutionsConnection.
+type listCronExecutionsDeploymentInstanceExecutionsQueryDeploymentInstanceExecutionsConnection struct {
+	Edges []listCronExecutionsDeploymentInstanceExecutionsQueryDeploymentInstanceExecutionsConnectionEdgesQueryDeploymentInstanceExecutionsConnectionEdge `json:"edges"`
+}
+
+// GetEdges returns listCronExecutionsDeploymentInstanceExecutionsQueryDeploymentInstanceExecutionsConnection.Edges, and is useful for accessing the field via an interface.
+func (v *listCronExecutionsDeploymentInstanceExecutionsQueryDeploymentInstanceExecutionsConnection) GetEdges() []listCronExecutionsDeploymentInstanceExecutionsQueryDeploymentInstanceExecutionsConnectionEdgesQueryDeploymentInstanceExecutionsConnectionEdge {
+	return v.Edges
+}
+
+// listCronExecutionsDeploymentInstanceExecutionsQueryDeploymentInstanceExecutionsConnectionEdgesQueryDeploymentInstanceExecutionsConnectionEdge includes the requested fields of the GraphQL type QueryDeploymentInstanceExecutionsConnectionEdge.
+type listCronExecutionsDeploymentInstanceExecutionsQueryDeploymentInstanceExecutionsConnectionEdgesQueryDeploymentInstanceExecutionsConnectionEdge struct {
+	Node listCronExecutionsDeploymentInstanceExecutionsQueryDeploymentInstanceExecutionsConnectionEdgesQueryDeploymentInstanceExecutionsConnectionEdgeNodeDeploymentInstanceExecution `json:"node"`
+}
+
+// GetNode returns listCronExecutionsDeploymentInstanceExecutionsQueryDeploymentInstanceExecutionsConnectionEdgesQueryDeploymentInstanceExecutionsConnectionEdge.Node, and is useful for accessing the field via an interface.
+func (v *listCronExecutionsDeploymentInstanceExecutionsQueryDeploymentInstanceExecutionsConnectionEdgesQueryDeploymentInstanceExecutionsConnectionEdge) GetNode() listCronExecutionsDeploymentInstanceExecutionsQueryDeploymentInstanceExecutionsConnectionEdgesQueryDeploymentInstanceExecutionsConnectionEdgeNodeDeploymentInstanceExecution {
+	return v.Node
+}
+
+// listCronExecutionsDeploymentInstanceExecutionsQueryDeploymentInstanceExecutionsConnectionEdgesQueryDeploymentInstanceExecutionsConnectionEdgeNodeDeploymentInstanceExecution includes the requested fields of the GraphQL type DeploymentInstanceExecution.
+type listCronExecutionsDeploymentInstanceExecutionsQueryDeploymentInstanceExecutionsConnectionEdgesQueryDeploymentInstanceExecutionsConnectionEdgeNodeDeploymentInstanceExecution struct {
+	Id           string                   `json:"id"`
+	DeploymentId string                   `json:"deploymentId"`
+	Status       DeploymentInstanceStatus `json:"status"`
+	CreatedAt    time.Time                `json:"createdAt"`
+	CompletedAt  time.Time                `json:"completedAt"`
+}
+
+// GetId returns listCronExecutionsDeploymentInstanceExecutionsQueryDeploymentInstanceExecutionsConnectionEdgesQueryDeploymentInstanceExecutionsConnectionEdgeNodeDeploymentInstanceExecution.Id, and is useful for accessing the field via an interface.
+func (v *listCronExecutionsDeploymentInstanceExecutionsQueryDeploymentInstanceExecutionsConnectionEdgesQueryDeploymentInstanceExecutionsConnectionEdgeNodeDeploymentInstanceExecution) GetId() string {
+	return v.Id
+}
+
+// GetDeploymentId returns listCronExecutionsDeploymentInstanceExecutionsQueryDeploymentInstanceExecutionsConnectionEdgesQueryDeploymentInstanceExecutionsConnectionEdgeNodeDeploymentInstanceExecution.DeploymentId, and is useful for accessing the field via an interface.
+func (v *listCronExecutionsDeploymentInstanceExecutionsQueryDeploymentInstanceExecutionsConnectionEdgesQueryDeploymentInstanceExecutionsConnectionEdgeNodeDeploymentInstanceExecution) GetDeploymentId() string {
+	return v.DeploymentId
+}
+
+// GetStatus returns listCronExecutionsDeploymentInstanceExecutionsQueryDeploymentInstanceExecutionsConnectionEdgesQueryDeploymentInstanceExecutionsConnectionEdgeNodeDeploymentInstanceExecution.Status, and is useful for accessing the field via an interface.
+func (v *listCronExecutionsDeploymentInstanceExecutionsQueryDeploymentInstanceExecutionsConnectionEdgesQueryDeploymentInstanceExecutionsConnectionEdgeNodeDeploymentInstanceExecution) GetStatus() DeploymentInstanceStatus {
+	return v.Status
+}
+
+// GetCreatedAt returns listCronExecutionsDeploymentInstanceExecutionsQueryDeploymentInstanceExecutionsConnectionEdgesQueryDeploymentInstanceExecutionsConnectionEdgeNodeDeploymentInstanceExecution.CreatedAt, and is useful for accessing the field via an interface.
+func (v *listCronExecutionsDeploymentInstanceExecutionsQueryDeploymentInstanceExecutionsConnectionEdgesQueryDeploymentInstanceExecutionsConnectionEdgeNodeDeploymentInstanceExecution) GetCreatedAt() time.Time {
+	return v.CreatedAt
+}
+
+// GetCompletedAt returns listCronExecutionsDeploymentInstanceExecutionsQueryDeploymentInstanceExecutionsConnectionEdgesQueryDeploymentInstanceExecutionsConnectionEdgeNodeDeploymentInstanceExecution.CompletedAt, and is useful for accessing the field via an interface.
+func (v *listCronExecutionsDeploymentInstanceExecutionsQueryDeploymentInstanceExecutionsConnectionEdgesQueryDeploymentInstanceExecutionsConnectionEdgeNodeDeploymentInstanceExecution) GetCompletedAt() time.Time {
+	return v.CompletedAt
+}
+
+// listCronExecutionsResponse is returned by listCronExecutions on success.
+type listCronExecutionsResponse struct {
+	// Get the deployment instance executions for a deployment.
+	DeploymentInstanceExecutions listCronExecutionsDeploymentInstanceExecutionsQueryDeploymentInstanceExecutionsConnection `json:"deploymentInstanceExecutions"`
+}
+
+// GetDeploymentInstanceExecutions returns listCronExecutionsResponse.DeploymentInstanceExecutions, and is useful for accessing the field via an interface.
+func (v *listCronExecutionsResponse) GetDeploymentInstanceExecutions() listCronExecutionsDeploymentInstanceExecutionsQueryDeploymentInstanceExecutionsConnection {
+	return v.DeploymentInstanceExecutions
+}
+
+// listCustomDomainsDomainsAllDomains includes the requested fields of the GraphQL type AllDomains.
+type listCustomDomainsDomainsAllDomains struct {
+	CustomDomains []listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain `json:"customDomains"`
+}
+
+// GetCustomDomains returns listCustomDomainsDomainsAllDomains.CustomDomains, and is useful for accessing the field via an interface.
+func (v *listCustomDomainsDomainsAllDomains) GetCustomDomains() []listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain {
+	return v.CustomDomains
+}
+
+// listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain includes the requested fields of the GraphQL type CustomDomain.
+type listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain struct {
+	CustomDomain `json:"-"`
+}
+
+// GetId returns listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain.Id, and is useful for accessing the field via an interface.
+func (v *listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain) GetId() string {
+	return v.CustomDomain.Id
+}
+
+// GetDomain returns listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain.Domain, and is useful for accessing the field via an interface.
+func (v *listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain) GetDomain() string {
+	return v.CustomDomain.Domain
+}
+
+// GetTargetPort returns listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain.TargetPort, and is useful for accessing the field via an interface.
+func (v *listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain) GetTargetPort() int {
+	return v.CustomDomain.TargetPort
+}
+
+// GetStatus returns listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain.Status, and is useful for accessing the field via an interface.
+func (v *listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain) GetStatus() CustomDomainStatus {
+	return v.CustomDomain.Status
+}
+
+// GetEnvironmentId returns listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain) GetEnvironmentId() string {
+	return v.CustomDomain.EnvironmentId
+}
+
+// GetServiceId returns listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain.ServiceId, and is useful for accessing the field via an interface.
+func (v *listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain) GetServiceId() string {
+	return v.CustomDomain.ServiceId
+}
+
+func (v *listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.CustomDomain)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshallistCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain struct {
+	Id string `json:"id"`
+
+	Domain string `json:"domain"`
+
+	TargetPort int `json:"targetPort"`
+
+	Status CustomDomainStatus `json:"status"`
+
+	EnvironmentId string `json:"environmentId"`
+
+	ServiceId string `json:"serviceId"`
+}
+
+func (v *listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *listCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain) __premarshalJSON() (*__premarshallistCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain, error) {
+	var retval __premarshallistCustomDomainsDomainsAllDomainsCustomDomainsCustomDomain
+
+	retval.Id = v.CustomDomain.Id
+	retval.Domain = v.CustomDomain.Domain
+	retval.TargetPort = v.CustomDomain.TargetPort
+	retval.Status = v.CustomDomain.Status
+	retval.EnvironmentId = v.CustomDomain.EnvironmentId
+	retval.ServiceId = v.CustomDomain.ServiceId
+	return &retval, nil
+}
+
+// listCustomDomainsResponse is returned by listCustomDomains on success.
+type listCustomDomainsResponse struct {
+	// All domains for a service instance
+	Domains listCustomDomainsDomainsAllDomains `json:"domains"`
+}
+
+// GetDomains returns listCustomDomainsResponse.Domains, and is useful for accessing the field via an interface.
+func (v *listCustomDomainsResponse) GetDomains() listCustomDomainsDomainsAllDomains { return v.Domains }
+
+// listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnection includes the requested fields of the GraphQL type QueryDeploymentTriggersConnection.
+type listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnection struct {
+	Edges []listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnectionEdgesQueryDeploymentTriggersConnectionEdge `json:"edges"`
+}
+
+// GetEdges returns listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnection.Edges, and is useful for accessing the field via an interface.
+func (v *listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnection) GetEdges() []listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnectionEdgesQueryDeploymentTriggersConnectionEdge {
+	return v.Edges
+}
+
+// listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnectionEdgesQueryDeploymentTriggersConnectionEdge includes the requested fields of the GraphQL type QueryDeploymentTriggersConnectionEdge.
+type listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnectionEdgesQueryDeploymentTriggersConnectionEdge struct {
+	Node listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnectionEdgesQueryDeploymentTriggersConnectionEdgeNodeDeploymentTrigger `json:"node"`
+}
+
+// GetNode returns listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnectionEdgesQueryDeploymentTriggersConnectionEdge.Node, and is useful for accessing the field via an interface.
+func (v *listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnectionEdgesQueryDeploymentTriggersConnectionEdge) GetNode() listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnectionEdgesQueryDeploymentTriggersConnectionEdgeNodeDeploymentTrigger {
+	return v.Node
+}
+
+// listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnectionEdgesQueryDeploymentTriggersConnectionEdgeNodeDeploymentTrigger includes the requested fields of the GraphQL type DeploymentTrigger.
+type listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnectionEdgesQueryDeploymentTriggersConnectionEdgeNodeDeploymentTrigger struct {
+	Id     string `json:"id"`
+	Branch string `json:"branch"`
+}
+
+// GetId returns listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnectionEdgesQueryDeploymentTriggersConnectionEdgeNodeDeploymentTrigger.Id, and is useful for accessing the field via an interface.
+func (v *listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnectionEdgesQueryDeploymentTriggersConnectionEdgeNodeDeploymentTrigger) GetId() string {
+	return v.Id
+}
+
+// GetBranch returns listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnectionEdgesQueryDeploymentTriggersConnectionEdgeNodeDeploymentTrigger.Branch, and is useful for accessing the field via an interface.
+func (v *listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnectionEdgesQueryDeploymentTriggersConnectionEdgeNodeDeploymentTrigger) GetBranch() string {
+	return v.Branch
+}
+
+// listDeploymentTriggersResponse is returned by listDeploymentTriggers on success.
+type listDeploymentTriggersResponse struct {
+	// All deployment triggers.
+	DeploymentTriggers listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnection `json:"deploymentTriggers"`
+}
+
+// GetDeploymentTriggers returns listDeploymentTriggersResponse.DeploymentTriggers, and is useful for accessing the field via an interface.
+func (v *listDeploymentTriggersResponse) GetDeploymentTriggers() listDeploymentTriggersDeploymentTriggersQueryDeploymentTriggersConnection {
+	return v.DeploymentTriggers
+}
+
+// listDeploymentsDeploymentsQueryDeploymentsConnection includes the requested fields of the GraphQL type QueryDeploymentsConnection.
+type listDeploymentsDeploymentsQueryDeploymentsConnection struct {
+	Edges []listDeploymentsDeploymentsQueryDeploymentsConnectionEdgesQueryDeploymentsConnectionEdge `json:"edges"`
+}
+
+// GetEdges returns listDeploymentsDeploymentsQueryDeploymentsConnection.Edges, and is useful for accessing the field via an interface.
+func (v *listDeploymentsDeploymentsQueryDeploymentsConnection) GetEdges() []listDeploymentsDeploymentsQueryDeploymentsConnectionEdgesQueryDeploymentsConnectionEdge {
+	return v.Edges
+}
+
+// listDeploymentsDeploymentsQueryDeploymentsConnectionEdgesQueryDeploymentsConnectionEdge includes the requested fields of the GraphQL type QueryDeploymentsConnectionEdge.
+type listDeploymentsDeploymentsQueryDeploymentsConnectionEdgesQueryDeploymentsConnectionEdge struct {
+	Node listDeploymentsDeploymentsQueryDeploymentsConnectionEdgesQueryDeploymentsConnectionEdgeNodeDeployment `json:"node"`
+}
+
+// GetNode returns listDeploymentsDeploymentsQueryDeploymentsConnectionEdgesQueryDeploymentsConnectionEdge.Node, and is useful for accessing the field via an interface.
+func (v *listDeploymentsDeploymentsQueryDeploymentsConnectionEdgesQueryDeploymentsConnectionEdge) GetNode() listDeploymentsDeploymentsQueryDeploymentsConnectionEdgesQueryDeploymentsConnectionEdgeNodeDeployment {
+	return v.Node
+}
+
+// listDeploymentsDeploymentsQueryDeploymentsConnectionEdgesQueryDeploymentsConnectionEdgeNodeDeployment includes the requested fields of the GraphQL type Deployment.
+type listDeploymentsDeploymentsQueryDeploymentsConnectionEdgesQueryDeploymentsConnectionEdgeNodeDeployment struct {
+	Deployment `json:"-"`
+}
+
+// GetId returns listDeploymentsDeploymentsQueryDeploymentsConnectionEdgesQueryDeploymentsConnectionEdgeNodeDeployment.Id, and is useful for accessing the field via an interface.
+func (v *listDeploymentsDeploymentsQueryDeploymentsConnectionEdgesQueryDeploymentsConnectionEdgeNodeDeployment) GetId() string {
+	return v.Deployment.Id
+}
+
+// GetStatus returns listDeploymentsDeploymentsQueryDeploymentsConnectionEdgesQueryDeploymentsConnectionEdgeNodeDeployment.Status, and is useful for accessing the field via an interface.
+func (v *listDeploymentsDeploymentsQueryDeploymentsConnectionEdgesQueryDeploymentsConnectionEdgeNodeDeployment) GetStatus() DeploymentStatus {
+	return v.Deployment.Status
+}
+
+// GetUrl returns listDeploymentsDeploymentsQueryDeploymentsConnectionEdgesQueryDeploymentsConnectionEdgeNodeDeployment.Url, and is useful for accessing the field via an interface.
+func (v *listDeploymentsDeploymentsQueryDeploymentsConnectionEdgesQueryDeploymentsConnectionEdgeNodeDeployment) GetUrl() string {
+	return v.Deployment.Url
+}
+
+// GetStaticUrl returns listDeploymentsDeploymentsQueryDeploymentsConnectionEdgesQueryDeploymentsConnectionEdgeNodeDeployment.StaticUrl, and is useful for accessing the field via an interface.
+func (v *listDeploymentsDeploymentsQueryDeploymentsConnectionEdgesQueryDeploymentsConnectionEdgeNodeDeployment) GetStaticUrl() string {
+	return v.Deployment.StaticUrl
+}
+
+// GetCanRollback returns listDeploymentsDeploymentsQueryDeploymentsConnectionEdgesQueryDeploymentsConnectionEdgeNodeDeployment.CanRollback, and is useful for accessing the field via an interface.
+func (v *listDeploymentsDeploymentsQueryDeploymentsConnectionEdgesQueryDeploymentsConnectionEdgeNodeDeployment) GetCanRollback() bool {
+	return v.Deployment.CanRollback
+}
+
+// GetCreatedAt returns listDeploymentsDeploymentsQueryDeploymentsConnectionEdgesQueryDeploymentsConnectionEdgeNodeDeployment.CreatedAt, and is useful for accessing the field via an interface.
+func (v *listDeploymentsDeploymentsQueryDeploymentsConnectionEdgesQueryDeploymentsConnectionEdgeNodeDeployment) GetCreatedAt() time.Time {
+	return v.Deployment.CreatedAt
+}
+
+// GetSnapshotId returns listDeploymentsDeploymentsQueryDeploymentsConnectionEdgesQueryDeploymentsConnectionEdgeNodeDeployment.SnapshotId, and is useful for accessing the field via an interface.
+func (v *listDeploymentsDeploymentsQueryDeploymentsConnectionEdgesQueryDeploymentsConnectionEdgeNodeDeployment) GetSnapshotId() string {
+	return v.Deployment.SnapshotId
+}
+
+// GetMeta returns listDeploymentsDeploymentsQueryDeploymentsConnectionEdgesQueryDeploymentsConnectionEdgeNodeDeployment.Meta, and is useful for accessing the field via an interface.
+func (v *listDeploymentsDeploymentsQueryDeploymentsConnectionEdgesQueryDeploymentsConnectionEdgeNodeDeployment) GetMeta() map[string]interface{} {
+	return v.Deployment.Meta
+}
+
+// GetProjectId returns listDeploymentsDeploymentsQueryDeploymentsConnectionEdgesQueryDeploymentsConnectionEdgeNodeDeployment.ProjectId, and is useful for accessing the field via an interface.
+func (v *listDeploymentsDeploymentsQueryDeploymentsConnectionEdgesQueryDeploymentsConnectionEdgeNodeDeployment) GetProjectId() string {
+	return v.Deployment.ProjectId
+}
+
+// GetEnvironmentId returns listDeploymentsDeploymentsQueryDeploymentsConnectionEdgesQueryDeploymentsConnectionEdgeNodeDeployment.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *listDeploymentsDeploymentsQueryDeploymentsConnectionEdgesQueryDeploymentsConnectionEdgeNodeDeployment) GetEnvironmentId() string {
+	return v.Deployment.EnvironmentId
+}
+
+// GetServiceId returns listDeploymentsDeploymentsQueryDeploymentsConnectionEdgesQueryDeploymentsConnectionEdgeNodeDeployment.ServiceId, and is useful for accessing the field via an interface.
+func (v *listDeploymentsDeploymentsQueryDeploymentsConnectionEdgesQueryDeploymentsConnectionEdgeNodeDeployment) GetServiceId() string {
+	return v.Deployment.ServiceId
+}
+
+func (v *listDeploymentsDeploymentsQueryDeploymentsConnectionEdgesQueryDeploymentsConnectionEdgeNodeDeployment) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*listDeploymentsDeploymentsQueryDeploymentsConnectionEdgesQueryDeploymentsConnectionEdgeNodeDeployment
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.listDeploymentsDeploymentsQueryDeploymentsConnectionEdgesQueryDeploymentsConnectionEdgeNodeDeployment = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Deployment)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshallistDeploymentsDeploymentsQueryDeploymentsConnectionEdgesQueryDeploymentsConnectionEdgeNodeDeployment struct {
+	Id string `json:"id"`
+
+	Status DeploymentStatus `json:"status"`
+
+	Url string `json:"url"`
+
+	StaticUrl string `json:"staticUrl"`
+
+	CanRollback bool `json:"canRollback"`
+
+	CreatedAt time.Time `json:"createdAt"`
+
+	SnapshotId string `json:"snapshotId"`
+
+	Meta map[string]interface{} `json:"meta"`
+
+	ProjectId string `json:"projectId"`
+
+	EnvironmentId string `json:"environmentId"`
+
+	ServiceId string `json:"serviceId"`
+}
+
+func (v *listDeploymentsDeploymentsQueryDeploymentsConnectionEdgesQueryDeploymentsConnectionEdgeNodeDeployment) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *listDeploymentsDeploymentsQueryDeploymentsConnectionEdgesQueryDeploymentsConnectionEdgeNodeDeployment) __premarshalJSON() (*__premarshallistDeploymentsDeploymentsQueryDeploymentsConnectionEdgesQueryDeploymentsConnectionEdgeNodeDeployment, error) {
+	var retval __premarshallistDeploymentsDeploymentsQueryDeploymentsConnectionEdgesQueryDeploymentsConnectionEdgeNodeDeployment
+
+	retval.Id = v.Deployment.Id
+	retval.Status = v.Deployment.Status
+	retval.Url = v.Deployment.Url
+	retval.StaticUrl = v.Deployment.StaticUrl
+	retval.CanRollback = v.Deployment.CanRollback
+	retval.CreatedAt = v.Deployment.CreatedAt
+	retval.SnapshotId = v.Deployment.SnapshotId
+	retval.Meta = v.Deployment.Meta
+	retval.ProjectId = v.Deployment.ProjectId
+	retval.EnvironmentId = v.Deployment.EnvironmentId
+	retval.ServiceId = v.Deployment.ServiceId
+	return &retval, nil
+}
+
+// listDeploymentsResponse is returned by listDeployments on success.
+type listDeploymentsResponse struct {
+	// Get all deployments
+	Deployments listDeploymentsDeploymentsQueryDeploymentsConnection `json:"deployments"`
+}
+
+// GetDeployments returns listDeploymentsResponse.Deployments, and is useful for accessing the field via an interface.
+func (v *listDeploymentsResponse) GetDeployments() listDeploymentsDeploymentsQueryDeploymentsConnection {
+	return v.Deployments
+}
+
+// listEgressGatewaysEgressGatewaysEgressGateway includes the requested fields of the GraphQL type EgressGateway.
+type listEgressGatewaysEgressGatewaysEgressGateway struct {
+	EgressGateway `json:"-"`
+}
+
+// GetIpv4 returns listEgressGatewaysEgressGatewaysEgressGateway.Ipv4, and is useful for accessing the field via an interface.
+func (v *listEgressGatewaysEgressGatewaysEgressGateway) GetIpv4() string { return v.EgressGateway.Ipv4 }
+
+// GetRegion returns listEgressGatewaysEgressGatewaysEgressGateway.Region, and is useful for accessing the field via an interface.
+func (v *listEgressGatewaysEgressGatewaysEgressGateway) GetRegion() string {
+	return v.EgressGateway.Region
+}
+
+func (v *listEgressGatewaysEgressGatewaysEgressGateway) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*listEgressGatewaysEgressGatewaysEgressGateway
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.listEgressGatewaysEgressGatewaysEgressGateway = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.EgressGateway)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshallistEgressGatewaysEgressGatewaysEgressGateway struct {
+	Ipv4 string `json:"ipv4"`
+
+	Region string `json:"region"`
+}
+
+func (v *listEgressGatewaysEgressGatewaysEgressGateway) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *listEgressGatewaysEgressGatewaysEgressGateway) __premarshalJSON() (*__premarshallistEgressGatewaysEgressGatewaysEgressGateway, error) {
+	var retval __premarshallistEgressGatewaysEgressGatewaysEgressGateway
+
+	retval.Ipv4 = v.EgressGateway.Ipv4
+	retval.Region = v.EgressGateway.Region
+	return &retval, nil
+}
+
+// listEgressGatewaysResponse is returned by listEgressGateways on success.
+type listEgressGatewaysResponse struct {
+	// All egress gateways assigned to a service instance
+	EgressGateways []listEgressGatewaysEgressGatewaysEgressGateway `json:"egressGateways"`
+}
+
+// GetEgressGateways returns listEgressGatewaysResponse.EgressGateways, and is useful for accessing the field via an interface.
+func (v *listEgressGatewaysResponse) GetEgressGateways() []listEgressGatewaysEgressGatewaysEgressGateway {
+	return v.EgressGateways
+}
+
+// listIntegrationAuthsIntegrationAuthsQueryIntegrationAuthsConnection includes the requested fields of the GraphQL type QueryIntegrationAuthsConnection.
+type listIntegrationAuthsIntegrationAuthsQueryIntegrationAuthsConnection struct {
+	Edges []listIntegrationAuthsIntegrationAuthsQueryIntegrationAuthsConnectionEdgesQueryIntegrationAuthsConnectionEdge `json:"edges"`
+}
+
+// GetEdges returns listIntegrationAuthsIntegrationAuthsQueryIntegrationAuthsConnection.Edges, and is useful for accessing the field via an interface.
+func (v *listIntegrationAuthsIntegrationAuthsQueryIntegrationAuthsConnection) GetEdges() []listIntegrationAuthsIntegrationAuthsQueryIntegrationAuthsConnectionEdgesQueryIntegrationAuthsConnectionEdge {
+	return v.Edges
+}
+
+// listIntegrationAuthsIntegrationAuthsQueryIntegrationAuthsConnectionEdgesQueryIntegrationAuthsConnectionEdge includes the requested fields of the GraphQL type QueryIntegrationAuthsConnectionEdge.
+type listIntegrationAuthsIntegrationAuthsQueryIntegrationAuthsConnectionEdgesQueryIntegrationAuthsConnectionEdge struct {
+	Node listIntegrationAuthsIntegrationAuthsQueryIntegrationAuthsConnectionEdgesQueryIntegrationAuthsConnectionEdgeNodeIntegrationAuth `json:"node"`
+}
+
+// GetNode returns listIntegrationAuthsIntegrationAuthsQueryIntegrationAuthsConnectionEdgesQueryIntegrationAuthsConnectionEdge.Node, and is useful for accessing the field via an interface.
+func (v *listIntegrationAuthsIntegrationAuthsQueryIntegrationAuthsConnectionEdgesQueryIntegrationAuthsConnectionEdge) GetNode() listIntegrationAuthsIntegrationAuthsQueryIntegrationAuthsConnectionEdgesQueryIntegrationAuthsConnectionEdgeNodeIntegrationAuth {
+	return v.Node
+}
+
+// listIntegrationAuthsIntegrationAuthsQueryIntegrationAuthsConnectionEdgesQueryIntegrationAuthsConnectionEdgeNodeIntegrationAuth includes the requested fields of the GraphQL type IntegrationAuth.
+type listIntegrationAuthsIntegrationAuthsQueryIntegrationAuthsConnectionEdgesQueryIntegrationAuthsConnectionEdgeNodeIntegrationAuth struct {
+	Id           string                                                                                                                                                                          `json:"id"`
+	Integrations listIntegrationAuthsIntegrationAuthsQueryIntegrationAuthsConnectionEdgesQueryIntegrationAuthsConnectionEdgeNodeIntegrationAuthIntegrationsIntegrationAuthIntegrationsConnection `json:"integrations"`
+}
+
+// GetId returns listIntegrationAuthsIntegrationAuthsQueryIntegrationAuthsConnectionEdgesQueryIntegrationAuthsConnectionEdgeNodeIntegrationAuth.Id, and is useful for accessing the field via an interface.
+func (v *listIntegrationAuthsIntegrationAuthsQueryIntegrationAuthsConnectionEdgesQueryIntegrationAuthsConnectionEdgeNodeIntegrationAuth) GetId() string {
+	return v.Id
+}
+
+// GetIntegrations returns listIntegrationAuthsIntegrationAuthsQueryIntegrationAuthsConnectionEdgesQueryIntegrationAuthsConnectionEdgeNodeIntegrationAuth.Integrations, and is useful for accessing the field via an interface.
+func (v *listIntegrationAuthsIntegrationAuthsQueryIntegrationAuthsConnectionEdgesQueryIntegrationAuthsConnectionEdgeNodeIntegrationAuth) GetIntegrations() listIntegrationAuthsIntegrationAuthsQueryIntegrationAuthsConnectionEdgesQueryIntegrationAuthsConnectionEdgeNodeIntegrationAuthIntegrationsIntegrationAuthIntegrationsConnection {
+	return v.Integrations
+}
+
+// listIntegrationAuthsIntegrationAuthsQueryIntegrationAuthsConnectionEdgesQueryIntegrationAuthsConnectionEdgeNodeIntegrationAuthIntegrationsIntegrationAuthIntegrationsConnection includes the requested fields of the GraphQL type IntegrationAuthIntegrationsConnection.
+type listIntegrationAuthsIntegrationAuthsQueryIntegrationAuthsConnectionEdgesQueryIntegrationAuthsConnectionEdgeNodeIntegrationAuthIntegrationsIntegrationAuthIntegrationsConnection struct {
+	Edges []listIntegrationAuthsIntegrationAuthsQueryIntegrationAuthsConnectionEdgesQueryIntegrationAuthsConnectionEdgeNodeIntegrationAuthIntegrationsIntegrationAuthIntegrationsConnectionEdgesIntegrationAuthIntegrationsConnectionEdge `json:"edges"`
+}
+
+// GetEdges returns listIntegrationAuthsIntegrationAuthsQueryIntegrationAuthsConnectionEdgesQueryIntegrationAuthsConnectionEdgeNodeIntegrationAuthIntegrationsIntegrationAuthIntegrationsConnection.Edges, and is useful for accessing the field via an interface.
+func (v *listIntegrationAuthsIntegrationAuthsQueryIntegrationAuthsConnectionEdgesQueryIntegrationAuthsConnectionEdgeNodeIntegrationAuthIntegrationsIntegrationAuthIntegrationsConnection) GetEdges() []listIntegrationAuthsIntegrationAuthsQueryIntegrationAuthsConnectionEdgesQueryIntegrationAuthsConnectionEdgeNodeIntegrationAuthIntegrationsIntegrationAuthIntegrationsConnectionEdgesIntegrationAuthIntegrationsConnectionEdge {
+	return v.Edges
+}
+
+// listIntegrationAuthsIntegrationAuthsQueryIntegrationAuthsConnectionEdgesQueryIntegrationAuthsConnectionEdgeNodeIntegrationAuthIntegrationsIntegrationAuthIntegrationsConnectionEdgesIntegrationAuthIntegrationsConnectionEdge includes the requested fields of the GraphQL type IntegrationAuthIntegrationsConnectionEdge.
+type listIntegrationAuthsIntegrationAuthsQueryIntegrationAuthsConnectionEdgesQueryIntegrationAuthsConnectionEdgeNodeIntegrationAuthIntegrationsIntegrationAuthIntegrationsConnectionEdgesIntegrationAuthIntegrationsConnectionEdge struct {
+	Node listIntegrationAuthsIntegrationAuthsQueryIntegrationAuthsConnectionEdgesQueryIntegrationAuthsConnectionEdgeNodeIntegrationAuthIntegrationsIntegrationAuthIntegrationsConnectionEdgesIntegrationAuthIntegrationsConnectionEdgeNodeIntegration `json:"node"`
+}
+
+// GetNode returns listIntegrationAuthsIntegrationAuthsQueryIntegrationAuthsConnectionEdgesQueryIntegrationAuthsConnectionEdgeNodeIntegrationAuthIntegrationsIntegrationAuthIntegrationsConnectionEdgesIntegrationAuthIntegrationsConnectionEdge.Node, and is useful for accessing the field via an interface.
+func (v *listIntegrationAuthsIntegrationAuthsQueryIntegrationAuthsConnectionEdgesQueryIntegrationAuthsConnectionEdgeNodeIntegrationAuthIntegrationsIntegrationAuthIntegrationsConnectionEdgesIntegrationAuthIntegrationsConnectionEdge) GetNode() listIntegrationAuthsIntegrationAuthsQueryIntegrationAuthsConnectionEdgesQueryIntegrationAuthsConnectionEdgeNodeIntegrationAuthIntegrationsIntegrationAuthIntegrationsConnectionEdgesIntegrationAuthIntegrationsConnectionEdgeNodeIntegration {
+	return v.Node
+}
+
+// listIntegrationAuthsIntegrationAuthsQueryIntegrationAuthsConnectionEdgesQueryIntegrationAuthsConnectionEdgeNodeIntegrationAuthIntegrationsIntegrationAuthIntegrationsConnectionEdgesIntegrationAuthIntegrationsConnectionEdgeNodeIntegration includes the requested fields of the GraphQL type Integration.
+type listIntegrationAuthsIntegrationAuthsQueryIntegrationAuthsConnectionEdgesQueryIntegrationAuthsConnectionEdgeNodeIntegrationAuthIntegrationsIntegrationAuthIntegrationsConnectionEdgesIntegrationAuthIntegrationsConnectionEdgeNodeIntegration struct {
+	Integration `json:"-"`
+}
+
+// GetId returns listIntegrationAuthsIntegrationAuthsQueryIntegrationAuthsConnectionEdgesQueryIntegrationAuthsConnectionEdgeNodeIntegrationAuthIntegrationsIntegrationAuthIntegrationsConnectionEdgesIntegrationAuthIntegrationsConnectionEdgeNodeIntegration.Id, and is useful for accessing the field via an interface.
+func (v *listIntegrationAuthsIntegrationAuthsQueryIntegrationAuthsConnectionEdgesQueryIntegrationAuthsConnectionEdgeNodeIntegrationAuthIntegrationsIntegrationAuthIntegrationsConnectionEdgesIntegrationAuthIntegrationsConnectionEdgeNodeIntegration) GetId() string {
+	return v.Integration.Id
+}
+
+// GetName returns listIntegrationAuthsIntegrationAuthsQueryIntegrationAuthsConnectionEdgesQueryIntegrationAuthsConnectionEdgeNodeIntegrationAuthIntegrationsIntegrationAuthIntegrationsConnectionEdgesIntegrationAuthIntegrationsConnectionEdgeNodeIntegration.Name, and is useful for accessing the field via an interface.
+func (v *listIntegrationAuthsIntegrationAuthsQueryIntegrationAuthsConnectionEdgesQueryIntegrationAuthsConnectionEdgeNodeIntegrationAuthIntegrationsIntegrationAuthIntegrationsConnectionEdgesIntegrationAuthIntegrationsConnectionEdgeNodeIntegration) GetName() string {
+	return v.Integration.Name
+}
+
+// GetProjectId returns listIntegrationAuthsIntegrationAuthsQueryIntegrationAuthsConnectionEdgesQueryIntegrationAuthsConnectionEdgeNodeIntegrationAuthIntegrationsIntegrationAuthIntegrationsConnectionEdgesIntegrationAuthIntegrationsConnectionEdgeNodeIntegration.ProjectId, and is useful for accessing the field via an interface.
+func (v *listIntegrationAuthsIntegrationAuthsQueryIntegrationAuthsConnectionEdgesQueryIntegrationAuthsConnectionEdgeNodeIntegrationAuthIntegrationsIntegrationAuthIntegrationsConnectionEdgesIntegrationAuthIntegrationsConnectionEdgeNodeIntegration) GetProjectId() string {
+	return v.Integration.ProjectId
+}
+
+// GetConfig returns listIntegrationAuthsIntegrationAuthsQueryIntegrationAuthsConnectionEdgesQueryIntegrationAuthsConnectionEdgeNodeIntegrationAuthIntegrationsIntegrationAuthIntegrationsConnectionEdgesIntegrationAuthIntegrationsConnectionEdgeNodeIntegration.Config, and is useful for accessing the field via an interface.
+func (v *listIntegrationAuthsIntegrationAuthsQueryIntegrationAuthsConnectionEdgesQueryIntegrationAuthsConnectionEdgeNodeIntegrationAuthIntegrationsIntegrationAuthIntegrationsConnectionEdgesIntegrationAuthIntegrationsConnectionEdgeNodeIntegration) GetConfig() map[string]interface{} {
+	return v.Integration.Config
+}
+
+func (v *listIntegrationAuthsIntegrationAuthsQueryIntegrationAuthsConnectionEdgesQueryIntegrationAuthsConnectionEdgeNodeIntegrationAuthIntegrationsIntegrationAuthIntegrationsConnectionEdgesIntegrationAuthIntegrationsConnectionEdgeNodeIntegration) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*listIntegrationAuthsIntegrationAuthsQueryIntegrationAuthsConnectionEdgesQueryIntegrationAuthsConnectionEdgeNodeIntegrationAuthIntegrationsIntegrationAuthIntegrationsConnectionEdgesIntegrationAuthIntegrationsConnectionEdgeNodeIntegration
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.listIntegrationAuthsIntegrationAuthsQueryIntegrationAuthsConnectionEdgesQueryIntegrationAuthsConnectionEdgeNodeIntegrationAuthIntegrationsIntegrationAuthIntegrationsConnectionEdgesIntegrationAuthIntegrationsConnectionEdgeNodeIntegration = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Integration)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshallistIntegrationAuthsIntegrationAuthsQueryIntegrationAuthsConnectionEdgesQueryIntegrationAuthsConnectionEdgeNodeIntegrationAuthIntegrationsIntegrationAuthIntegrationsConnectionEdgesIntegrationAuthIntegrationsConnectionEdgeNodeIntegration struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	ProjectId string `json:"projectId"`
+
+	Config map[string]interface{} `json:"config"`
+}
+
+func (v *listIntegrationAuthsIntegrationAuthsQueryIntegrationAuthsConnectionEdgesQueryIntegrationAuthsConnectionEdgeNodeIntegrationAuthIntegrationsIntegrationAuthIntegrationsConnectionEdgesIntegrationAuthIntegrationsConnectionEdgeNodeIntegration) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *listIntegrationAuthsIntegrationAuthsQueryIntegrationAuthsConnectionEdgesQueryIntegrationAuthsConnectionEdgeNodeIntegrationAuthIntegrationsIntegrationAuthIntegrationsConnectionEdgesIntegrationAuthIntegrationsConnectionEdgeNodeIntegration) __premarshalJSON() (*__premarshallistIntegrationAuthsIntegrationAuthsQueryIntegrationAuthsConnectionEdgesQueryIntegrationAuthsConnectionEdgeNodeIntegrationAuthIntegrationsIntegrationAuthIntegrationsConnectionEdgesIntegrationAuthIntegrationsConnectionEdgeNodeIntegration, error) {
+	var retval __premarshallistIntegrationAuthsIntegrationAuthsQueryIntegrationAuthsConnectionEdgesQueryIntegrationAuthsConnectionEdgeNodeIntegrationAuthIntegrationsIntegrationAuthIntegrationsConnectionEdgesIntegrationAuthIntegrationsConnectionEdgeNodeIntegration
+
+	retval.Id = v.Integration.Id
+	retval.Name = v.Integration.Name
+	retval.ProjectId = v.Integration.ProjectId
+	retval.Config = v.Integration.Config
+	return &retval, nil
+}
+
+// listIntegrationAuthsResponse is returned by listIntegrationAuths on success.
+type listIntegrationAuthsResponse struct {
+	// Get all integration auths for a user
+	IntegrationAuths listIntegrationAuthsIntegrationAuthsQueryIntegrationAuthsConnection `json:"integrationAuths"`
+}
+
+// GetIntegrationAuths returns listIntegrationAuthsResponse.IntegrationAuths, and is useful for accessing the field via an interface.
+func (v *listIntegrationAuthsResponse) GetIntegrationAuths() listIntegrationAuthsIntegrationAuthsQueryIntegrationAuthsConnection {
+	return v.IntegrationAuths
+}
+
+// listIntegrationsIntegrationsQueryIntegrationsConnection includes the requested fields of the GraphQL type QueryIntegrationsConnection.
+type listIntegrationsIntegrationsQueryIntegrationsConnection struct {
+	Edges []listIntegrationsIntegrationsQueryIntegrationsConnectionEdgesQueryIntegrationsConnectionEdge `json:"edges"`
+}
+
+// GetEdges returns listIntegrationsIntegrationsQueryIntegrationsConnection.Edges, and is useful for accessing the field via an interface.
+func (v *listIntegrationsIntegrationsQueryIntegrationsConnection) GetEdges() []listIntegrationsIntegrationsQueryIntegrationsConnectionEdgesQueryIntegrationsConnectionEdge {
+	return v.Edges
+}
+
+// listIntegrationsIntegrationsQueryIntegrationsConnectionEdgesQueryIntegrationsConnectionEdge includes the requested fields of the GraphQL type QueryIntegrationsConnectionEdge.
+type listIntegrationsIntegrationsQueryIntegrationsConnectionEdgesQueryIntegrationsConnectionEdge struct {
+	Node listIntegrationsIntegrationsQueryIntegrationsConnectionEdgesQueryIntegrationsConnectionEdgeNodeIntegration `json:"node"`
+}
+
+// GetNode returns listIntegrationsIntegrationsQueryIntegrationsConnectionEdgesQueryIntegrationsConnectionEdge.Node, and is useful for accessing the field via an interface.
+func (v *listIntegrationsIntegrationsQueryIntegrationsConnectionEdgesQueryIntegrationsConnectionEdge) GetNode() listIntegrationsIntegrationsQueryIntegrationsConnectionEdgesQueryIntegrationsConnectionEdgeNodeIntegration {
+	return v.Node
+}
+
+// listIntegrationsIntegrationsQueryIntegrationsConnectionEdgesQueryIntegrationsConnectionEdgeNodeIntegration includes the requested fields of the GraphQL type Integration.
+type listIntegrationsIntegrationsQueryIntegrationsConnectionEdgesQueryIntegrationsConnectionEdgeNodeIntegration struct {
+	Integration `json:"-"`
+}
+
+// GetId returns listIntegrationsIntegrationsQueryIntegrationsConnectionEdgesQueryIntegrationsConnectionEdgeNodeIntegration.Id, and is useful for accessing the field via an interface.
+func (v *listIntegrationsIntegrationsQueryIntegrationsConnectionEdgesQueryIntegrationsConnectionEdgeNodeIntegration) GetId() string {
+	return v.Integration.Id
+}
+
+// GetName returns listIntegrationsIntegrationsQueryIntegrationsConnectionEdgesQueryIntegrationsConnectionEdgeNodeIntegration.Name, and is useful for accessing the field via an interface.
+func (v *listIntegrationsIntegrationsQueryIntegrationsConnectionEdgesQueryIntegrationsConnectionEdgeNodeIntegration) GetName() string {
+	return v.Integration.Name
+}
+
+// GetProjectId returns listIntegrationsIntegrationsQueryIntegrationsConnectionEdgesQueryIntegrationsConnectionEdgeNodeIntegration.ProjectId, and is useful for accessing the field via an interface.
+func (v *listIntegrationsIntegrationsQueryIntegrationsConnectionEdgesQueryIntegrationsConnectionEdgeNodeIntegration) GetProjectId() string {
+	return v.Integration.ProjectId
+}
+
+// GetConfig returns listIntegrationsIntegrationsQueryIntegrationsConnectionEdgesQueryIntegrationsConnectionEdgeNodeIntegration.Config, and is useful for accessing the field via an interface.
+func (v *listIntegrationsIntegrationsQueryIntegrationsConnectionEdgesQueryIntegrationsConnectionEdgeNodeIntegration) GetConfig() map[string]interface{} {
+	return v.Integration.Config
+}
+
+func (v *listIntegrationsIntegrationsQueryIntegrationsConnectionEdgesQueryIntegrationsConnectionEdgeNodeIntegration) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*listIntegrationsIntegrationsQueryIntegrationsConnectionEdgesQueryIntegrationsConnectionEdgeNodeIntegration
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.listIntegrationsIntegrationsQueryIntegrationsConnectionEdgesQueryIntegrationsConnectionEdgeNodeIntegration = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Integration)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshallistIntegrationsIntegrationsQueryIntegrationsConnectionEdgesQueryIntegrationsConnectionEdgeNodeIntegration struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	ProjectId string `json:"projectId"`
+
+	Config map[string]interface{} `json:"config"`
+}
+
+func (v *listIntegrationsIntegrationsQueryIntegrationsConnectionEdgesQueryIntegrationsConnectionEdgeNodeIntegration) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *listIntegrationsIntegrationsQueryIntegrationsConnectionEdgesQueryIntegrationsConnectionEdgeNodeIntegration) __premarshalJSON() (*__premarshallistIntegrationsIntegrationsQueryIntegrationsConnectionEdgesQueryIntegrationsConnectionEdgeNodeIntegration, error) {
+	var retval __premarshallistIntegrationsIntegrationsQueryIntegrationsConnectionEdgesQueryIntegrationsConnectionEdgeNodeIntegration
+
+	retval.Id = v.Integration.Id
+	retval.Name = v.Integration.Name
+	retval.ProjectId = v.Integration.ProjectId
+	retval.Config = v.Integration.Config
+	return &retval, nil
+}
+
+// listIntegrationsResponse is returned by listIntegrations on success.
+type listIntegrationsResponse struct {
+	// Get all integrations for a project
+	Integrations listIntegrationsIntegrationsQueryIntegrationsConnection `json:"integrations"`
+}
+
+// GetIntegrations returns listIntegrationsResponse.Integrations, and is useful for accessing the field via an interface.
+func (v *listIntegrationsResponse) GetIntegrations() listIntegrationsIntegrationsQueryIntegrationsConnection {
+	return v.Integrations
+}
+
+// listObservabilityDashboardsObservabilityDashboardsQueryObservabilityDashboardsConnection includes the requested fields of the GraphQL type QueryObservabilityDashboardsConnection.
+type listObservabilityDashboardsObservabilityDashboardsQueryObservabilityDashboardsConnection struct {
+	Edges []listObservabilityDashboardsObservabilityDashboardsQueryObservabilityDashboardsConnectionEdgesQueryObservabilityDashboardsConnectionEdge `json:"edges"`
+}
+
+// GetEdges returns listObservabilityDashboardsObservabilityDashboardsQueryObservabilityDashboardsConnection.Edges, and is useful for accessing the field via an interface.
+func (v *listObservabilityDashboardsObservabilityDashboardsQueryObservabilityDashboardsConnection) GetEdges() []listObservabilityDashboardsObservabilityDashboardsQueryObservabilityDashboardsConnectionEdgesQueryObservabilityDashboardsConnectionEdge {
+	return v.Edges
+}
+
+// listObservabilityDashboardsObservabilityDashboardsQueryObservabilityDashboardsConnectionEdgesQueryObservabilityDashboardsConnectionEdge includes the requested fields of the GraphQL type QueryObservabilityDashboardsConnectionEdge.
+type listObservabilityDashboardsObservabilityDashboardsQueryObservabilityDashboardsConnectionEdgesQueryObservabilityDashboardsConnectionEdge struct {
+	Node listObservabilityDashboardsObservabilityDashboardsQueryObservabilityDashboardsConnectionEdgesQueryObservabilityDashboardsConnectionEdgeNodeObservabilityDashboard `json:"node"`
+}
+
+// GetNode returns listObservabilityDashboardsObservabilityDashboardsQueryObservabilityDashboardsConnectionEdgesQueryObservabilityDashboardsConnectionEdge.Node, and is useful for accessing the field via an interface.
+func (v *listObservabilityDashboardsObservabilityDashboardsQueryObservabilityDashboardsConnectionEdgesQueryObservabilityDashboardsConnectionEdge) GetNode() listObservabilityDashboardsObservabilityDashboardsQueryObservabilityDashboardsConnectionEdgesQueryObservabilityDashboardsConnectionEdgeNodeObservabilityDashboard {
+	return v.Node
+}
+
+// listObservabilityDashboardsObservabilityDashboardsQueryObservabilityDashboardsConnectionEdgesQueryObservabilityDashboardsConnectionEdgeNodeObservabilityDashboard includes the requested fields of the GraphQL type ObservabilityDashboard.
+type listObservabilityDashboardsObservabilityDashboardsQueryObservabilityDashboardsConnectionEdgesQueryObservabilityDashboardsConnectionEdgeNodeObservabilityDashboard struct {
+	ObservabilityDashboard `json:"-"`
+}
+
+// GetId returns listObservabilityDashboardsObservabilityDashboardsQueryObservabilityDashboardsConnectionEdgesQueryObservabilityDashboardsConnectionEdgeNodeObservabilityDashboard.Id, and is useful for accessing the field via an interface.
+func (v *listObservabilityDashboardsObservabilityDashboardsQueryObservabilityDashboardsConnectionEdgesQueryObservabilityDashboardsConnectionEdgeNodeObservabilityDashboard) GetId() string {
+	return v.ObservabilityDashboard.Id
+}
+
+// GetItems returns listObservabilityDashboardsObservabilityDashboardsQueryObservabilityDashboardsConnectionEdgesQueryObservabilityDashboardsConnectionEdgeNodeObservabilityDashboard.Items, and is useful for accessing the field via an interface.
+func (v *listObservabilityDashboardsObservabilityDashboardsQueryObservabilityDashboardsConnectionEdgesQueryObservabilityDashboardsConnectionEdgeNodeObservabilityDashboard) GetItems() []ObservabilityDashboardItemsObservabilityDashboardItemInstance {
+	return v.ObservabilityDashboard.Items
+}
+
+func (v *listObservabilityDashboardsObservabilityDashboardsQueryObservabilityDashboardsConnectionEdgesQueryObservabilityDashboardsConnectionEdgeNodeObservabilityDashboard) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*listObservabilityDashboardsObservabilityDashboardsQueryObservabilityDashboardsConnectionEdgesQueryObservabilityDashboardsConnectionEdgeNodeObservabilityDashboard
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.listObservabilityDashboardsObservabilityDashboardsQueryObservabilityDashboardsConnectionEdgesQueryObservabilityDashboardsConnectionEdgeNodeObservabilityDashboard = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ObservabilityDashboard)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshallistObservabilityDashboardsObservabilityDashboardsQueryObservabilityDashboardsConnectionEdgesQueryObservabilityDashboardsConnectionEdgeNodeObservabilityDashboard struct {
+	Id string `json:"id"`
+
+	Items []ObservabilityDashboardItemsObservabilityDashboardItemInstance `json:"items"`
+}
+
+func (v *listObservabilityDashboardsObservabilityDashboardsQueryObservabilityDashboardsConnectionEdgesQueryObservabilityDashboardsConnectionEdgeNodeObservabilityDashboard) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *listObservabilityDashboardsObservabilityDashboardsQueryObservabilityDashboardsConnectionEdgesQueryObservabilityDashboardsConnectionEdgeNodeObservabilityDashboard) __premarshalJSON() (*__premarshallistObservabilityDashboardsObservabilityDashboardsQueryObservabilityDashboardsConnectionEdgesQueryObservabilityDashboardsConnectionEdgeNodeObservabilityDashboard, error) {
+	var retval __premarshallistObservabilityDashboardsObservabilityDashboardsQueryObservabilityDashboardsConnectionEdgesQueryObservabilityDashboardsConnectionEdgeNodeObservabilityDashboard
+
+	retval.Id = v.ObservabilityDashboard.Id
+	retval.Items = v.ObservabilityDashboard.Items
+	return &retval, nil
+}
+
+// listObservabilityDashboardsResponse is returned by listObservabilityDashboards on success.
+type listObservabilityDashboardsResponse struct {
+	// Get all observability dashboards for an environment
+	ObservabilityDashboards listObservabilityDashboardsObservabilityDashboardsQueryObservabilityDashboardsConnection `json:"observabilityDashboards"`
+}
+
+// GetObservabilityDashboards returns listObservabilityDashboardsResponse.ObservabilityDashboards, and is useful for accessing the field via an interface.
+func (v *listObservabilityDashboardsResponse) GetObservabilityDashboards() listObservabilityDashboardsObservabilityDashboardsQueryObservabilityDashboardsConnection {
+	return v.ObservabilityDashboards
+}
+
+// listPrivateNetworksPrivateNetworksPrivateNetwork includes the requested fields of the GraphQL type PrivateNetwork.
+type listPrivateNetworksPrivateNetworksPrivateNetwork struct {
+	PublicId string `json:"publicId"`
+	Name     string `json:"name"`
+}
+
+// GetPublicId returns listPrivateNetworksPrivateNetworksPrivateNetwork.PublicId, and is useful for accessing the field via an interface.
+func (v *listPrivateNetworksPrivateNetworksPrivateNetwork) GetPublicId() string { return v.PublicId }
+
+// GetName returns listPrivateNetworksPrivateNetworksPrivateNetwork.Name, and is useful for accessing the field via an interface.
+func (v *listPrivateNetworksPrivateNetworksPrivateNetwork) GetName() string { return v.Name }
+
+// listPrivateNetworksResponse is returned by listPrivateNetworks on success.
+type listPrivateNetworksResponse struct {
+	// List private networks for an environment.
+	PrivateNetworks []listPrivateNetworksPrivateNetworksPrivateNetwork `json:"privateNetworks"`
+}
+
+// GetPrivateNetworks returns listPrivateNetworksResponse.PrivateNetworks, and is useful for accessing the field via an interface.
+func (v *listPrivateNetworksResponse) GetPrivateNetworks() []listPrivateNetworksPrivateNetworksPrivateNetwork {
+	return v.PrivateNetworks
+}
+
+// listProjectServicesProject includes the requested fields of the GraphQL type Project.
+type listProjectServicesProject struct {
+	Services listProjectServicesProjectServicesProjectServicesConnection `json:"services"`
+}
+
+// GetServices returns listProjectServicesProject.Services, and is useful for accessing the field via an interface.
+func (v *listProjectServicesProject) GetServices() listProjectServicesProjectServicesProjectServicesConnection {
+	return v.Services
+}
+
+// listProjectServicesProjectServicesProjectServicesConnection includes the requested fields of the GraphQL type ProjectServicesConnection.
+type listProjectServicesProjectServicesProjectServicesConnection struct {
+	Edges []listProjectServicesProjectServicesProjectServicesConnectionEdgesProjectServicesConnectionEdge `json:"edges"`
+}
+
+// GetEdges returns listProjectServicesProjectServicesProjectServicesConnection.Edges, and is useful for accessing the field via an interface.
+func (v *listProjectServicesProjectServicesProjectServicesConnection) GetEdges() []listProjectServicesProjectServicesProjectServicesConnectionEdgesProjectServicesConnectionEdge {
+	return v.Edges
+}
+
+// listProjectServicesProjectServicesProjectServicesConnectionEdgesProjectServicesConnectionEdge includes the requested fields of the GraphQL type ProjectServicesConnectionEdge.
+type listProjectServicesProjectServicesProjectServicesConnectionEdgesProjectServicesConnectionEdge struct {
+	Node listProjectServicesProjectServicesProjectServicesConnectionEdgesProjectServicesConnectionEdgeNodeService `json:"node"`
+}
+
+// GetNode returns listProjectServicesProjectServicesProjectServicesConnectionEdgesProjectServicesConnectionEdge.Node, and is useful for accessing the field via an interface.
+func (v *listProjectServicesProjectServicesProjectServicesConnectionEdgesProjectServicesConnectionEdge) GetNode() listProjectServicesProjectServicesProjectServicesConnectionEdgesProjectServicesConnectionEdgeNodeService {
+	return v.Node
+}
+
+// listProjectServicesProjectServicesProjectServicesConnectionEdgesProjectServicesConnectionEdgeNodeService includes the requested fields of the GraphQL type Service.
+type listProjectServicesProjectServicesProjectServicesConnectionEdgesProjectServicesConnectionEdgeNodeService struct {
+	Id                string  `json:"id"`
+	Name              string  `json:"name"`
+	TemplateId        *string `json:"templateId"`
+	TemplateServiceId *string `json:"templateServiceId"`
+}
+
+// GetId returns listProjectServicesProjectServicesProjectServicesConnectionEdgesProjectServicesConnectionEdgeNodeService.Id, and is useful for accessing the field via an interface.
+func (v *listProjectServicesProjectServicesProjectServicesConnectionEdgesProjectServicesConnectionEdgeNodeService) GetId() string {
+	return v.Id
+}
+
+// GetName returns listProjectServicesProjectServicesProjectServicesConnectionEdgesProjectServicesConnectionEdgeNodeService.Name, and is useful for accessing the field via an interface.
+func (v *listProjectServicesProjectServicesProjectServicesConnectionEdgesProjectServicesConnectionEdgeNodeService) GetName() string {
+	return v.Name
+}
+
+// GetTemplateId returns listProjectServicesProjectServicesProjectServicesConnectionEdgesProjectServicesConnectionEdgeNodeService.TemplateId, and is useful for accessing the field via an interface.
+func (v *listProjectServicesProjectServicesProjectServicesConnectionEdgesProjectServicesConnectionEdgeNodeService) GetTemplateId() *string {
+	return v.TemplateId
+}
+
+// GetTemplateServiceId returns listProjectServicesProjectServicesProjectServicesConnectionEdgesProjectServicesConnectionEdgeNodeService.TemplateServiceId, and is useful for accessing the field via an interface.
+func (v *listProjectServicesProjectServicesProjectServicesConnectionEdgesProjectServicesConnectionEdgeNodeService) GetTemplateServiceId() *string {
+	return v.TemplateServiceId
+}
+
+// listProjectServicesResponse is returned by listProjectServices on success.
+type listProjectServicesResponse struct {
+	// Get a project by ID
+	Project listProjectServicesProject `json:"project"`
+}
+
+// GetProject returns listProjectServicesResponse.Project, and is useful for accessing the field via an interface.
+func (v *listProjectServicesResponse) GetProject() listProjectServicesProject { return v.Project }
+
+// listSealedVariablesEnvironment includes the requested fields of the GraphQL type Environment.
+type listSealedVariablesEnvironment struct {
+	Variables listSealedVariablesEnvironmentVariablesEnvironmentVariablesConnection `json:"variables"`
+}
+
+// GetVariables returns listSealedVariablesEnvironment.Variables, and is useful for accessing the field via an interface.
+func (v *listSealedVariablesEnvironment) GetVariables() listSealedVariablesEnvironmentVariablesEnvironmentVariablesConnection {
+	return v.Variables
+}
+
+// listSealedVariablesEnvironmentVariablesEnvironmentVariablesConnection includes the requested fields of the GraphQL type EnvironmentVariablesConnection.
+type listSealedVariablesEnvironmentVariablesEnvironmentVariablesConnection struct {
+	Edges []listSealedVariablesEnvironmentVariablesEnvironmentVariablesConnectionEdgesEnvironmentVariablesConnectionEdge `json:"edges"`
+}
+
+// GetEdges returns listSealedVariablesEnvironmentVariablesEnvironmentVariablesConnection.Edges, and is useful for accessing the field via an interface.
+func (v *listSealedVariablesEnvironmentVariablesEnvironmentVariablesConnection) GetEdges() []listSealedVariablesEnvironmentVariablesEnvironmentVariablesConnectionEdgesEnvironmentVariablesConnectionEdge {
+	return v.Edges
+}
+
+// listSealedVariablesEnvironmentVariablesEnvironmentVariablesConnectionEdgesEnvironmentVariablesConnectionEdge includes the requested fields of the GraphQL type EnvironmentVariablesConnectionEdge.
+type listSealedVariablesEnvironmentVariablesEnvironmentVariablesConnectionEdgesEnvironmentVariablesConnectionEdge struct {
+	Node listSealedVariablesEnvironmentVariablesEnvironmentVariablesConnectionEdgesEnvironmentVariablesConnectionEdgeNodeVariable `json:"node"`
+}
+
+// GetNode returns listSealedVariablesEnvironmentVariablesEnvironmentVariablesConnectionEdgesEnvironmentVariablesConnectionEdge.Node, and is useful for accessing the field via an interface.
+func (v *listSealedVariablesEnvironmentVariablesEnvironmentVariablesConnectionEdgesEnvironmentVariablesConnectionEdge) GetNode() listSealedVariablesEnvironmentVariablesEnvironmentVariablesConnectionEdgesEnvironmentVariablesConnectionEdgeNodeVariable {
+	return v.Node
+}
+
+// listSealedVariablesEnvironmentVariablesEnvironmentVariablesConnectionEdgesEnvironmentVariablesConnectionEdgeNodeVariable includes the requested fields of the GraphQL type Variable.
+type listSealedVariablesEnvironmentVariablesEnvironmentVariablesConnectionEdgesEnvironmentVariablesConnectionEdgeNodeVariable struct {
+	Name      string `json:"name"`
+	ServiceId string `json:"serviceId"`
+	IsSealed  bool   `json:"isSealed"`
+}
+
+// GetName returns listSealedVariablesEnvironmentVariablesEnvironmentVariablesConnectionEdgesEnvironmentVariablesConnectionEdgeNodeVariable.Name, and is useful for accessing the field via an interface.
+func (v *listSealedVariablesEnvironmentVariablesEnvironmentVariablesConnectionEdgesEnvironmentVariablesConnectionEdgeNodeVariable) GetName() string {
+	return v.Name
+}
+
+// GetServiceId returns listSealedVariablesEnvironmentVariablesEnvironmentVariablesConnectionEdgesEnvironmentVariablesConnectionEdgeNodeVariable.ServiceId, and is useful for accessing the field via an interface.
+func (v *listSealedVariablesEnvironmentVariablesEnvironmentVariablesConnectionEdgesEnvironmentVariablesConnectionEdgeNodeVariable) GetServiceId() string {
+	return v.ServiceId
+}
+
+// GetIsSealed returns listSealedVariablesEnvironmentVariablesEnvironmentVariablesConnectionEdgesEnvironmentVariablesConnectionEdgeNodeVariable.IsSealed, and is useful for accessing the field via an interface.
+func (v *listSealedVariablesEnvironmentVariablesEnvironmentVariablesConnectionEdgesEnvironmentVariablesConnectionEdgeNodeVariable) GetIsSealed() bool {
+	return v.IsSealed
+}
+
+// listSealedVariablesResponse is returned by listSealedVariables on success.
+type listSealedVariablesResponse struct {
+	// Find a single environment
+	Environment listSealedVariablesEnvironment `json:"environment"`
+}
+
+// GetEnvironment returns listSealedVariablesResponse.Environment, and is useful for accessing the field via an interface.
+func (v *listSealedVariablesResponse) GetEnvironment() listSealedVariablesEnvironment {
+	return v.Environment
+}
+
+// listServiceDomainsDomainsAllDomains includes the requested fields of the GraphQL type AllDomains.
+type listServiceDomainsDomainsAllDomains struct {
+	ServiceDomains []listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain `json:"serviceDomains"`
+}
+
+// GetServiceDomains returns listServiceDomainsDomainsAllDomains.ServiceDomains, and is useful for accessing the field via an interface.
+func (v *listServiceDomainsDomainsAllDomains) GetServiceDomains() []listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain {
+	return v.ServiceDomains
+}
+
+// listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain includes the requested fields of the GraphQL type ServiceDomain.
+type listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain struct {
+	ServiceDomain `json:"-"`
+}
+
+// GetId returns listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain.Id, and is useful for accessing the field via an interface.
+func (v *listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain) GetId() string {
+	return v.ServiceDomain.Id
+}
+
+// GetDomain returns listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain.Domain, and is useful for accessing the field via an interface.
+func (v *listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain) GetDomain() string {
+	return v.ServiceDomain.Domain
+}
+
+// GetSuffix returns listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain.Suffix, and is useful for accessing the field via an interface.
+func (v *listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain) GetSuffix() string {
+	return v.ServiceDomain.Suffix
+}
+
+// GetTargetPort returns listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain.TargetPort, and is useful for accessing the field via an interface.
+func (v *listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain) GetTargetPort() int {
+	return v.ServiceDomain.TargetPort
+}
+
+// GetEnvironmentId returns listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain.EnvironmentId, and is useful for accessing the field via an interface.
+func (v *listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain) GetEnvironmentId() string {
+	return v.ServiceDomain.EnvironmentId
+}
+
+// GetServiceId returns listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain.ServiceId, and is useful for accessing the field via an interface.
+func (v *listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain) GetServiceId() string {
+	return v.ServiceDomain.ServiceId
+}
+
+func (v *listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ServiceDomain)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshallistServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain struct {
+	Id string `json:"id"`
+
+	Domain string `json:"domain"`
+
+	Suffix string `json:"suffix"`
+
+	TargetPort int `json:"targetPort"`
+
+	EnvironmentId string `json:"environmentId"`
+
+	ServiceId string `json:"serviceId"`
+}
+
+func (v *listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *listServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain) __premarshalJSON() (*__premarshallistServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain, error) {
+	var retval __premarshallistServiceDomainsDomainsAllDomainsServiceDomainsServiceDomain
+
+	retval.Id = v.ServiceDomain.Id
+	retval.Domain = v.ServiceDomain.Domain
+	retval.Suffix = v.ServiceDomain.Suffix
+	retval.TargetPort = v.ServiceDomain.TargetPort
+	retval.EnvironmentId = v.ServiceDomain.EnvironmentId
+	retval.ServiceId = v.ServiceDomain.ServiceId
+	return &retval, nil
+}
+
+// listServiceDomainsResponse is returned by listServiceDomains on success.
+type listServiceDomainsResponse struct {
+	// All domains for a service instance
+	Domains listServiceDomainsDomainsAllDomains `json:"domains"`
+}
+
+// GetDomains returns listServiceDomainsResponse.Domains, and is useful for accessing the field via an interface.
+func (v *listServiceDomainsResponse) GetDomains() listServiceDomainsDomainsAllDomains {
+	return v.Domains
+}
+
+// listSshPublicKeysResponse is returned by listSshPublicKeys on success.
+type listSshPublicKeysResponse struct {
+	// Gets all SSH public keys for the authenticated user.
+	SshPublicKeys listSshPublicKeysSshPublicKeysQuerySshPublicKeysConnection `json:"sshPublicKeys"`
+}
+
+// GetSshPublicKeys returns listSshPublicKeysResponse.SshPublicKeys, and is useful for accessing the field via an interface.
+func (v *listSshPublicKeysResponse) GetSshPublicKeys() listSshPublicKeysSshPublicKeysQuerySshPublicKeysConnection {
+	return v.SshPublicKeys
+}
+
+// listSshPublicKeysSshPublicKeysQuerySshPublicKeysConnection includes the requested fields of the GraphQL type QuerySshPublicKeysConnection.
+type listSshPublicKeysSshPublicKeysQuerySshPublicKeysConnection struct {
+	Edges []listSshPublicKeysSshPublicKeysQuerySshPublicKeysConnectionEdgesQuerySshPublicKeysConnectionEdge `json:"edges"`
+}
+
+// GetEdges returns listSshPublicKeysSshPublicKeysQuerySshPublicKeysConnection.Edges, and is useful for accessing the field via an interface.
+func (v *listSshPublicKeysSshPublicKeysQuerySshPublicKeysConnection) GetEdges() []listSshPublicKeysSshPublicKeysQuerySshPublicKeysConnectionEdgesQuerySshPublicKeysConnectionEdge {
+	return v.Edges
+}
+
+// listSshPublicKeysSshPublicKeysQuerySshPublicKeysConnectionEdgesQuerySshPublicKeysConnectionEdge includes the requested fields of the GraphQL type QuerySshPublicKeysConnectionEdge.
+type listSshPublicKeysSshPublicKeysQuerySshPublicKeysConnectionEdgesQuerySshPublicKeysConnectionEdge struct {
+	Node listSshPublicKeysSshPublicKeysQuerySshPublicKeysConnectionEdgesQuerySshPublicKeysConnectionEdgeNodeSshPublicKey `json:"node"`
+}
+
+// GetNode returns listSshPublicKeysSshPublicKeysQuerySshPublicKeysConnectionEdgesQuerySshPublicKeysConnectionEdge.Node, and is useful for accessing the field via an interface.
+func (v *listSshPublicKeysSshPublicKeysQuerySshPublicKeysConnectionEdgesQuerySshPublicKeysConnectionEdge) GetNode() listSshPublicKeysSshPublicKeysQuerySshPublicKeysConnectionEdgesQuerySshPublicKeysConnectionEdgeNodeSshPublicKey {
+	return v.Node
+}
+
+// listSshPublicKeysSshPublicKeysQuerySshPublicKeysConnectionEdgesQuerySshPublicKeysConnectionEdgeNodeSshPublicKey includes the requested fields of the GraphQL type SshPublicKey.
+type listSshPublicKeysSshPublicKeysQuerySshPublicKeysConnectionEdgesQuerySshPublicKeysConnectionEdgeNodeSshPublicKey struct {
+	SshPublicKey `json:"-"`
+}
+
+// GetId returns listSshPublicKeysSshPublicKeysQuerySshPublicKeysConnectionEdgesQuerySshPublicKeysConnectionEdgeNodeSshPublicKey.Id, and is useful for accessing the field via an interface.
+func (v *listSshPublicKeysSshPublicKeysQuerySshPublicKeysConnectionEdgesQuerySshPublicKeysConnectionEdgeNodeSshPublicKey) GetId() string {
+	return v.SshPublicKey.Id
+}
+
+// GetName returns listSshPublicKeysSshPublicKeysQuerySshPublicKeysConnectionEdgesQuerySshPublicKeysConnectionEdgeNodeSshPublicKey.Name, and is useful for accessing the field via an interface.
+func (v *listSshPublicKeysSshPublicKeysQuerySshPublicKeysConnectionEdgesQuerySshPublicKeysConnectionEdgeNodeSshPublicKey) GetName() string {
+	return v.SshPublicKey.Name
+}
+
+// GetPublicKey returns listSshPublicKeysSshPublicKeysQuerySshPublicKeysConnectionEdgesQuerySshPublicKeysConnectionEdgeNodeSshPublicKey.PublicKey, and is useful for accessing the field via an interface.
+func (v *listSshPublicKeysSshPublicKeysQuerySshPublicKeysConnectionEdgesQuerySshPublicKeysConnectionEdgeNodeSshPublicKey) GetPublicKey() string {
+	return v.SshPublicKey.PublicKey
+}
+
+// GetFingerprint returns listSshPublicKeysSshPublicKeysQuerySshPublicKeysConnectionEdgesQuerySshPublicKeysConnectionEdgeNodeSshPublicKey.Fingerprint, and is useful for accessing the field via an interface.
+func (v *listSshPublicKeysSshPublicKeysQuerySshPublicKeysConnectionEdgesQuerySshPublicKeysConnectionEdgeNodeSshPublicKey) GetFingerprint() string {
+	return v.SshPublicKey.Fingerprint
+}
+
+func (v *listSshPublicKeysSshPublicKeysQuerySshPublicKeysConnectionEdgesQuerySshPublicKeysConnectionEdgeNodeSshPublicKey) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*listSshPublicKeysSshPublicKeysQuerySshPublicKeysConnectionEdgesQuerySshPublicKeysConnectionEdgeNodeSshPublicKey
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.listSshPublicKeysSshPublicKeysQuerySshPublicKeysConnectionEdgesQuerySshPublicKeysConnectionEdgeNodeSshPublicKey = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.SshPublicKey)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshallistSshPublicKeysSshPublicKeysQuerySshPublicKeysConnectionEdgesQuerySshPublicKeysConnectionEdgeNodeSshPublicKey struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	PublicKey string `json:"publicKey"`
+
+	Fingerprint string `json:"fingerprint"`
+}
+
+func (v *listSshPublicKeysSshPublicKeysQuerySshPublicKeysConnectionEdgesQuerySshPublicKeysConnectionEdgeNodeSshPublicKey) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *listSshPublicKeysSshPublicKeysQuerySshPublicKeysConnectionEdgesQuerySshPublicKeysConnectionEdgeNodeSshPublicKey) __premarshalJSON() (*__premarshallistSshPublicKeysSshPublicKeysQuerySshPublicKeysConnectionEdgesQuerySshPublicKeysConnectionEdgeNodeSshPublicKey, error) {
+	var retval __premarshallistSshPublicKeysSshPublicKeysQuerySshPublicKeysConnectionEdgesQuerySshPublicKeysConnectionEdgeNodeSshPublicKey
+
+	retval.Id = v.SshPublicKey.Id
+	retval.Name = v.SshPublicKey.Name
+	retval.PublicKey = v.SshPublicKey.PublicKey
+	retval.Fingerprint = v.SshPublicKey.Fingerprint
+	return &retval, nil
+}
+
+// privateNetworkEndpointNameAvailableResponse is returned by privateNetworkEndpointNameAvailable on success.
+type privateNetworkEndpointNameAvailableResponse struct {
+	// Check if an endpoint name is available.
+	PrivateNetworkEndpointNameAvailable bool `json:"privateNetworkEndpointNameAvailable"`
+}
+
+// GetPrivateNetworkEndpointNameAvailable returns privateNetworkEndpointNameAvailableResponse.PrivateNetworkEndpointNameAvailable, and is useful for accessing the field via an interface.
+func (v *privateNetworkEndpointNameAvailableResponse) GetPrivateNetworkEndpointNameAvailable() bool {
+	return v.PrivateNetworkEndpointNameAvailable
+}
+
+// redeployServiceInstanceResponse is returned by redeployServiceInstance on success.
+type redeployServiceInstanceResponse struct {
+	// Redeploy a service instance
+	ServiceInstanceRedeploy bool `json:"serviceInstanceRedeploy"`
+}
+
+// GetServiceInstanceRedeploy returns redeployServiceInstanceResponse.ServiceInstanceRedeploy, and is useful for accessing the field via an interface.
+func (v *redeployServiceInstanceResponse) GetServiceInstanceRedeploy() bool {
+	return v.ServiceInstanceRedeploy
+}
+
+// removeUsageLimitResponse is returned by removeUsageLimit on success.
+type removeUsageLimitResponse struct {
+	// Remove the usage limit for a customer
+	UsageLimitRemove bool `json:"usageLimitRemove"`
+}
+
+// GetUsageLimitRemove returns removeUsageLimitResponse.UsageLimitRemove, and is useful for accessing the field via an interface.
+func (v *removeUsageLimitResponse) GetUsageLimitRemove() bool { return v.UsageLimitRemove }
+
+// renamePrivateNetworkEndpointResponse is returned by renamePrivateNetworkEndpoint on success.
+type renamePrivateNetworkEndpointResponse struct {
+	// Rename a private network endpoint.
+	PrivateNetworkEndpointRename bool `json:"privateNetworkEndpointRename"`
+}
+
+// GetPrivateNetworkEndpointRename returns renamePrivateNetworkEndpointResponse.PrivateNetworkEndpointRename, and is useful for accessing the field via an interface.
+func (v *renamePrivateNetworkEndpointResponse) GetPrivateNetworkEndpointRename() bool {
+	return v.PrivateNetworkEndpointRename
+}
+
+// resetBucketCredentialsBucketCredentialsResetBucketS3CompatibleCredentials includes the requested fields of the GraphQL type BucketS3CompatibleCredentials.
+type resetBucketCredentialsBucketCredentialsResetBucketS3CompatibleCredentials struct {
+	BucketS3CompatibleCredentials `json:"-"`
+}
+
+// GetAccessKeyId returns resetBucketCredentialsBucketCredentialsResetBucketS3CompatibleCredentials.AccessKeyId, and is useful for accessing the field via an interface.
+func (v *resetBucketCredentialsBucketCredentialsResetBucketS3CompatibleCredentials) GetAccessKeyId() string {
+	return v.BucketS3CompatibleCredentials.AccessKeyId
+}
+
+// GetSecretAccessKey returns resetBucketCredentialsBucketCredentialsResetBucketS3CompatibleCredentials.SecretAccessKey, and is useful for accessing the field via an interface.
+func (v *resetBucketCredentialsBucketCredentialsResetBucketS3CompatibleCredentials) GetSecretAccessKey() string {
+	return v.BucketS3CompatibleCredentials.SecretAccessKey
+}
+
+// GetBucketName returns resetBucketCredentialsBucketCredentialsResetBucketS3CompatibleCredentials.BucketName, and is useful for accessing the field via an interface.
+func (v *resetBucketCredentialsBucketCredentialsResetBucketS3CompatibleCredentials) GetBucketName() string {
+	return v.BucketS3CompatibleCredentials.BucketName
+}
+
+// GetEndpoint returns resetBucketCredentialsBucketCredentialsResetBucketS3CompatibleCredentials.Endpoint, and is useful for accessing the field via an interface.
+func (v *resetBucketCredentialsBucketCredentialsResetBucketS3CompatibleCredentials) GetEndpoint() string {
+	return v.BucketS3CompatibleCredentials.Endpoint
+}
+
+// GetRegion returns resetBucketCredentialsBucketCredentialsResetBucketS3CompatibleCredentials.Region, and is useful for accessing the field via an interface.
+func (v *resetBucketCredentialsBucketCredentialsResetBucketS3CompatibleCredentials) GetRegion() string {
+	return v.BucketS3CompatibleCredentials.Region
+}
+
+// GetUrlStyle returns resetBucketCredentialsBucketCredentialsResetBucketS3CompatibleCredentials.UrlStyle, and is useful for accessing the field via an interface.
+func (v *resetBucketCredentialsBucketCredentialsResetBucketS3CompatibleCredentials) GetUrlStyle() string {
+	return v.BucketS3CompatibleCredentials.UrlStyle
+}
+
+func (v *resetBucketCredentialsBucketCredentialsResetBucketS3CompatibleCredentials) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*resetBucketCredentialsBucketCredentialsResetBucketS3CompatibleCredentials
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.resetBucketCredentialsBucketCredentialsResetBucketS3CompatibleCredentials = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.BucketS3CompatibleCredentials)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalresetBucketCredentialsBucketCredentialsResetBucketS3CompatibleCredentials struct {
+	AccessKeyId string `json:"accessKeyId"`
+
+	SecretAccessKey string `json:"secretAccessKey"`
+
+	BucketName string `json:"bucketName"`
+
+	Endpoint string `json:"endpoint"`
+
+	Region string `json:"region"`
+
+	UrlStyle string `json:"urlStyle"`
+}
+
+func (v *resetBucketCredentialsBucketCredentialsResetBucketS3CompatibleCredentials) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *resetBucketCredentialsBucketCredentialsResetBucketS3CompatibleCredentials) __premarshalJSON() (*__premarshalresetBucketCredentialsBucketCredentialsResetBucketS3CompatibleCredentials, error) {
+	var retval __premarshalresetBucketCredentialsBucketCredentialsResetBucketS3CompatibleCredentials
+
+	retval.AccessKeyId = v.BucketS3CompatibleCredentials.AccessKeyId
+	retval.SecretAccessKey = v.BucketS3CompatibleCredentials.SecretAccessKey
+	retval.BucketName = v.BucketS3CompatibleCredentials.BucketName
+	retval.Endpoint = v.BucketS3CompatibleCredentials.Endpoint
+	retval.Region = v.BucketS3CompatibleCredentials.Region
+	retval.UrlStyle = v.BucketS3CompatibleCredentials.UrlStyle
+	return &retval, nil
+}
+
+// resetBucketCredentialsResponse is returned by resetBucketCredentials on success.
+type resetBucketCredentialsResponse struct {
+	// Reset the credentials for a bucket in an environment
+	BucketCredentialsReset resetBucketCredentialsBucketCredentialsResetBucketS3CompatibleCredentials `json:"bucketCredentialsReset"`
+}
+
+// GetBucketCredentialsReset returns resetBucketCredentialsResponse.BucketCredentialsReset, and is useful for accessing the field via an interface.
+func (v *resetBucketCredentialsResponse) GetBucketCredentialsReset() resetBucketCredentialsBucketCredentialsResetBucketS3CompatibleCredentials {
+	return v.BucketCredentialsReset
+}
+
+// resetObservabilityDashboardResponse is returned by resetObservabilityDashboard on success.
+type resetObservabilityDashboardResponse struct {
+	// Reset an observability dashboard to default dashboard items
+	ObservabilityDashboardReset bool `json:"observabilityDashboardReset"`
+}
+
+// GetObservabilityDashboardReset returns resetObservabilityDashboardResponse.ObservabilityDashboardReset, and is useful for accessing the field via an interface.
+func (v *resetObservabilityDashboardResponse) GetObservabilityDashboardReset() bool {
+	return v.ObservabilityDashboardReset
+}
+
+// rollbackDeploymentResponse is returned by rollbackDeployment on success.
+type rollbackDeploymentResponse struct {
+	// Rolls back to a deployment.
+	DeploymentRollback bool `json:"deploymentRollback"`
+}
+
+// GetDeploymentRollback returns rollbackDeploymentResponse.DeploymentRollback, and is useful for accessing the field via an interface.
+func (v *rollbackDeploymentResponse) GetDeploymentRollback() bool { return v.DeploymentRollback }
+
+// scheduleDeleteProjectResponse is returned by scheduleDeleteProject on success.
+type scheduleDeleteProjectResponse struct {
+	// Deletes a project with a 48 hour grace period.
+	ProjectScheduleDelete bool `json:"projectScheduleDelete"`
+}
+
+// GetProjectScheduleDelete returns scheduleDeleteProjectResponse.ProjectScheduleDelete, and is useful for accessing the field via an interface.
+func (v *scheduleDeleteProjectResponse) GetProjectScheduleDelete() bool {
+	return v.ProjectScheduleDelete
+}
+
+// setUsageLimitResponse is returned by setUsageLimit on success.
+type setUsageLimitResponse struct {
+	// Set the usage limit for a customer
+	UsageLimitSet bool `json:"usageLimitSet"`
+}
+
+// GetUsageLimitSet returns setUsageLimitResponse.UsageLimitSet, and is useful for accessing the field via an interface.
+func (v *setUsageLimitResponse) GetUsageLimitSet() bool { return v.UsageLimitSet }
+
+// stageEnvironmentChangesEnvironmentStageChangesEnvironmentPatch includes the requested fields of the GraphQL type EnvironmentPatch.
+type stageEnvironmentChangesEnvironmentStageChangesEnvironmentPatch struct {
+	Id string `json:"id"`
+}
+
+// GetId returns stageEnvironmentChangesEnvironmentStageChangesEnvironmentPatch.Id, and is useful for accessing the field via an interface.
+func (v *stageEnvironmentChangesEnvironmentStageChangesEnvironmentPatch) GetId() string { return v.Id }
+
+// stageEnvironmentChangesResponse is returned by stageEnvironmentChanges on success.
+type stageEnvironmentChangesResponse struct {
+	// Sets the staged patch for a single environment.
+	EnvironmentStageChanges stageEnvironmentChangesEnvironmentStageChangesEnvironmentPatch `json:"environmentStageChanges"`
+}
+
+// GetEnvironmentStageChanges returns stageEnvironmentChangesResponse.EnvironmentStageChanges, and is useful for accessing the field via an interface.
+func (v *stageEnvironmentChangesResponse) GetEnvironmentStageChanges() stageEnvironmentChangesEnvironmentStageChangesEnvironmentPatch {
+	return v.EnvironmentStageChanges
+}
+
+// updateBucketBucketUpdateBucket includes the requested fields of the GraphQL type Bucket.
+type updateBucketBucketUpdateBucket struct {
+	Bucket `json:"-"`
+}
+
+// GetId returns updateBucketBucketUpdateBucket.Id, and is useful for accessing the field via an interface.
+func (v *updateBucketBucketUpdateBucket) GetId() string { return v.Bucket.Id }
+
+// GetName returns updateBucketBucketUpdateBucket.Name, and is useful for accessing the field via an interface.
+func (v *updateBucketBucketUpdateBucket) GetName() string { return v.Bucket.Name }
+
+// GetProjectId returns updateBucketBucketUpdateBucket.ProjectId, and is useful for accessing the field via an interface.
+func (v *updateBucketBucketUpdateBucket) GetProjectId() string { return v.Bucket.ProjectId }
+
+func (v *updateBucketBucketUpdateBucket) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateBucketBucketUpdateBucket
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateBucketBucketUpdateBucket = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Bucket)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupdateBucketBucketUpdateBucket struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	ProjectId string `json:"projectId"`
+}
+
+func (v *updateBucketBucketUpdateBucket) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateBucketBucketUpdateBucket) __premarshalJSON() (*__premarshalupdateBucketBucketUpdateBucket, error) {
+	var retval __premarshalupdateBucketBucketUpdateBucket
+
+	retval.Id = v.Bucket.Id
+	retval.Name = v.Bucket.Name
+	retval.ProjectId = v.Bucket.ProjectId
+	return &retval, nil
+}
+
+// updateBucketResponse is returned by updateBucket on success.
+type updateBucketResponse struct {
+	// Updates a bucket.
+	BucketUpdate updateBucketBucketUpdateBucket `json:"bucketUpdate"`
+}
+
+// GetBucketUpdate returns updateBucketResponse.BucketUpdate, and is useful for accessing the field via an interface.
+func (v *updateBucketResponse) GetBucketUpdate() updateBucketBucketUpdateBucket {
+	return v.BucketUpdate
+}
+
+// updateCustomDomainResponse is returned by updateCustomDomain on success.
+type updateCustomDomainResponse struct {
+	// Updates a custom domain.
+	CustomDomainUpdate bool `json:"customDomainUpdate"`
+}
+
+// GetCustomDomainUpdate returns updateCustomDomainResponse.CustomDomainUpdate, and is useful for accessing the field via an interface.
+func (v *updateCustomDomainResponse) GetCustomDomainUpdate() bool { return v.CustomDomainUpdate }
+
+// updateIntegrationIntegrationUpdateIntegration includes the requested fields of the GraphQL type Integration.
+type updateIntegrationIntegrationUpdateIntegration struct {
+	Integration `json:"-"`
+}
+
+// GetId returns updateIntegrationIntegrationUpdateIntegration.Id, and is useful for accessing the field via an interface.
+func (v *updateIntegrationIntegrationUpdateIntegration) GetId() string { return v.Integration.Id }
+
+// GetName returns updateIntegrationIntegrationUpdateIntegration.Name, and is useful for accessing the field via an interface.
+func (v *updateIntegrationIntegrationUpdateIntegration) GetName() string { return v.Integration.Name }
+
+// GetProjectId returns updateIntegrationIntegrationUpdateIntegration.ProjectId, and is useful for accessing the field via an interface.
+func (v *updateIntegrationIntegrationUpdateIntegration) GetProjectId() string {
+	return v.Integration.ProjectId
+}
+
+// GetConfig returns updateIntegrationIntegrationUpdateIntegration.Config, and is useful for accessing the field via an interface.
+func (v *updateIntegrationIntegrationUpdateIntegration) GetConfig() map[string]interface{} {
+	return v.Integration.Config
+}
+
+func (v *updateIntegrationIntegrationUpdateIntegration) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateIntegrationIntegrationUpdateIntegration
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateIntegrationIntegrationUpdateIntegration = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Integration)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupdateIntegrationIntegrationUpdateIntegration struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	ProjectId string `json:"projectId"`
+
+	Config map[string]interface{} `json:"config"`
+}
+
+func (v *updateIntegrationIntegrationUpdateIntegration) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateIntegrationIntegrationUpdateIntegration) __premarshalJSON() (*__premarshalupdateIntegrationIntegrationUpdateIntegration, error) {
+	var retval __premarshalupdateIntegrationIntegrationUpdateIntegration
+
+	retval.Id = v.Integration.Id
+	retval.Name = v.Integration.Name
+	retval.ProjectId = v.Integration.ProjectId
+	retval.Config = v.Integration.Config
+	return &retval, nil
+}
+
+// updateIntegrationResponse is returned by updateIntegration on success.
+type updateIntegrationResponse struct {
+	// Update an integration for a project
+	IntegrationUpdate updateIntegrationIntegrationUpdateIntegration `json:"integrationUpdate"`
+}
+
+// GetIntegrationUpdate returns updateIntegrationResponse.IntegrationUpdate, and is useful for accessing the field via an interface.
+func (v *updateIntegrationResponse) GetIntegrationUpdate() updateIntegrationIntegrationUpdateIntegration {
+	return v.IntegrationUpdate
+}
+
+// updateObservabilityDashboardResponse is returned by updateObservabilityDashboard on success.
+type updateObservabilityDashboardResponse struct {
+	// Update an observability dashboard
+	ObservabilityDashboardUpdate bool `json:"observabilityDashboardUpdate"`
+}
+
+// GetObservabilityDashboardUpdate returns updateObservabilityDashboardResponse.ObservabilityDashboardUpdate, and is useful for accessing the field via an interface.
+func (v *updateObservabilityDashboardResponse) GetObservabilityDashboardUpdate() bool {
+	return v.ObservabilityDashboardUpdate
+}
+
+// updateProjectProjectUpdateProject includes the requested fields of the GraphQL type Project.
+type updateProjectProjectUpdateProject struct {
+	Project `json:"-"`
+}
+
+// GetId returns updateProjectProjectUpdateProject.Id, and is useful for accessing the field via an interface.
+func (v *updateProjectProjectUpdateProject) GetId() string { return v.Project.Id }
+
+// GetName returns updateProjectProjectUpdateProject.Name, and is useful for accessing the field via an interface.
+func (v *updateProjectProjectUpdateProject) GetName() string { return v.Project.Name }
+
+// GetDescription returns updateProjectProjectUpdateProject.Description, and is useful for accessing the field via an interface.
+func (v *updateProjectProjectUpdateProject) GetDescription() string { return v.Project.Description }
+
+// GetIsPublic returns updateProjectProjectUpdateProject.IsPublic, and is useful for accessing the field via an interface.
+func (v *updateProjectProjectUpdateProject) GetIsPublic() bool { return v.Project.IsPublic }
+
+// GetPrDeploys returns updateProjectProjectUpdateProject.PrDeploys, and is useful for accessing the field via an interface.
+func (v *updateProjectProjectUpdateProject) GetPrDeploys() bool { return v.Project.PrDeploys }
+
+// GetDeletedAt returns updateProjectProjectUpdateProject.DeletedAt, and is useful for accessing the field via an interface.
+func (v *updateProjectProjectUpdateProject) GetDeletedAt() time.Time { return v.Project.DeletedAt }
+
+// GetWorkspace returns updateProjectProjectUpdateProject.Workspace, and is useful for accessing the field via an interface.
+func (v *updateProjectProjectUpdateProject) GetWorkspace() *ProjectWorkspace {
+	return v.Project.Workspace
+}
+
+// GetEnvironments returns updateProjectProjectUpdateProject.Environments, and is useful for accessing the field via an interface.
+func (v *updateProjectProjectUpdateProject) GetEnvironments() ProjectEnvironmentsProjectEnvironmentsConnection {
+	return v.Project.Environments
+}
+
+func (v *updateProjectProjectUpdateProject) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateProjectProjectUpdateProject
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateProjectProjectUpdateProject = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Project)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupdateProjectProjectUpdateProject struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Description string `json:"description"`
+
+	IsPublic bool `json:"isPublic"`
+
+	PrDeploys bool `json:"prDeploys"`
+
+	DeletedAt time.Time `json:"deletedAt"`
+
+	Workspace *ProjectWorkspace `json:"workspace"`
+
+	Environments ProjectEnvironmentsProjectEnvironmentsConnection `json:"environments"`
+}
+
+func (v *updateProjectProjectUpdateProject) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateProjectProjectUpdateProject) __premarshalJSON() (*__premarshalupdateProjectProjectUpdateProject, error) {
+	var retval __premarshalupdateProjectProjectUpdateProject
+
+	retval.Id = v.Project.Id
+	retval.Name = v.Project.Name
+	retval.Description = v.Project.Description
+	retval.IsPublic = v.Project.IsPublic
+	retval.PrDeploys = v.Project.PrDeploys
+	retval.DeletedAt = v.Project.DeletedAt
+	retval.Workspace = v.Project.Workspace
+	retval.Environments = v.Project.Environments
+	return &retval, nil
+}
+
+// updateProjectResponse is returned by updateProject on success.
+type updateProjectResponse struct {
+	// Updates a project.
+	ProjectUpdate updateProjectProjectUpdateProject `json:"projectUpdate"`
+}
+
+// GetProjectUpdate returns updateProjectResponse.ProjectUpdate, and is useful for accessing the field via an interface.
+func (v *updateProjectResponse) GetProjectUpdate() updateProjectProjectUpdateProject {
+	return v.ProjectUpdate
+}
+
+// updateServiceDomainResponse is returned by updateServiceDomain on success.
+type updateServiceDomainResponse struct {
+	// Updates a service domain.
+	ServiceDomainUpdate bool `json:"serviceDomainUpdate"`
+}
+
+// GetServiceDomainUpdate returns updateServiceDomainResponse.ServiceDomainUpdate, and is useful for accessing the field via an interface.
+func (v *updateServiceDomainResponse) GetServiceDomainUpdate() bool { return v.ServiceDomainUpdate }
+
+// updateServiceInstanceResponse is returned by updateServiceInstance on success.
+type updateServiceInstanceResponse struct {
+	// Update a service instance
+	ServiceInstanceUpdate bool `json:"serviceInstanceUpdate"`
+}
+
+// GetServiceInstanceUpdate returns updateServiceInstanceResponse.ServiceInstanceUpdate, and is useful for accessing the field via an interface.
+func (v *updateServiceInstanceResponse) GetServiceInstanceUpdate() bool {
+	return v.ServiceInstanceUpdate
+}
+
+// updateServiceResponse is returned by updateService on success.
+type updateServiceResponse struct {
+	// Updates a service.
+	ServiceUpdate updateServiceServiceUpdateService `json:"serviceUpdate"`
+}
+
+// GetServiceUpdate returns updateServiceResponse.ServiceUpdate, and is useful for accessing the field via an interface.
+func (v *updateServiceResponse) GetServiceUpdate() updateServiceServiceUpdateService {
+	return v.ServiceUpdate
+}
+
+// updateServiceServiceUpdateService includes the requested fields of the GraphQL type Service.
+type updateServiceServiceUpdateService struct {
+	Service `json:"-"`
+}
+
+// GetId returns updateServiceServiceUpdateService.Id, and is useful for accessing the field via an interface.
+func (v *updateServiceServiceUpdateService) GetId() string { return v.Service.Id }
+
+// GetName returns updateServiceServiceUpdateService.Name, and is useful for accessing the field via an interface.
+func (v *updateServiceServiceUpdateService) GetName() string { return v.Service.Name }
+
+// GetProjectId returns updateServiceServiceUpdateService.ProjectId, and is useful for accessing the field via an interface.
+func (v *updateServiceServiceUpdateService) GetProjectId() string { return v.Service.ProjectId }
+
+func (v *updateServiceServiceUpdateService) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateServiceServiceUpdateService
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateServiceServiceUpdateService = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Service)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupdateServiceServiceUpdateService struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	ProjectId string `json:"projectId"`
+}
+
+func (v *updateServiceServiceUpdateService) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateServiceServiceUpdateService) __premarshalJSON() (*__premarshalupdateServiceServiceUpdateService, error) {
+	var retval __premarshalupdateServiceServiceUpdateService
+
+	retval.Id = v.Service.Id
+	retval.Name = v.Service.Name
+	retval.ProjectId = v.Service.ProjectId
+	return &retval, nil
+}
+
+// updateVolumeInstanceResponse is returned by updateVolumeInstance on success.
+type updateVolumeInstanceResponse struct {
+	// Update a volume instance. If no environmentId is provided, all volume instances for the volume will be updated.
+	VolumeInstanceUpdate bool `json:"volumeInstanceUpdate"`
+}
+
+// GetVolumeInstanceUpdate returns updateVolumeInstanceResponse.VolumeInstanceUpdate, and is useful for accessing the field via an interface.
+func (v *updateVolumeInstanceResponse) GetVolumeInstanceUpdate() bool { return v.VolumeInstanceUpdate }
+
+// updateVolumeResponse is returned by updateVolume on success.
+type updateVolumeResponse struct {
+	// Update a persistent volume in a project
+	VolumeUpdate updateVolumeVolumeUpdateVolume `json:"volumeUpdate"`
+}
+
+// GetVolumeUpdate returns updateVolumeResponse.VolumeUpdate, and is useful for accessing the field via an interface.
+func (v *updateVolumeResponse) GetVolumeUpdate() updateVolumeVolumeUpdateVolume {
+	return v.VolumeUpdate
+}
+
+// updateVolumeVolumeUpdateVolume includes the requested fields of the GraphQL type Volume.
+type updateVolumeVolumeUpdateVolume struct {
+	Volume `json:"-"`
+}
+
+// GetId returns updateVolumeVolumeUpdateVolume.Id, and is useful for accessing the field via an interface.
+func (v *updateVolumeVolumeUpdateVolume) GetId() string { return v.Volume.Id }
+
+// GetName returns updateVolumeVolumeUpdateVolume.Name, and is useful for accessing the field via an interface.
+func (v *updateVolumeVolumeUpdateVolume) GetName() string { return v.Volume.Name }
+
+// GetVolumeInstances returns updateVolumeVolumeUpdateVolume.VolumeInstances, and is useful for accessing the field via an interface.
+func (v *updateVolumeVolumeUpdateVolume) GetVolumeInstances() VolumeVolumeInstancesVolumeVolumeInstancesConnection {
+	return v.Volume.VolumeInstances
+}
+
+func (v *updateVolumeVolumeUpdateVolume) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateVolumeVolumeUpdateVolume
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateVolumeVolumeUpdateVolume = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Volume)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupdateVolumeVolumeUpdateVolume struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	VolumeInstances VolumeVolumeInstancesVolumeVolumeInstancesConnection `json:"volumeInstances"`
+}
+
+func (v *updateVolumeVolumeUpdateVolume) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateVolumeVolumeUpdateVolume) __premarshalJSON() (*__premarshalupdateVolumeVolumeUpdateVolume, error) {
+	var retval __premarshalupdateVolumeVolumeUpdateVolume
+
+	retval.Id = v.Volume.Id
+	retval.Name = v.Volume.Name
+	retval.VolumeInstances = v.Volume.VolumeInstances
+	return &retval, nil
+}
+
+// upsertVariableCollectionResponse is returned by upsertVariableCollection on success.
+type upsertVariableCollectionResponse struct {
+	// Upserts a collection of variables.
+	VariableCollectionUpsert bool `json:"variableCollectionUpsert"`
+}
+
+// GetVariableCollectionUpsert returns upsertVariableCollectionResponse.VariableCollectionUpsert, and is useful for accessing the field via an interface.
+func (v *upsertVariableCollectionResponse) GetVariableCollectionUpsert() bool {
+	return v.VariableCollectionUpsert
+}
+
+// upsertVariableResponse is returned by upsertVariable on success.
+type upsertVariableResponse struct {
+	// Upserts a variable.
+	VariableUpsert bool `json:"variableUpsert"`
+}
+
+// GetVariableUpsert returns upsertVariableResponse.VariableUpsert, and is useful for accessing the field via an interface.
+func (v *upsertVariableResponse) GetVariableUpsert() bool { return v.VariableUpsert }
+
+func cancelScheduledDeleteProject(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*cancelScheduledDeleteProjectResponse, error) {
+	req := &graphql.Request{
+		OpName: "cancelScheduledDeleteProject",
+		Query: `
+mutation cancelScheduledDeleteProject ($id: String!) {
+	projectScheduleDeleteCancel(id: $id)
+}
+`,
+		Variables: &__cancelScheduledDeleteProjectInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data cancelScheduledDeleteProjectResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func checkServiceDomainAvailable(
+	ctx context.Context,
+	client graphql.Client,
+	domain string,
+) (*checkServiceDomainAvailableResponse, error) {
+	req := &graphql.Request{
+		OpName: "checkServiceDomainAvailable",
+		Query: `
+query checkServiceDomainAvailable ($domain: String!) {
+	serviceDomainAvailable(domain: $domain) {
+		available
+		message
+	}
+}
+`,
+		Variables: &__checkServiceDomainAvailableInput{
+			Domain: domain,
+		},
+	}
+	var err error
+
+	var data checkServiceDomainAvailableResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func clearEgressGateways(
+	ctx context.Context,
+	client graphql.Client,
+	input EgressGatewayServiceTargetInput,
+) (*clearEgressGatewaysResponse, error) {
+	req := &graphql.Request{
+		OpName: "clearEgressGateways",
+		Query: `
+mutation clearEgressGateways ($input: EgressGatewayServiceTargetInput!) {
+	egressGatewayAssociationsClear(input: $input)
+}
+`,
+		Variables: &__clearEgressGatewaysInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data clearEgressGatewaysResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func commitStagedChanges(
+	ctx context.Context,
+	client graphql.Client,
+	environmentId string,
+	commitMessage string,
+	skipDeploys bool,
+) (*commitStagedChangesResponse, error) {
+	req := &graphql.Request{
+		OpName: "commitStagedChanges",
+		Query: `
+mutation commitStagedChanges ($environmentId: String!, $commitMessage: String!, $skipDeploys: Boolean!) {
+	environmentPatchCommitStaged(environmentId: $environmentId, commitMessage: $commitMessage, skipDeploys: $skipDeploys)
+}
+`,
+		Variables: &__commitStagedChangesInput{
+			EnvironmentId: environmentId,
+			CommitMessage: commitMessage,
+			SkipDeploys:   skipDeploys,
+		},
+	}
+	var err error
+
+	var data commitStagedChangesResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func configureSharedVariable(
+	ctx context.Context,
+	client graphql.Client,
+	input SharedVariableConfigureInput,
+) (*configureSharedVariableResponse, error) {
+	req := &graphql.Request{
+		OpName: "configureSharedVariable",
+		Query: `
+mutation configureSharedVariable ($input: SharedVariableConfigureInput!) {
+	sharedVariableConfigure(input: $input) {
+		id
+	}
+}
+`,
+		Variables: &__configureSharedVariableInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data configureSharedVariableResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func connectService(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+	input ServiceConnectInput,
+) (*connectServiceResponse, error) {
+	req := &graphql.Request{
+		OpName: "connectService",
+		Query: `
+mutation connectService ($id: String!, $input: ServiceConnectInput!) {
+	serviceConnect(id: $id, input: $input) {
+		... Service
+	}
+}
+fragment Service on Service {
+	id
+	name
+	projectId
+}
+`,
+		Variables: &__connectServiceInput{
+			Id:    id,
+			Input: input,
+		},
+	}
+	var err error
+
+	var data connectServiceResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func createApiToken(
+	ctx context.Context,
+	client graphql.Client,
+	input ApiTokenCreateInput,
+) (*createApiTokenResponse, error) {
+	req := &graphql.Request{
+		OpName: "createApiToken",
+		Query: `
+mutation createApiToken ($input: ApiTokenCreateInput!) {
+	apiTokenCreate(input: $input)
+}
+`,
+		Variables: &__createApiTokenInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data createApiTokenResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func createBucket(
+	ctx context.Context,
+	client graphql.Client,
+	input BucketCreateInput,
+) (*createBucketResponse, error) {
+	req := &graphql.Request{
+		OpName: "createBucket",
+		Query: `
+mutation createBucket ($input: BucketCreateInput!) {
+	bucketCreate(input: $input) {
+		... Bucket
+	}
+}
+fragment Bucket on Bucket {
+	id
+	name
+	projectId
+}
+`,
+		Variables: &__createBucketInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data createBucketResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func createCustomDomain(
+	ctx context.Context,
+	client graphql.Client,
+	input CustomDomainCreateInput,
+) (*createCustomDomainResponse, error) {
+	req := &graphql.Request{
+		OpName: "createCustomDomain",
+		Query: `
+mutation createCustomDomain ($input: CustomDomainCreateInput!) {
+	customDomainCreate(input: $input) {
+		... CustomDomain
+	}
+}
+fragment CustomDomain on CustomDomain {
+	id
+	domain
+	targetPort
+	status {
+		dnsRecords {
+			hostlabel
+			fqdn
+			recordType
+			requiredValue
+			currentValue
+			status
+			zone
+		}
+		verificationDnsHost
+		verificationToken
+		verified
+		certificateStatus
+		certificateErrorMessage
+		certificates {
+			expiresAt
+		}
+	}
+	environmentId
+	serviceId
+}
+`,
+		Variables: &__createCustomDomainInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data createCustomDomainResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func createEgressGateway(
+	ctx context.Context,
+	client graphql.Client,
+	input EgressGatewayCreateInput,
+) (*createEgressGatewayResponse, error) {
+	req := &graphql.Request{
+		OpName: "createEgressGateway",
+		Query: `
+mutation createEgressGateway ($input: EgressGatewayCreateInput!) {
+	egressGatewayAssociationCreate(input: $input) {
+		... EgressGateway
+	}
+}
+fragment EgressGateway on EgressGateway {
+	ipv4
+	region
+}
+`,
+		Variables: &__createEgressGatewayInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data createEgressGatewayResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func createEnvironment(
+	ctx context.Context,
+	client graphql.Client,
+	input EnvironmentCreateInput,
+) (*createEnvironmentResponse, error) {
+	req := &graphql.Request{
+		OpName: "createEnvironment",
+		Query: `
+mutation createEnvironment ($input: EnvironmentCreateInput!) {
+	environmentCreate(input: $input) {
+		... Environment
+	}
+}
+fragment Environment on Environment {
+	id
+	name
+	projectId
+}
+`,
+		Variables: &__createEnvironmentInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data createEnvironmentResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func createIntegration(
+	ctx context.Context,
+	client graphql.Client,
+	input IntegrationCreateInput,
+) (*createIntegrationResponse, error) {
+	req := &graphql.Request{
+		OpName: "createIntegration",
+		Query: `
+mutation createIntegration ($input: IntegrationCreateInput!) {
+	integrationCreate(input: $input) {
+		... Integration
+	}
+}
+fragment Integration on Integration {
+	id
+	name
+	projectId
+	config
+}
+`,
+		Variables: &__createIntegrationInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data createIntegrationResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func createObservabilityDashboard(
+	ctx context.Context,
+	client graphql.Client,
+	input ObservabilityDashboardCreateInput,
+) (*createObservabilityDashboardResponse, error) {
+	req := &graphql.Request{
+		OpName: "createObservabilityDashboard",
+		Query: `
+mutation createObservabilityDashboard ($input: ObservabilityDashboardCreateInput!) {
+	observabilityDashboardCreate(input: $input)
+}
+`,
+		Variables: &__createObservabilityDashboardInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data createObservabilityDashboardResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func createOrGetPrivateNetwork(
+	ctx context.Context,
+	client graphql.Client,
+	input PrivateNetworkCreateOrGetInput,
+) (*createOrGetPrivateNetworkResponse, error) {
+	req := &graphql.Request{
+		OpName: "createOrGetPrivateNetwork",
+		Query: `
+mutation createOrGetPrivateNetwork ($input: PrivateNetworkCreateOrGetInput!) {
+	privateNetworkCreateOrGet(input: $input) {
+		publicId
+		name
+	}
+}
+`,
+		Variables: &__createOrGetPrivateNetworkInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data createOrGetPrivateNetworkResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func createOrGetPrivateNetworkEndpoint(
+	ctx context.Context,
+	client graphql.Client,
+	input PrivateNetworkEndpointCreateOrGetInput,
+) (*createOrGetPrivateNetworkEndpointResponse, error) {
+	req := &graphql.Request{
+		OpName: "createOrGetPrivateNetworkEndpoint",
+		Query: `
+mutation createOrGetPrivateNetworkEndpoint ($input: PrivateNetworkEndpointCreateOrGetInput!) {
+	privateNetworkEndpointCreateOrGet(input: $input) {
+		... PrivateNetworkEndpoint
+	}
+}
+fragment PrivateNetworkEndpoint on PrivateNetworkEndpoint {
+	publicId
+	dnsName
+	privateIps
+	serviceInstanceId
+	tags
+}
+`,
+		Variables: &__createOrGetPrivateNetworkEndpointInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data createOrGetPrivateNetworkEndpointResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func createProject(
+	ctx context.Context,
+	client graphql.Client,
+	input ProjectCreateInput,
+) (*createProjectResponse, error) {
+	req := &graphql.Request{
+		OpName: "createProject",
+		Query: `
+mutation createProject ($input: ProjectCreateInput!) {
+	projectCreate(input: $input) {
+		... Project
+	}
+}
+fragment Project on Project {
+	id
+	name
+	description
+	isPublic
+	prDeploys
+	deletedAt
+	workspace {
+		id
+	}
+	environments {
+		edges {
+			node {
+				id
+				name
+				createdAt
+			}
+		}
+	}
+}
+`,
+		Variables: &__createProjectInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data createProjectResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func createService(
+	ctx context.Context,
+	client graphql.Client,
+	input ServiceCreateInput,
+) (*createServiceResponse, error) {
+	req := &graphql.Request{
+		OpName: "createService",
+		Query: `
+mutation createService ($input: ServiceCreateInput!) {
+	serviceCreate(input: $input) {
+		... Service
+	}
+}
+fragment Service on Service {
+	id
+	name
+	projectId
+}
+`,
+		Variables: &__createServiceInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data createServiceResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func createServiceDomain(
+	ctx context.Context,
+	client graphql.Client,
+	input ServiceDomainCreateInput,
+) (*createServiceDomainResponse, error) {
+	req := &graphql.Request{
+		OpName: "createServiceDomain",
+		Query: `
+mutation createServiceDomain ($input: ServiceDomainCreateInput!) {
+	serviceDomainCreate(input: $input) {
+		... ServiceDomain
+	}
+}
+fragment ServiceDomain on ServiceDomain {
+	id
+	domain
+	suffix
+	targetPort
+	environmentId
+	serviceId
+}
+`,
+		Variables: &__createServiceDomainInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data createServiceDomainResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func createSshPublicKey(
+	ctx context.Context,
+	client graphql.Client,
+	input SshPublicKeyCreateInput,
+) (*createSshPublicKeyResponse, error) {
+	req := &graphql.Request{
+		OpName: "createSshPublicKey",
+		Query: `
+mutation createSshPublicKey ($input: SshPublicKeyCreateInput!) {
+	sshPublicKeyCreate(input: $input) {
+		... SshPublicKey
+	}
+}
+fragment SshPublicKey on SshPublicKey {
+	id
+	name
+	publicKey
+	fingerprint
+}
+`,
+		Variables: &__createSshPublicKeyInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data createSshPublicKeyResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func createTcpProxy(
+	ctx context.Context,
+	client graphql.Client,
+	input TCPProxyCreateInput,
+) (*createTcpProxyResponse, error) {
+	req := &graphql.Request{
+		OpName: "createTcpProxy",
+		Query: `
+mutation createTcpProxy ($input: TCPProxyCreateInput!) {
+	tcpProxyCreate(input: $input) {
+		... TCPProxy
+	}
+}
+fragment TCPProxy on TCPProxy {
+	id
+	applicationPort
+	proxyPort
+	domain
+	environmentId
+	serviceId
+}
+`,
+		Variables: &__createTcpProxyInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data createTcpProxyResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func createVolume(
+	ctx context.Context,
+	client graphql.Client,
+	input VolumeCreateInput,
+) (*createVolumeResponse, error) {
+	req := &graphql.Request{
+		OpName: "createVolume",
+		Query: `
+mutation createVolume ($input: VolumeCreateInput!) {
+	volumeCreate(input: $input) {
+		... Volume
+	}
+}
+fragment Volume on Volume {
+	id
+	name
+	volumeInstances {
+		edges {
+			node {
+				id
+				environmentId
+				serviceId
+				mountPath
+				sizeMB
+			}
+		}
+	}
+}
+`,
+		Variables: &__createVolumeInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data createVolumeResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deleteApiToken(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteApiTokenResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteApiToken",
+		Query: `
+mutation deleteApiToken ($id: String!) {
+	apiTokenDelete(id: $id)
+}
+`,
+		Variables: &__deleteApiTokenInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteApiTokenResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deleteCustomDomain(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteCustomDomainResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteCustomDomain",
+		Query: `
+mutation deleteCustomDomain ($id: String!) {
+	customDomainDelete(id: $id)
+}
+`,
+		Variables: &__deleteCustomDomainInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteCustomDomainResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deleteEnvironment(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteEnvironmentResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteEnvironment",
+		Query: `
+mutation deleteEnvironment ($id: String!) {
+	environmentDelete(id: $id)
+}
+`,
+		Variables: &__deleteEnvironmentInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteEnvironmentResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deleteIntegration(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteIntegrationResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteIntegration",
+		Query: `
+mutation deleteIntegration ($id: String!) {
+	integrationDelete(id: $id)
+}
+`,
+		Variables: &__deleteIntegrationInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteIntegrationResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deletePrivateNetworkEndpoint(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deletePrivateNetworkEndpointResponse, error) {
+	req := &graphql.Request{
+		OpName: "deletePrivateNetworkEndpoint",
+		Query: `
+mutation deletePrivateNetworkEndpoint ($id: String!) {
+	privateNetworkEndpointDelete(id: $id)
+}
+`,
+		Variables: &__deletePrivateNetworkEndpointInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deletePrivateNetworkEndpointResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deleteProject(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteProjectResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteProject",
+		Query: `
+mutation deleteProject ($id: String!) {
+	projectDelete(id: $id)
+}
+`,
+		Variables: &__deleteProjectInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteProjectResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deleteService(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteServiceResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteService",
+		Query: `
+mutation deleteService ($id: String!) {
+	serviceDelete(id: $id)
+}
+`,
+		Variables: &__deleteServiceInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteServiceResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deleteServiceDomain(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteServiceDomainResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteServiceDomain",
+		Query: `
+mutation deleteServiceDomain ($id: String!) {
+	serviceDomainDelete(id: $id)
+}
+`,
+		Variables: &__deleteServiceDomainInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteServiceDomainResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deleteSshPublicKey(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteSshPublicKeyResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteSshPublicKey",
+		Query: `
+mutation deleteSshPublicKey ($id: String!) {
+	sshPublicKeyDelete(id: $id)
+}
+`,
+		Variables: &__deleteSshPublicKeyInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteSshPublicKeyResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deleteTcpProxy(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteTcpProxyResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteTcpProxy",
+		Query: `
+mutation deleteTcpProxy ($id: String!) {
+	tcpProxyDelete(id: $id)
+}
+`,
+		Variables: &__deleteTcpProxyInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteTcpProxyResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deleteVariable(
+	ctx context.Context,
+	client graphql.Client,
+	input VariableDeleteInput,
+) (*deleteVariableResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteVariable",
+		Query: `
+mutation deleteVariable ($input: VariableDeleteInput!) {
+	variableDelete(input: $input)
+}
+`,
+		Variables: &__deleteVariableInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data deleteVariableResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deleteVolume(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteVolumeResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteVolume",
+		Query: `
+mutation deleteVolume ($id: String!) {
+	volumeDelete(volumeId: $id)
+}
+`,
+		Variables: &__deleteVolumeInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteVolumeResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deployServiceInstance(
+	ctx context.Context,
+	client graphql.Client,
+	environmentId string,
+	serviceId string,
+	commitSha *string,
+) (*deployServiceInstanceResponse, error) {
+	req := &graphql.Request{
+		OpName: "deployServiceInstance",
+		Query: `
+mutation deployServiceInstance ($environmentId: String!, $serviceId: String!, $commitSha: String) {
+	serviceInstanceDeployV2(environmentId: $environmentId, serviceId: $serviceId, commitSha: $commitSha)
+}
+`,
+		Variables: &__deployServiceInstanceInput{
+			EnvironmentId: environmentId,
+			ServiceId:     serviceId,
+			CommitSha:     commitSha,
+		},
+	}
+	var err error
+
+	var data deployServiceInstanceResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deployTemplate(
+	ctx context.Context,
+	client graphql.Client,
+	input TemplateDeployV2Input,
+) (*deployTemplateResponse, error) {
+	req := &graphql.Request{
+		OpName: "deployTemplate",
+		Query: `
+mutation deployTemplate ($input: TemplateDeployV2Input!) {
+	templateDeployV2(input: $input) {
+		projectId
+		workflowId
+	}
+}
+`,
+		Variables: &__deployTemplateInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data deployTemplateResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func disconnectService(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*disconnectServiceResponse, error) {
+	req := &graphql.Request{
+		OpName: "disconnectService",
+		Query: `
+mutation disconnectService ($id: String!) {
+	serviceDisconnect(id: $id) {
+		id
+	}
+}
+`,
+		Variables: &__disconnectServiceInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data disconnectServiceResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getBucketCredentials(
+	ctx context.Context,
+	client graphql.Client,
+	bucketId string,
+	environmentId string,
+	projectId string,
+) (*getBucketCredentialsResponse, error) {
+	req := &graphql.Request{
+		OpName: "getBucketCredentials",
+		Query: `
+query getBucketCredentials ($bucketId: String!, $environmentId: String!, $projectId: String!) {
+	bucketS3Credentials(bucketId: $bucketId, environmentId: $environmentId, projectId: $projectId) {
+		... BucketS3CompatibleCredentials
+	}
+}
+fragment BucketS3CompatibleCredentials on BucketS3CompatibleCredentials {
+	accessKeyId
+	secretAccessKey
+	bucketName
+	endpoint
+	region
+	urlStyle
+}
+`,
+		Variables: &__getBucketCredentialsInput{
+			BucketId:      bucketId,
+			EnvironmentId: environmentId,
+			ProjectId:     projectId,
+		},
+	}
+	var err error
+
+	var data getBucketCredentialsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getCustomDomain(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+	projectId string,
+) (*getCustomDomainResponse, error) {
+	req := &graphql.Request{
+		OpName: "getCustomDomain",
+		Query: `
+query getCustomDomain ($id: String!, $projectId: String!) {
+	customDomain(id: $id, projectId: $projectId) {
+		... CustomDomain
+	}
+}
+fragment CustomDomain on CustomDomain {
+	id
+	domain
+	targetPort
+	status {
+		dnsRecords {
+			hostlabel
+			fqdn
+			recordType
+			requiredValue
+			currentValue
+			status
+			zone
+		}
+		verificationDnsHost
+		verificationToken
+		verified
+		certificateStatus
+		certificateErrorMessage
+		certificates {
+			expiresAt
+		}
+	}
+	environmentId
+	serviceId
+}
+`,
+		Variables: &__getCustomDomainInput{
+			Id:        id,
+			ProjectId: projectId,
+		},
+	}
+	var err error
+
+	var data getCustomDomainResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getDeployment(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getDeploymentResponse, error) {
+	req := &graphql.Request{
+		OpName: "getDeployment",
+		Query: `
+query getDeployment ($id: String!) {
+	deployment(id: $id) {
+		... Deployment
+	}
+}
+fragment Deployment on Deployment {
+	id
+	status
+	url
+	staticUrl
+	canRollback
+	createdAt
+	snapshotId
+	meta
+	projectId
+	environmentId
+	serviceId
+}
+`,
+		Variables: &__getDeploymentInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getDeploymentResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getEnvironment(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getEnvironmentResponse, error) {
+	req := &graphql.Request{
+		OpName: "getEnvironment",
+		Query: `
+query getEnvironment ($id: String!) {
+	environment(id: $id) {
+		... Environment
+	}
+}
+fragment Environment on Environment {
+	id
+	name
+	projectId
+}
+`,
+		Variables: &__getEnvironmentInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getEnvironmentResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getEnvironments(
+	ctx context.Context,
+	client graphql.Client,
+	projectId string,
+) (*getEnvironmentsResponse, error) {
+	req := &graphql.Request{
+		OpName: "getEnvironments",
+		Query: `
+query getEnvironments ($projectId: String!) {
+	environments(projectId: $projectId) {
+		edges {
+			node {
+				... Environment
+			}
+		}
+	}
+}
+fragment Environment on Environment {
+	id
+	name
+	projectId
+}
+`,
+		Variables: &__getEnvironmentsInput{
+			ProjectId: projectId,
+		},
+	}
+	var err error
+
+	var data getEnvironmentsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getEstimatedUsage(
+	ctx context.Context,
+	client graphql.Client,
+	measurements []MetricMeasurement,
+	projectId *string,
+	workspaceId *string,
+) (*getEstimatedUsageResponse, error) {
+	req := &graphql.Request{
+		OpName: "getEstimatedUsage",
+		Query: `
+query getEstimatedUsage ($measurements: [MetricMeasurement!]!, $projectId: String, $workspaceId: String) {
+	estimatedUsage(measurements: $measurements, projectId: $projectId, workspaceId: $workspaceId) {
+		measurement
+		estimatedValue
+	}
+}
+`,
+		Variables: &__getEstimatedUsageInput{
+			Measurements: measurements,
+			ProjectId:    projectId,
+			WorkspaceId:  workspaceId,
+		},
+	}
+	var err error
+
+	var data getEstimatedUsageResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getPrivateNetworkEndpoint(
+	ctx context.Context,
+	client graphql.Client,
+	environmentId string,
+	privateNetworkId string,
+	serviceId string,
+) (*getPrivateNetworkEndpointResponse, error) {
+	req := &graphql.Request{
+		OpName: "getPrivateNetworkEndpoint",
+		Query: `
+query getPrivateNetworkEndpoint ($environmentId: String!, $privateNetworkId: String!, $serviceId: String!) {
+	privateNetworkEndpoint(environmentId: $environmentId, privateNetworkId: $privateNetworkId, serviceId: $serviceId) {
+		... PrivateNetworkEndpoint
+	}
+}
+fragment PrivateNetworkEndpoint on PrivateNetworkEndpoint {
+	publicId
+	dnsName
+	privateIps
+	serviceInstanceId
+	tags
+}
+`,
+		Variables: &__getPrivateNetworkEndpointInput{
+			EnvironmentId:    environmentId,
+			PrivateNetworkId: privateNetworkId,
+			ServiceId:        serviceId,
+		},
+	}
+	var err error
+
+	var data getPrivateNetworkEndpointResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getProject(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getProjectResponse, error) {
+	req := &graphql.Request{
+		OpName: "getProject",
+		Query: `
+query getProject ($id: String!) {
+	project(id: $id) {
+		... Project
+	}
+}
+fragment Project on Project {
+	id
+	name
+	description
+	isPublic
+	prDeploys
+	deletedAt
+	workspace {
+		id
+	}
+	environments {
+		edges {
+			node {
+				id
+				name
+				createdAt
+			}
+		}
+	}
+}
+`,
+		Variables: &__getProjectInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getProjectResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getService(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getServiceResponse, error) {
+	req := &graphql.Request{
+		OpName: "getService",
+		Query: `
+query getService ($id: String!) {
+	service(id: $id) {
+		... Service
+	}
+}
+fragment Service on Service {
+	id
+	name
+	projectId
+}
+`,
+		Variables: &__getServiceInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getServiceResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getServiceInstance(
+	ctx context.Context,
+	client graphql.Client,
+	environmentId string,
+	serviceId string,
+) (*getServiceInstanceResponse, error) {
+	req := &graphql.Request{
+		OpName: "getServiceInstance",
+		Query: `
+query getServiceInstance ($environmentId: String!, $serviceId: String!) {
+	serviceInstance(environmentId: $environmentId, serviceId: $serviceId) {
+		source {
+			image
+			repo
+		}
+		rootDirectory
+		railwayConfigFile
+		cronSchedule
+		nextCronRunAt
+		latestDeployment {
+			meta
+		}
+	}
+}
+`,
+		Variables: &__getServiceInstanceInput{
+			EnvironmentId: environmentId,
+			ServiceId:     serviceId,
+		},
+	}
+	var err error
+
+	var data getServiceInstanceResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getServiceInstances(
+	ctx context.Context,
+	client graphql.Client,
+	serviceId string,
+) (*getServiceInstancesResponse, error) {
+	req := &graphql.Request{
+		OpName: "getServiceInstances",
+		Query: `
+query getServiceInstances ($serviceId: String!) {
+	service(id: $serviceId) {
+		serviceInstances {
+			edges {
+				node {
+					id
+					environmentId
+				}
+			}
+		}
+	}
+}
+`,
+		Variables: &__getServiceInstancesInput{
+			ServiceId: serviceId,
+		},
+	}
+	var err error
+
+	var data getServiceInstancesResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getSharedVariables(
+	ctx context.Context,
+	client graphql.Client,
+	projectId string,
+	environmentId string,
+) (*getSharedVariablesResponse, error) {
+	req := &graphql.Request{
+		OpName: "getSharedVariables",
+		Query: `
+query getSharedVariables ($projectId: String!, $environmentId: String!) {
+	variables(environmentId: $environmentId, projectId: $projectId, unrendered: true)
+}
+`,
+		Variables: &__getSharedVariablesInput{
+			ProjectId:     projectId,
+			EnvironmentId: environmentId,
+		},
+	}
+	var err error
+
+	var data getSharedVariablesResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getStagedChanges(
+	ctx context.Context,
+	client graphql.Client,
+	environmentId string,
+) (*getStagedChangesResponse, error) {
+	req := &graphql.Request{
+		OpName: "getStagedChanges",
+		Query: `
+query getStagedChanges ($environmentId: String!) {
+	environmentStagedChanges(environmentId: $environmentId) {
+		id
+		patch(decryptVariables: true)
+	}
+}
+`,
+		Variables: &__getStagedChangesInput{
+			EnvironmentId: environmentId,
+		},
+	}
+	var err error
+
+	var data getStagedChangesResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getTcpProxy(
+	ctx context.Context,
+	client graphql.Client,
+	environmentId string,
+	serviceId string,
+) (*getTcpProxyResponse, error) {
+	req := &graphql.Request{
+		OpName: "getTcpProxy",
+		Query: `
+query getTcpProxy ($environmentId: String!, $serviceId: String!) {
+	tcpProxies(environmentId: $environmentId, serviceId: $serviceId) {
+		... TCPProxy
+	}
+}
+fragment TCPProxy on TCPProxy {
+	id
+	applicationPort
+	proxyPort
+	domain
+	environmentId
+	serviceId
+}
+`,
+		Variables: &__getTcpProxyInput{
+			EnvironmentId: environmentId,
+			ServiceId:     serviceId,
+		},
+	}
+	var err error
+
+	var data getTcpProxyResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getTemplate(
+	ctx context.Context,
+	client graphql.Client,
+	code string,
+) (*getTemplateResponse, error) {
+	req := &graphql.Request{
+		OpName: "getTemplate",
+		Query: `
+query getTemplate ($code: String!) {
+	template(code: $code) {
+		id
+		code
+		serializedConfig
+	}
+}
+`,
+		Variables: &__getTemplateInput{
+			Code: code,
+		},
+	}
+	var err error
+
+	var data getTemplateResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getUsage(
+	ctx context.Context,
+	client graphql.Client,
+	measurements []MetricMeasurement,
+	projectId *string,
+	workspaceId *string,
+) (*getUsageResponse, error) {
+	req := &graphql.Request{
+		OpName: "getUsage",
+		Query: `
+query getUsage ($measurements: [MetricMeasurement!]!, $projectId: String, $workspaceId: String) {
+	usage(measurements: $measurements, projectId: $projectId, workspaceId: $workspaceId) {
+		measurement
+		value
+	}
+}
+`,
+		Variables: &__getUsageInput{
+			Measurements: measurements,
+			ProjectId:    projectId,
+			WorkspaceId:  workspaceId,
+		},
+	}
+	var err error
+
+	var data getUsageResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getVariables(
+	ctx context.Context,
+	client graphql.Client,
+	projectId string,
+	environmentId string,
+	serviceId string,
+) (*getVariablesResponse, error) {
+	req := &graphql.Request{
+		OpName: "getVariables",
+		Query: `
+query getVariables ($projectId: String!, $environmentId: String!, $serviceId: String!) {
+	variables(environmentId: $environmentId, projectId: $projectId, serviceId: $serviceId, unrendered: true)
+}
+`,
+		Variables: &__getVariablesInput{
+			ProjectId:     projectId,
+			EnvironmentId: environmentId,
+			ServiceId:     serviceId,
+		},
+	}
+	var err error
+
+	var data getVariablesResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getVolumeInstances(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getVolumeInstancesResponse, error) {
+	req := &graphql.Request{
+		OpName: "getVolumeInstances",
+		Query: `
+query getVolumeInstances ($id: String!) {
+	project(id: $id) {
+		volumes {
+			edges {
+				node {
+					... Volume
+				}
+			}
+		}
+	}
+}
+fragment Volume on Volume {
+	id
+	name
+	volumeInstances {
+		edges {
+			node {
+				id
+				environmentId
+				serviceId
+				mountPath
+				sizeMB
+			}
+		}
+	}
+}
+`,
+		Variables: &__getVolumeInstancesInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getVolumeInstancesResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getWorkflowStatus(
+	ctx context.Context,
+	client graphql.Client,
+	workflowId string,
+) (*getWorkflowStatusResponse, error) {
+	req := &graphql.Request{
+		OpName: "getWorkflowStatus",
+		Query: `
+query getWorkflowStatus ($workflowId: String!) {
+	workflowStatus(workflowId: $workflowId) {
+		status
+		error
+	}
+}
+`,
+		Variables: &__getWorkflowStatusInput{
+			WorkflowId: workflowId,
+		},
+	}
+	var err error
+
+	var data getWorkflowStatusResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
 }
 
-// GetVariableUpsert returns upsertVariableResponse.VariableUpsert, and is useful for accessing the field via an interface.
-func (v *upsertVariableResponse) GetVariableUpsert() bool { return v.VariableUpsert }
-
-func connectService(
+func getWorkspaceCurrentUsage(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-	input ServiceConnectInput,
-) (*connectServiceResponse, error) {
+	workspaceId string,
+) (*getWorkspaceCurrentUsageResponse, error) {
 	req := &graphql.Request{
-		OpName: "connectService",
+		OpName: "getWorkspaceCurrentUsage",
 		Query: `
-mutation connectService ($id: String!, $input: ServiceConnectInput!) {
-	serviceConnect(id: $id, input: $input) {
-		... Service
+query getWorkspaceCurrentUsage ($workspaceId: String!) {
+	workspace(workspaceId: $workspaceId) {
+		customer {
+			currentUsage
+			billingPeriod {
+				start
+				end
+			}
+		}
 	}
 }
-fragment Service on Service {
-	id
-	name
-	projectId
-}
 `,
-		Variables: &__connectServiceInput{
-			Id:    id,
-			Input: input,
+		Variables: &__getWorkspaceCurrentUsageInput{
+			WorkspaceId: workspaceId,
 		},
 	}
 	var err error
 
-	var data connectServiceResponse
+	var data getWorkspaceCurrentUsageResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -3392,43 +9616,33 @@ fragment Service on Service {
 	return &data, err
 }
 
-func createCustomDomain(
+func getWorkspaceUsageLimit(
 	ctx context.Context,
 	client graphql.Client,
-	input CustomDomainCreateInput,
-) (*createCustomDomainResponse, error) {
+	workspaceId string,
+) (*getWorkspaceUsageLimitResponse, error) {
 	req := &graphql.Request{
-		OpName: "createCustomDomain",
+		OpName: "getWorkspaceUsageLimit",
 		Query: `
-mutation createCustomDomain ($input: CustomDomainCreateInput!) {
-	customDomainCreate(input: $input) {
-		... CustomDomain
-	}
-}
-fragment CustomDomain on CustomDomain {
-	id
-	domain
-	targetPort
-	status {
-		dnsRecords {
-			hostlabel
-			requiredValue
-			zone
+query getWorkspaceUsageLimit ($workspaceId: String!) {
+	workspace(workspaceId: $workspaceId) {
+		customer {
+			id
+			usageLimit {
+				softLimit
+				hardLimit
+			}
 		}
-		verificationDnsHost
-		verificationToken
 	}
-	environmentId
-	serviceId
 }
 `,
-		Variables: &__createCustomDomainInput{
-			Input: input,
+		Variables: &__getWorkspaceUsageLimitInput{
+			WorkspaceId: workspaceId,
 		},
 	}
 	var err error
 
-	var data createCustomDomainResponse
+	var data getWorkspaceUsageLimitResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -3440,32 +9654,34 @@ fragment CustomDomain on CustomDomain {
 	return &data, err
 }
 
-func createEnvironment(
+func importDockerCompose(
 	ctx context.Context,
 	client graphql.Client,
-	input EnvironmentCreateInput,
-) (*createEnvironmentResponse, error) {
+	projectId string,
+	environmentId string,
+	yaml string,
+	skipStagingPatch bool,
+) (*importDockerComposeResponse, error) {
 	req := &graphql.Request{
-		OpName: "createEnvironment",
+		OpName: "importDockerCompose",
 		Query: `
-mutation createEnvironment ($input: EnvironmentCreateInput!) {
-	environmentCreate(input: $input) {
-		... Environment
+mutation importDockerCompose ($projectId: String!, $environmentId: String!, $yaml: String!, $skipStagingPatch: Boolean!) {
+	dockerComposeImport(projectId: $projectId, environmentId: $environmentId, yaml: $yaml, skipStagingPatch: $skipStagingPatch) {
+		errors
+		patch
 	}
 }
-fragment Environment on Environment {
-	id
-	name
-	projectId
-}
 `,
-		Variables: &__createEnvironmentInput{
-			Input: input,
+		Variables: &__importDockerComposeInput{
+			ProjectId:        projectId,
+			EnvironmentId:    environmentId,
+			Yaml:             yaml,
+			SkipStagingPatch: skipStagingPatch,
 		},
 	}
 	var err error
 
-	var data createEnvironmentResponse
+	var data importDockerComposeResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -3477,46 +9693,63 @@ fragment Environment on Environment {
 	return &data, err
 }
 
-func createProject(
+func importHerokuVariables(
 	ctx context.Context,
 	client graphql.Client,
-	input ProjectCreateInput,
-) (*createProjectResponse, error) {
+	input HerokuImportVariablesInput,
+) (*importHerokuVariablesResponse, error) {
 	req := &graphql.Request{
-		OpName: "createProject",
+		OpName: "importHerokuVariables",
 		Query: `
-mutation createProject ($input: ProjectCreateInput!) {
-	projectCreate(input: $input) {
-		... Project
-	}
+mutation importHerokuVariables ($input: HerokuImportVariablesInput!) {
+	herokuImportVariables(input: $input)
 }
-fragment Project on Project {
-	id
-	name
-	description
-	isPublic
-	prDeploys
-	workspace {
-		id
+`,
+		Variables: &__importHerokuVariablesInput{
+			Input: input,
+		},
 	}
-	environments {
+	var err error
+
+	var data importHerokuVariablesResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func listApiTokens(
+	ctx context.Context,
+	client graphql.Client,
+) (*listApiTokensResponse, error) {
+	req := &graphql.Request{
+		OpName: "listApiTokens",
+		Query: `
+query listApiTokens {
+	apiTokens {
 		edges {
 			node {
-				id
-				name
-				createdAt
+				... ApiToken
 			}
 		}
 	}
 }
+fragment ApiToken on ApiToken {
+	id
+	name
+	displayToken
+	workspaceId
+}
 `,
-		Variables: &__createProjectInput{
-			Input: input,
-		},
 	}
 	var err error
 
-	var data createProjectResponse
+	var data listApiTokensResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -3528,32 +9761,38 @@ fragment Project on Project {
 	return &data, err
 }
 
-func createService(
+func listBuckets(
 	ctx context.Context,
 	client graphql.Client,
-	input ServiceCreateInput,
-) (*createServiceResponse, error) {
+	projectId string,
+) (*listBucketsResponse, error) {
 	req := &graphql.Request{
-		OpName: "createService",
+		OpName: "listBuckets",
 		Query: `
-mutation createService ($input: ServiceCreateInput!) {
-	serviceCreate(input: $input) {
-		... Service
+query listBuckets ($projectId: String!) {
+	project(id: $projectId) {
+		buckets {
+			edges {
+				node {
+					... Bucket
+				}
+			}
+		}
 	}
 }
-fragment Service on Service {
+fragment Bucket on Bucket {
 	id
 	name
 	projectId
 }
 `,
-		Variables: &__createServiceInput{
-			Input: input,
+		Variables: &__listBucketsInput{
+			ProjectId: projectId,
 		},
 	}
 	var err error
 
-	var data createServiceResponse
+	var data listBucketsResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -3565,34 +9804,39 @@ fragment Service on Service {
 	return &data, err
 }
 
-func createServiceDomain(
+func listCronExecutions(
 	ctx context.Context,
 	client graphql.Client,
-	input ServiceDomainCreateInput,
-) (*createServiceDomainResponse, error) {
+	environmentId string,
+	serviceId string,
+	first int,
+) (*listCronExecutionsResponse, error) {
 	req := &graphql.Request{
-		OpName: "createServiceDomain",
+		OpName: "listCronExecutions",
 		Query: `
-mutation createServiceDomain ($input: ServiceDomainCreateInput!) {
-	serviceDomainCreate(input: $input) {
-		... ServiceDomain
+query listCronExecutions ($environmentId: String!, $serviceId: String!, $first: Int!) {
+	deploymentInstanceExecutions(first: $first, input: {environmentId:$environmentId,serviceId:$serviceId}) {
+		edges {
+			node {
+				id
+				deploymentId
+				status
+				createdAt
+				completedAt
+			}
+		}
 	}
 }
-fragment ServiceDomain on ServiceDomain {
-	id
-	domain
-	suffix
-	environmentId
-	serviceId
-}
 `,
-		Variables: &__createServiceDomainInput{
-			Input: input,
+		Variables: &__listCronExecutionsInput{
+			EnvironmentId: environmentId,
+			ServiceId:     serviceId,
+			First:         first,
 		},
 	}
 	var err error
 
-	var data createServiceDomainResponse
+	var data listCronExecutionsResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -3604,35 +9848,59 @@ fragment ServiceDomain on ServiceDomain {
 	return &data, err
 }
 
-func createTcpProxy(
+func listCustomDomains(
 	ctx context.Context,
 	client graphql.Client,
-	input TCPProxyCreateInput,
-) (*createTcpProxyResponse, error) {
+	environmentId string,
+	serviceId string,
+	projectId string,
+) (*listCustomDomainsResponse, error) {
 	req := &graphql.Request{
-		OpName: "createTcpProxy",
+		OpName: "listCustomDomains",
 		Query: `
-mutation createTcpProxy ($input: TCPProxyCreateInput!) {
-	tcpProxyCreate(input: $input) {
-		... TCPProxy
+query listCustomDomains ($environmentId: String!, $serviceId: String!, $projectId: String!) {
+	domains(environmentId: $environmentId, serviceId: $serviceId, projectId: $projectId) {
+		customDomains {
+			... CustomDomain
+		}
 	}
 }
-fragment TCPProxy on TCPProxy {
+fragment CustomDomain on CustomDomain {
 	id
-	applicationPort
-	proxyPort
 	domain
+	targetPort
+	status {
+		dnsRecords {
+			hostlabel
+			fqdn
+			recordType
+			requiredValue
+			currentValue
+			status
+			zone
+		}
+		verificationDnsHost
+		verificationToken
+		verified
+		certificateStatus
+		certificateErrorMessage
+		certificates {
+			expiresAt
+		}
+	}
 	environmentId
 	serviceId
 }
 `,
-		Variables: &__createTcpProxyInput{
-			Input: input,
+		Variables: &__listCustomDomainsInput{
+			EnvironmentId: environmentId,
+			ServiceId:     serviceId,
+			ProjectId:     projectId,
 		},
 	}
 	var err error
 
-	var data createTcpProxyResponse
+	var data listCustomDomainsResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -3644,42 +9912,36 @@ fragment TCPProxy on TCPProxy {
 	return &data, err
 }
 
-func createVolume(
+func listDeploymentTriggers(
 	ctx context.Context,
 	client graphql.Client,
-	input VolumeCreateInput,
-) (*createVolumeResponse, error) {
+	projectId string,
+	environmentId string,
+	serviceId string,
+) (*listDeploymentTriggersResponse, error) {
 	req := &graphql.Request{
-		OpName: "createVolume",
+		OpName: "listDeploymentTriggers",
 		Query: `
-mutation createVolume ($input: VolumeCreateInput!) {
-	volumeCreate(input: $input) {
-		... Volume
-	}
-}
-fragment Volume on Volume {
-	id
-	name
-	volumeInstances {
+query listDeploymentTriggers ($projectId: String!, $environmentId: String!, $serviceId: String!) {
+	deploymentTriggers(environmentId: $environmentId, projectId: $projectId, serviceId: $serviceId) {
 		edges {
 			node {
 				id
-				environmentId
-				serviceId
-				mountPath
-				sizeMB
+				branch
 			}
 		}
 	}
 }
 `,
-		Variables: &__createVolumeInput{
-			Input: input,
+		Variables: &__listDeploymentTriggersInput{
+			ProjectId:     projectId,
+			EnvironmentId: environmentId,
+			ServiceId:     serviceId,
 		},
 	}
 	var err error
 
-	var data createVolumeResponse
+	var data listDeploymentTriggersResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -3691,25 +9953,46 @@ fragment Volume on Volume {
 	return &data, err
 }
 
-func deleteCustomDomain(
+func listDeployments(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-) (*deleteCustomDomainResponse, error) {
+	input DeploymentListInput,
+	first int,
+) (*listDeploymentsResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteCustomDomain",
+		OpName: "listDeployments",
 		Query: `
-mutation deleteCustomDomain ($id: String!) {
-	customDomainDelete(id: $id)
+query listDeployments ($input: DeploymentListInput!, $first: Int!) {
+	deployments(first: $first, input: $input) {
+		edges {
+			node {
+				... Deployment
+			}
+		}
+	}
+}
+fragment Deployment on Deployment {
+	id
+	status
+	url
+	staticUrl
+	canRollback
+	createdAt
+	snapshotId
+	meta
+	projectId
+	environmentId
+	serviceId
 }
 `,
-		Variables: &__deleteCustomDomainInput{
-			Id: id,
+		Variables: &__listDeploymentsInput{
+			Input: input,
+			First: first,
 		},
 	}
 	var err error
 
-	var data deleteCustomDomainResponse
+	var data listDeploymentsResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -3721,25 +10004,33 @@ mutation deleteCustomDomain ($id: String!) {
 	return &data, err
 }
 
-func deleteEnvironment(
+func listEgressGateways(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-) (*deleteEnvironmentResponse, error) {
+	environmentId string,
+	serviceId string,
+) (*listEgressGatewaysResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteEnvironment",
+		OpName: "listEgressGateways",
 		Query: `
-mutation deleteEnvironment ($id: String!) {
-	environmentDelete(id: $id)
+query listEgressGateways ($environmentId: String!, $serviceId: String!) {
+	egressGateways(environmentId: $environmentId, serviceId: $serviceId) {
+		... EgressGateway
+	}
+}
+fragment EgressGateway on EgressGateway {
+	ipv4
+	region
 }
 `,
-		Variables: &__deleteEnvironmentInput{
-			Id: id,
+		Variables: &__listEgressGatewaysInput{
+			EnvironmentId: environmentId,
+			ServiceId:     serviceId,
 		},
 	}
 	var err error
 
-	var data deleteEnvironmentResponse
+	var data listEgressGatewaysResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -3751,25 +10042,40 @@ mutation deleteEnvironment ($id: String!) {
 	return &data, err
 }
 
-func deleteProject(
+func listIntegrationAuths(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-) (*deleteProjectResponse, error) {
+) (*listIntegrationAuthsResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteProject",
+		OpName: "listIntegrationAuths",
 		Query: `
-mutation deleteProject ($id: String!) {
-	projectDelete(id: $id)
+query listIntegrationAuths {
+	integrationAuths {
+		edges {
+			node {
+				id
+				integrations {
+					edges {
+						node {
+							... Integration
+						}
+					}
+				}
+			}
+		}
+	}
+}
+fragment Integration on Integration {
+	id
+	name
+	projectId
+	config
 }
 `,
-		Variables: &__deleteProjectInput{
-			Id: id,
-		},
 	}
 	var err error
 
-	var data deleteProjectResponse
+	var data listIntegrationAuthsResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -3781,25 +10087,37 @@ mutation deleteProject ($id: String!) {
 	return &data, err
 }
 
-func deleteService(
+func listIntegrations(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-) (*deleteServiceResponse, error) {
+	projectId string,
+) (*listIntegrationsResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteService",
+		OpName: "listIntegrations",
 		Query: `
-mutation deleteService ($id: String!) {
-	serviceDelete(id: $id)
+query listIntegrations ($projectId: String!) {
+	integrations(projectId: $projectId) {
+		edges {
+			node {
+				... Integration
+			}
+		}
+	}
+}
+fragment Integration on Integration {
+	id
+	name
+	projectId
+	config
 }
 `,
-		Variables: &__deleteServiceInput{
-			Id: id,
+		Variables: &__listIntegrationsInput{
+			ProjectId: projectId,
 		},
 	}
 	var err error
 
-	var data deleteServiceResponse
+	var data listIntegrationsResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -3811,25 +10129,50 @@ mutation deleteService ($id: String!) {
 	return &data, err
 }
 
-func deleteServiceDomain(
+func listObservabilityDashboards(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-) (*deleteServiceDomainResponse, error) {
+	environmentId string,
+) (*listObservabilityDashboardsResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteServiceDomain",
+		OpName: "listObservabilityDashboards",
 		Query: `
-mutation deleteServiceDomain ($id: String!) {
-	serviceDomainDelete(id: $id)
+query listObservabilityDashboards ($environmentId: String!) {
+	observabilityDashboards(environmentId: $environmentId) {
+		edges {
+			node {
+				... ObservabilityDashboard
+			}
+		}
+	}
+}
+fragment ObservabilityDashboard on ObservabilityDashboard {
+	id
+	items {
+		id
+		displayConfig
+		dashboardItem {
+			id
+			name
+			description
+			type
+			config {
+				logsFilter
+				measurements
+				projectUsageProperties
+				resourceIds
+			}
+		}
+	}
 }
 `,
-		Variables: &__deleteServiceDomainInput{
-			Id: id,
+		Variables: &__listObservabilityDashboardsInput{
+			EnvironmentId: environmentId,
 		},
 	}
 	var err error
 
-	var data deleteServiceDomainResponse
+	var data listObservabilityDashboardsResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -3841,25 +10184,28 @@ mutation deleteServiceDomain ($id: String!) {
 	return &data, err
 }
 
-func deleteTcpProxy(
+func listPrivateNetworks(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-) (*deleteTcpProxyResponse, error) {
+	environmentId string,
+) (*listPrivateNetworksResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteTcpProxy",
+		OpName: "listPrivateNetworks",
 		Query: `
-mutation deleteTcpProxy ($id: String!) {
-	tcpProxyDelete(id: $id)
+query listPrivateNetworks ($environmentId: String!) {
+	privateNetworks(environmentId: $environmentId) {
+		publicId
+		name
+	}
 }
 `,
-		Variables: &__deleteTcpProxyInput{
-			Id: id,
+		Variables: &__listPrivateNetworksInput{
+			EnvironmentId: environmentId,
 		},
 	}
 	var err error
 
-	var data deleteTcpProxyResponse
+	var data listPrivateNetworksResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -3871,25 +10217,36 @@ mutation deleteTcpProxy ($id: String!) {
 	return &data, err
 }
 
-func deleteVariable(
+func listProjectServices(
 	ctx context.Context,
 	client graphql.Client,
-	input VariableDeleteInput,
-) (*deleteVariableResponse, error) {
+	projectId string,
+) (*listProjectServicesResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteVariable",
+		OpName: "listProjectServices",
 		Query: `
-mutation deleteVariable ($input: VariableDeleteInput!) {
-	variableDelete(input: $input)
+query listProjectServices ($projectId: String!) {
+	project(id: $projectId) {
+		services {
+			edges {
+				node {
+					id
+					name
+					templateId
+					templateServiceId
+				}
+			}
+		}
+	}
 }
 `,
-		Variables: &__deleteVariableInput{
-			Input: input,
+		Variables: &__listProjectServicesInput{
+			ProjectId: projectId,
 		},
 	}
 	var err error
 
-	var data deleteVariableResponse
+	var data listProjectServicesResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -3901,25 +10258,35 @@ mutation deleteVariable ($input: VariableDeleteInput!) {
 	return &data, err
 }
 
-func deleteVolume(
+func listSealedVariables(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-) (*deleteVolumeResponse, error) {
+	environmentId string,
+) (*listSealedVariablesResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteVolume",
+		OpName: "listSealedVariables",
 		Query: `
-mutation deleteVolume ($id: String!) {
-	volumeDelete(volumeId: $id)
+query listSealedVariables ($environmentId: String!) {
+	environment(id: $environmentId) {
+		variables {
+			edges {
+				node {
+					name
+					serviceId
+					isSealed
+				}
+			}
+		}
+	}
 }
 `,
-		Variables: &__deleteVolumeInput{
-			Id: id,
+		Variables: &__listSealedVariablesInput{
+			EnvironmentId: environmentId,
 		},
 	}
 	var err error
 
-	var data deleteVolumeResponse
+	var data listSealedVariablesResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -3931,27 +10298,41 @@ mutation deleteVolume ($id: String!) {
 	return &data, err
 }
 
-func disconnectService(
+func listServiceDomains(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-) (*disconnectServiceResponse, error) {
+	environmentId string,
+	serviceId string,
+	projectId string,
+) (*listServiceDomainsResponse, error) {
 	req := &graphql.Request{
-		OpName: "disconnectService",
+		OpName: "listServiceDomains",
 		Query: `
-mutation disconnectService ($id: String!) {
-	serviceDisconnect(id: $id) {
-		id
+query listServiceDomains ($environmentId: String!, $serviceId: String!, $projectId: String!) {
+	domains(environmentId: $environmentId, serviceId: $serviceId, projectId: $projectId) {
+		serviceDomains {
+			... ServiceDomain
+		}
 	}
 }
+fragment ServiceDomain on ServiceDomain {
+	id
+	domain
+	suffix
+	targetPort
+	environmentId
+	serviceId
+}
 `,
-		Variables: &__disconnectServiceInput{
-			Id: id,
+		Variables: &__listServiceDomainsInput{
+			EnvironmentId: environmentId,
+			ServiceId:     serviceId,
+			ProjectId:     projectId,
 		},
 	}
 	var err error
 
-	var data disconnectServiceResponse
+	var data listServiceDomainsResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -3963,32 +10344,33 @@ mutation disconnectService ($id: String!) {
 	return &data, err
 }
 
-func getEnvironment(
+func listSshPublicKeys(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-) (*getEnvironmentResponse, error) {
+) (*listSshPublicKeysResponse, error) {
 	req := &graphql.Request{
-		OpName: "getEnvironment",
+		OpName: "listSshPublicKeys",
 		Query: `
-query getEnvironment ($id: String!) {
-	environment(id: $id) {
-		... Environment
+query listSshPublicKeys {
+	sshPublicKeys {
+		edges {
+			node {
+				... SshPublicKey
+			}
+		}
 	}
 }
-fragment Environment on Environment {
+fragment SshPublicKey on SshPublicKey {
 	id
 	name
-	projectId
+	publicKey
+	fingerprint
 }
 `,
-		Variables: &__getEnvironmentInput{
-			Id: id,
-		},
 	}
 	var err error
 
-	var data getEnvironmentResponse
+	var data listSshPublicKeysResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -4000,36 +10382,29 @@ fragment Environment on Environment {
 	return &data, err
 }
 
-func getEnvironments(
+func privateNetworkEndpointNameAvailable(
 	ctx context.Context,
 	client graphql.Client,
-	projectId string,
-) (*getEnvironmentsResponse, error) {
+	environmentId string,
+	privateNetworkId string,
+	prefix string,
+) (*privateNetworkEndpointNameAvailableResponse, error) {
 	req := &graphql.Request{
-		OpName: "getEnvironments",
+		OpName: "privateNetworkEndpointNameAvailable",
 		Query: `
-query getEnvironments ($projectId: String!) {
-	environments(projectId: $projectId) {
-		edges {
-			node {
-				... Environment
-			}
-		}
-	}
-}
-fragment Environment on Environment {
-	id
-	name
-	projectId
+query privateNetworkEndpointNameAvailable ($environmentId: String!, $privateNetworkId: String!, $prefix: String!) {
+	privateNetworkEndpointNameAvailable(environmentId: $environmentId, privateNetworkId: $privateNetworkId, prefix: $prefix)
 }
 `,
-		Variables: &__getEnvironmentsInput{
-			ProjectId: projectId,
+		Variables: &__privateNetworkEndpointNameAvailableInput{
+			EnvironmentId:    environmentId,
+			PrivateNetworkId: privateNetworkId,
+			Prefix:           prefix,
 		},
 	}
 	var err error
 
-	var data getEnvironmentsResponse
+	var data privateNetworkEndpointNameAvailableResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -4041,46 +10416,27 @@ fragment Environment on Environment {
 	return &data, err
 }
 
-func getProject(
+func redeployServiceInstance(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-) (*getProjectResponse, error) {
+	environmentId string,
+	serviceId string,
+) (*redeployServiceInstanceResponse, error) {
 	req := &graphql.Request{
-		OpName: "getProject",
+		OpName: "redeployServiceInstance",
 		Query: `
-query getProject ($id: String!) {
-	project(id: $id) {
-		... Project
-	}
-}
-fragment Project on Project {
-	id
-	name
-	description
-	isPublic
-	prDeploys
-	workspace {
-		id
-	}
-	environments {
-		edges {
-			node {
-				id
-				name
-				createdAt
-			}
-		}
-	}
+mutation redeployServiceInstance ($environmentId: String!, $serviceId: String!) {
+	serviceInstanceRedeploy(environmentId: $environmentId, serviceId: $serviceId)
 }
 `,
-		Variables: &__getProjectInput{
-			Id: id,
+		Variables: &__redeployServiceInstanceInput{
+			EnvironmentId: environmentId,
+			ServiceId:     serviceId,
 		},
 	}
 	var err error
 
-	var data getProjectResponse
+	var data redeployServiceInstanceResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -4092,32 +10448,25 @@ fragment Project on Project {
 	return &data, err
 }
 
-func getService(
+func removeUsageLimit(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-) (*getServiceResponse, error) {
+	input UsageLimitRemoveInput,
+) (*removeUsageLimitResponse, error) {
 	req := &graphql.Request{
-		OpName: "getService",
+		OpName: "removeUsageLimit",
 		Query: `
-query getService ($id: String!) {
-	service(id: $id) {
-		... Service
-	}
-}
-fragment Service on Service {
-	id
-	name
-	projectId
+mutation removeUsageLimit ($input: UsageLimitRemoveInput!) {
+	usageLimitRemove(input: $input)
 }
 `,
-		Variables: &__getServiceInput{
-			Id: id,
+		Variables: &__removeUsageLimitInput{
+			Input: input,
 		},
 	}
 	var err error
 
-	var data getServiceResponse
+	var data removeUsageLimitResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -4129,38 +10478,29 @@ fragment Service on Service {
 	return &data, err
 }
 
-func getServiceInstance(
+func renamePrivateNetworkEndpoint(
 	ctx context.Context,
 	client graphql.Client,
-	environmentId string,
-	serviceId string,
-) (*getServiceInstanceResponse, error) {
+	id string,
+	privateNetworkId string,
+	dnsName string,
+) (*renamePrivateNetworkEndpointResponse, error) {
 	req := &graphql.Request{
-		OpName: "getServiceInstance",
+		OpName: "renamePrivateNetworkEndpoint",
 		Query: `
-query getServiceInstance ($environmentId: String!, $serviceId: String!) {
-	serviceInstance(environmentId: $environmentId, serviceId: $serviceId) {
-		source {
-			image
-			repo
-		}
-		rootDirectory
-		railwayConfigFile
-		cronSchedule
-		latestDeployment {
-			meta
-		}
-	}
+mutation renamePrivateNetworkEndpoint ($id: String!, $privateNetworkId: String!, $dnsName: String!) {
+	privateNetworkEndpointRename(id: $id, privateNetworkId: $privateNetworkId, dnsName: $dnsName)
 }
 `,
-		Variables: &__getServiceInstanceInput{
-			EnvironmentId: environmentId,
-			ServiceId:     serviceId,
+		Variables: &__renamePrivateNetworkEndpointInput{
+			Id:               id,
+			PrivateNetworkId: privateNetworkId,
+			DnsName:          dnsName,
 		},
 	}
 	var err error
 
-	var data getServiceInstanceResponse
+	var data renamePrivateNetworkEndpointResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -4172,34 +10512,39 @@ query getServiceInstance ($environmentId: String!, $serviceId: String!) {
 	return &data, err
 }
 
-func getServiceInstances(
+func resetBucketCredentials(
 	ctx context.Context,
 	client graphql.Client,
-	serviceId string,
-) (*getServiceInstancesResponse, error) {
+	bucketId string,
+	environmentId string,
+	projectId string,
+) (*resetBucketCredentialsResponse, error) {
 	req := &graphql.Request{
-		OpName: "getServiceInstances",
+		OpName: "resetBucketCredentials",
 		Query: `
-query getServiceInstances ($serviceId: String!) {
-	service(id: $serviceId) {
-		serviceInstances {
-			edges {
-				node {
-					id
-					environmentId
-				}
-			}
-		}
+mutation resetBucketCredentials ($bucketId: String!, $environmentId: String!, $projectId: String!) {
+	bucketCredentialsReset(bucketId: $bucketId, environmentId: $environmentId, projectId: $projectId) {
+		... BucketS3CompatibleCredentials
 	}
 }
+fragment BucketS3CompatibleCredentials on BucketS3CompatibleCredentials {
+	accessKeyId
+	secretAccessKey
+	bucketName
+	endpoint
+	region
+	urlStyle
+}
 `,
-		Variables: &__getServiceInstancesInput{
-			ServiceId: serviceId,
+		Variables: &__resetBucketCredentialsInput{
+			BucketId:      bucketId,
+			EnvironmentId: environmentId,
+			ProjectId:     projectId,
 		},
 	}
 	var err error
 
-	var data getServiceInstancesResponse
+	var data resetBucketCredentialsResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -4211,27 +10556,25 @@ query getServiceInstances ($serviceId: String!) {
 	return &data, err
 }
 
-func getSharedVariables(
+func resetObservabilityDashboard(
 	ctx context.Context,
 	client graphql.Client,
-	projectId string,
-	environmentId string,
-) (*getSharedVariablesResponse, error) {
+	id string,
+) (*resetObservabilityDashboardResponse, error) {
 	req := &graphql.Request{
-		OpName: "getSharedVariables",
+		OpName: "resetObservabilityDashboard",
 		Query: `
-query getSharedVariables ($projectId: String!, $environmentId: String!) {
-	variables(environmentId: $environmentId, projectId: $projectId, unrendered: true)
+mutation resetObservabilityDashboard ($id: String!) {
+	observabilityDashboardReset(id: $id)
 }
 `,
-		Variables: &__getSharedVariablesInput{
-			ProjectId:     projectId,
-			EnvironmentId: environmentId,
+		Variables: &__resetObservabilityDashboardInput{
+			Id: id,
 		},
 	}
 	var err error
 
-	var data getSharedVariablesResponse
+	var data resetObservabilityDashboardResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -4243,37 +10586,25 @@ query getSharedVariables ($projectId: String!, $environmentId: String!) {
 	return &data, err
 }
 
-func getTcpProxy(
+func rollbackDeployment(
 	ctx context.Context,
 	client graphql.Client,
-	environmentId string,
-	serviceId string,
-) (*getTcpProxyResponse, error) {
+	id string,
+) (*rollbackDeploymentResponse, error) {
 	req := &graphql.Request{
-		OpName: "getTcpProxy",
+		OpName: "rollbackDeployment",
 		Query: `
-query getTcpProxy ($environmentId: String!, $serviceId: String!) {
-	tcpProxies(environmentId: $environmentId, serviceId: $serviceId) {
-		... TCPProxy
-	}
-}
-fragment TCPProxy on TCPProxy {
-	id
-	applicationPort
-	proxyPort
-	domain
-	environmentId
-	serviceId
+mutation rollbackDeployment ($id: String!) {
+	deploymentRollback(id: $id)
 }
 `,
-		Variables: &__getTcpProxyInput{
-			EnvironmentId: environmentId,
-			ServiceId:     serviceId,
+		Variables: &__rollbackDeploymentInput{
+			Id: id,
 		},
 	}
 	var err error
 
-	var data getTcpProxyResponse
+	var data rollbackDeploymentResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -4285,29 +10616,25 @@ fragment TCPProxy on TCPProxy {
 	return &data, err
 }
 
-func getVariables(
+func scheduleDeleteProject(
 	ctx context.Context,
 	client graphql.Client,
-	projectId string,
-	environmentId string,
-	serviceId string,
-) (*getVariablesResponse, error) {
+	id string,
+) (*scheduleDeleteProjectResponse, error) {
 	req := &graphql.Request{
-		OpName: "getVariables",
+		OpName: "scheduleDeleteProject",
 		Query: `
-query getVariables ($projectId: String!, $environmentId: String!, $serviceId: String!) {
-	variables(environmentId: $environmentId, projectId: $projectId, serviceId: $serviceId, unrendered: true)
+mutation scheduleDeleteProject ($id: String!) {
+	projectScheduleDelete(id: $id)
 }
 `,
-		Variables: &__getVariablesInput{
-			ProjectId:     projectId,
-			EnvironmentId: environmentId,
-			ServiceId:     serviceId,
+		Variables: &__scheduleDeleteProjectInput{
+			Id: id,
 		},
 	}
 	var err error
 
-	var data getVariablesResponse
+	var data scheduleDeleteProjectResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -4319,48 +10646,25 @@ query getVariables ($projectId: String!, $environmentId: String!, $serviceId: St
 	return &data, err
 }
 
-func getVolumeInstances(
+func setUsageLimit(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-) (*getVolumeInstancesResponse, error) {
+	input UsageLimitSetInput,
+) (*setUsageLimitResponse, error) {
 	req := &graphql.Request{
-		OpName: "getVolumeInstances",
+		OpName: "setUsageLimit",
 		Query: `
-query getVolumeInstances ($id: String!) {
-	project(id: $id) {
-		volumes {
-			edges {
-				node {
-					... Volume
-				}
-			}
-		}
-	}
-}
-fragment Volume on Volume {
-	id
-	name
-	volumeInstances {
-		edges {
-			node {
-				id
-				environmentId
-				serviceId
-				mountPath
-				sizeMB
-			}
-		}
-	}
+mutation setUsageLimit ($input: UsageLimitSetInput!) {
+	usageLimitSet(input: $input)
 }
 `,
-		Variables: &__getVolumeInstancesInput{
-			Id: id,
+		Variables: &__setUsageLimitInput{
+			Input: input,
 		},
 	}
 	var err error
 
-	var data getVolumeInstancesResponse
+	var data setUsageLimitResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -4372,49 +10676,31 @@ fragment Volume on Volume {
 	return &data, err
 }
 
-func listCustomDomains(
+func stageEnvironmentChanges(
 	ctx context.Context,
 	client graphql.Client,
 	environmentId string,
-	serviceId string,
-	projectId string,
-) (*listCustomDomainsResponse, error) {
+	input map[string]interface{},
+	merge bool,
+) (*stageEnvironmentChangesResponse, error) {
 	req := &graphql.Request{
-		OpName: "listCustomDomains",
+		OpName: "stageEnvironmentChanges",
 		Query: `
-query listCustomDomains ($environmentId: String!, $serviceId: String!, $projectId: String!) {
-	domains(environmentId: $environmentId, serviceId: $serviceId, projectId: $projectId) {
-		customDomains {
-			... CustomDomain
-		}
-	}
-}
-fragment CustomDomain on CustomDomain {
-	id
-	domain
-	targetPort
-	status {
-		dnsRecords {
-			hostlabel
-			requiredValue
-			zone
-		}
-		verificationDnsHost
-		verificationToken
+mutation stageEnvironmentChanges ($environmentId: String!, $input: EnvironmentConfig!, $merge: Boolean!) {
+	environmentStageChanges(environmentId: $environmentId, input: $input, merge: $merge) {
+		id
 	}
-	environmentId
-	serviceId
 }
 `,
-		Variables: &__listCustomDomainsInput{
+		Variables: &__stageEnvironmentChangesInput{
 			EnvironmentId: environmentId,
-			ServiceId:     serviceId,
-			ProjectId:     projectId,
+			Input:         input,
+			Merge:         merge,
 		},
 	}
 	var err error
 
-	var data listCustomDomainsResponse
+	var data stageEnvironmentChangesResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -4426,36 +10712,34 @@ fragment CustomDomain on CustomDomain {
 	return &data, err
 }
 
-func listDeploymentTriggers(
+func updateBucket(
 	ctx context.Context,
 	client graphql.Client,
-	projectId string,
-	environmentId string,
-	serviceId string,
-) (*listDeploymentTriggersResponse, error) {
+	id string,
+	input BucketUpdateInput,
+) (*updateBucketResponse, error) {
 	req := &graphql.Request{
-		OpName: "listDeploymentTriggers",
+		OpName: "updateBucket",
 		Query: `
-query listDeploymentTriggers ($projectId: String!, $environmentId: String!, $serviceId: String!) {
-	deploymentTriggers(environmentId: $environmentId, projectId: $projectId, serviceId: $serviceId) {
-		edges {
-			node {
-				id
-				branch
-			}
-		}
+mutation updateBucket ($id: String!, $input: BucketUpdateInput!) {
+	bucketUpdate(id: $id, input: $input) {
+		... Bucket
 	}
 }
+fragment Bucket on Bucket {
+	id
+	name
+	projectId
+}
 `,
-		Variables: &__listDeploymentTriggersInput{
-			ProjectId:     projectId,
-			EnvironmentId: environmentId,
-			ServiceId:     serviceId,
+		Variables: &__updateBucketInput{
+			Id:    id,
+			Input: input,
 		},
 	}
 	var err error
 
-	var data listDeploymentTriggersResponse
+	var data updateBucketResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -4467,40 +10751,29 @@ query listDeploymentTriggers ($projectId: String!, $environmentId: String!, $ser
 	return &data, err
 }
 
-func listServiceDomains(
+func updateCustomDomain(
 	ctx context.Context,
 	client graphql.Client,
 	environmentId string,
-	serviceId string,
-	projectId string,
-) (*listServiceDomainsResponse, error) {
+	id string,
+	targetPort *int,
+) (*updateCustomDomainResponse, error) {
 	req := &graphql.Request{
-		OpName: "listServiceDomains",
+		OpName: "updateCustomDomain",
 		Query: `
-query listServiceDomains ($environmentId: String!, $serviceId: String!, $projectId: String!) {
-	domains(environmentId: $environmentId, serviceId: $serviceId, projectId: $projectId) {
-		serviceDomains {
-			... ServiceDomain
-		}
-	}
-}
-fragment ServiceDomain on ServiceDomain {
-	id
-	domain
-	suffix
-	environmentId
-	serviceId
+mutation updateCustomDomain ($environmentId: String!, $id: String!, $targetPort: Int) {
+	customDomainUpdate(environmentId: $environmentId, id: $id, targetPort: $targetPort)
 }
 `,
-		Variables: &__listServiceDomainsInput{
+		Variables: &__updateCustomDomainInput{
 			EnvironmentId: environmentId,
-			ServiceId:     serviceId,
-			ProjectId:     projectId,
+			Id:            id,
+			TargetPort:    targetPort,
 		},
 	}
 	var err error
 
-	var data listServiceDomainsResponse
+	var data updateCustomDomainResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -4512,27 +10785,35 @@ fragment ServiceDomain on ServiceDomain {
 	return &data, err
 }
 
-func redeployServiceInstance(
+func updateIntegration(
 	ctx context.Context,
 	client graphql.Client,
-	environmentId string,
-	serviceId string,
-) (*redeployServiceInstanceResponse, error) {
+	id string,
+	input IntegrationUpdateInput,
+) (*updateIntegrationResponse, error) {
 	req := &graphql.Request{
-		OpName: "redeployServiceInstance",
+		OpName: "updateIntegration",
 		Query: `
-mutation redeployServiceInstance ($environmentId: String!, $serviceId: String!) {
-	serviceInstanceRedeploy(environmentId: $environmentId, serviceId: $serviceId)
+mutation updateIntegration ($id: String!, $input: IntegrationUpdateInput!) {
+	integrationUpdate(id: $id, input: $input) {
+		... Integration
+	}
+}
+fragment Integration on Integration {
+	id
+	name
+	projectId
+	config
 }
 `,
-		Variables: &__redeployServiceInstanceInput{
-			EnvironmentId: environmentId,
-			ServiceId:     serviceId,
+		Variables: &__updateIntegrationInput{
+			Id:    id,
+			Input: input,
 		},
 	}
 	var err error
 
-	var data redeployServiceInstanceResponse
+	var data updateIntegrationResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -4544,29 +10825,27 @@ mutation redeployServiceInstance ($environmentId: String!, $serviceId: String!)
 	return &data, err
 }
 
-func updateCustomDomain(
+func updateObservabilityDashboard(
 	ctx context.Context,
 	client graphql.Client,
-	environmentId string,
 	id string,
-	targetPort *int,
-) (*updateCustomDomainResponse, error) {
+	input []ObservabilityDashboardUpdateInput,
+) (*updateObservabilityDashboardResponse, error) {
 	req := &graphql.Request{
-		OpName: "updateCustomDomain",
+		OpName: "updateObservabilityDashboard",
 		Query: `
-mutation updateCustomDomain ($environmentId: String!, $id: String!, $targetPort: Int) {
-	customDomainUpdate(environmentId: $environmentId, id: $id, targetPort: $targetPort)
+mutation updateObservabilityDashboard ($id: String!, $input: [ObservabilityDashboardUpdateInput!]!) {
+	observabilityDashboardUpdate(id: $id, input: $input)
 }
 `,
-		Variables: &__updateCustomDomainInput{
-			EnvironmentId: environmentId,
-			Id:            id,
-			TargetPort:    targetPort,
+		Variables: &__updateObservabilityDashboardInput{
+			Id:    id,
+			Input: input,
 		},
 	}
 	var err error
 
-	var data updateCustomDomainResponse
+	var data updateObservabilityDashboardResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -4598,6 +10877,7 @@ fragment Project on Project {
 	description
 	isPublic
 	prDeploys
+	deletedAt
 	workspace {
 		id
 	}
@@ -24,6 +24,10 @@ func uuidRegex() *regexp.Regexp {
 	return regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
 }
 
+func commitShaRegex() *regexp.Regexp {
+	return regexp.MustCompile("^[0-9a-f]{40}$")
+}
+
 var _ provider.Provider = &RailwayProvider{}
 
 type RailwayProvider struct {
@@ -104,11 +108,30 @@ func (p *RailwayProvider) Resources(ctx context.Context) []func() resource.Resou
 		NewCustomDomainResource,
 		NewServiceDomainResource,
 		NewTcpProxyResource,
+		NewPrivateNetworkEndpointResource,
+		NewEgressGatewayResource,
+		NewBucketResource,
+		NewTemplateDeploymentResource,
+		NewDockerComposeResource,
+		NewVariablesResource,
+		NewVariableImportResource,
+		NewDeploymentResource,
+		NewDeploymentRollbackResource,
+		NewUsageLimitResource,
+		NewObservabilityDashboardResource,
+		NewSshPublicKeyResource,
+		NewApiTokenResource,
+		NewIntegrationResource,
 	}
 }
 
 func (p *RailwayProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		NewDeploymentDataSource,
+		NewDeploymentsDataSource,
+		NewCronExecutionsDataSource,
+		NewUsageDataSource,
+	}
 }
 
 func New(version string) func() provider.Provider {
@@ -0,0 +1,315 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &ApiTokenResource{}
+var _ resource.ResourceWithImportState = &ApiTokenResource{}
+
+func NewApiTokenResource() resource.Resource {
+	return &ApiTokenResource{}
+}
+
+type ApiTokenResource struct {
+	client *graphql.Client
+}
+
+type ApiTokenResourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	WorkspaceId     types.String `tfsdk:"workspace_id"`
+	RotationTrigger types.String `tfsdk:"rotation_trigger"`
+	Token           types.String `tfsdk:"token"`
+	DisplayToken    types.String `tfsdk:"display_token"`
+}
+
+func (r *ApiTokenResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_token"
+}
+
+func (r *ApiTokenResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Railway API token. An account token of the current user, or a workspace token when scoped to a workspace.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the API token.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the API token.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"workspace_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the workspace the API token is scoped to. The token has access to all the workspaces of the user when not given.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an id"),
+				},
+			},
+			"rotation_trigger": schema.StringAttribute{
+				MarkdownDescription: "Arbitrary value which replaces the API token with a new one whenever it changes.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"token": schema.StringAttribute{
+				MarkdownDescription: "Value of the API token. Only available when the token is created by terraform.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"display_token": schema.StringAttribute{
+				MarkdownDescription: "Masked value of the API token shown in the Railway dashboard.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ApiTokenResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ApiTokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *ApiTokenResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := ApiTokenCreateInput{
+		Name:        data.Name.ValueString(),
+		WorkspaceId: data.WorkspaceId.ValueStringPointer(),
+	}
+
+	response, err := createApiToken(ctx, *r.client, input)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create API token, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created an API token")
+
+	data.Token = types.StringValue(response.ApiTokenCreate)
+
+	// Only the value of the token is returned, so the new token is the one whose masked value matches it
+	tokens, err := getApiTokens(ctx, *r.client)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read API tokens after creating token %s, delete it in the Railway dashboard, got error: %s", input.Name, err))
+		return
+	}
+
+	matches := make([]ApiToken, 0, 1)
+
+	for _, candidate := range tokens {
+		if candidate.Name == input.Name && matchesDisplayToken(candidate.DisplayToken, response.ApiTokenCreate) {
+			matches = append(matches, candidate)
+		}
+	}
+
+	if len(matches) != 1 {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read API token %s after creating it, delete it in the Railway dashboard, got error: expected exactly one matching token, got %d", input.Name, len(matches)))
+		return
+	}
+
+	token := &matches[0]
+
+	buildApiToken(token, data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ApiTokenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *ApiTokenResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tokens, err := getApiTokens(ctx, *r.client)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read API token, got error: %s", err))
+		return
+	}
+
+	token, ok := tokens[data.Id.ValueString()]
+
+	// The token was deleted outside of terraform, so it needs to be created again
+	if !ok {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	buildApiToken(&token, data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ApiTokenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *ApiTokenResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ApiTokenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *ApiTokenResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := deleteApiToken(ctx, *r.client, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete API token, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted an API token")
+}
+
+func (r *ApiTokenResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func getApiTokens(ctx context.Context, client graphql.Client) (map[string]ApiToken, error) {
+	response, err := listApiTokens(ctx, client)
+
+	if err != nil {
+		return nil, err
+	}
+
+	tokens := make(map[string]ApiToken, len(response.ApiTokens.Edges))
+
+	for _, edge := range response.ApiTokens.Edges {
+		tokens[edge.Node.Id] = edge.Node.ApiToken
+	}
+
+	return tokens, nil
+}
+
+func buildApiToken(token *ApiToken, data *ApiTokenResourceModel) {
+	data.Id = types.StringValue(token.Id)
+	data.Name = types.StringValue(token.Name)
+	data.DisplayToken = types.StringValue(token.DisplayToken)
+	data.WorkspaceId = types.StringNull()
+
+	if token.WorkspaceId != "" {
+		data.WorkspaceId = types.StringValue(token.WorkspaceId)
+	}
+
+	// The value of the token can not be read after it is created
+	if data.Token.IsUnknown() {
+		data.Token = types.StringNull()
+	}
+}
+
+// matchesDisplayToken returns whether the masked value of a token, as shown in the Railway dashboard,
+// matches the given value. The visible parts of the masked value need to appear in the value in order.
+func matchesDisplayToken(displayToken string, value string) bool {
+	if displayToken == value {
+		return true
+	}
+
+	isMask := func(r rune) bool {
+		return r == '*' || r == '•' || r == '…' || r == '.'
+	}
+
+	parts := strings.FieldsFunc(displayToken, isMask)
+
+	if len(parts) == 0 || !strings.ContainsFunc(displayToken, isMask) {
+		return false
+	}
+
+	// Visible parts at the ends of the masked value are at the ends of the value as well
+	if !strings.HasPrefix(displayToken, parts[0]) {
+		parts = append([]string{""}, parts...)
+	}
+
+	if !strings.HasSuffix(displayToken, parts[len(parts)-1]) {
+		parts = append(parts, "")
+	}
+
+	first, last := parts[0], parts[len(parts)-1]
+
+	if len(value) < len(first)+len(last) || !strings.HasPrefix(value, first) || !strings.HasSuffix(value, last) {
+		return false
+	}
+
+	rest := value[len(first) : len(value)-len(last)]
+
+	for _, part := range parts[1 : len(parts)-1] {
+		index := strings.Index(rest, part)
+
+		if index < 0 {
+			return false
+		}
+
+		rest = rest[index+len(part):]
+	}
+
+	return true
+}
@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestMatchesDisplayToken(t *testing.T) {
+	value := "8f7c2a1e-4b3d-4e6f-9a0b-1c2d3e4f5a6b"
+
+	tests := []struct {
+		displayToken string
+		matches      bool
+	}{
+		{"8f7c2a1e-4b3d-4e6f-9a0b-1c2d3e4f5a6b", true},
+		{"8f7c****", true},
+		{"****5a6b", true},
+		{"8f7c****5a6b", true},
+		{"8f7c...5a6b", true},
+		{"8f7c•••4b3d•••5a6b", true},
+		{"****4b3d****", true},
+		{"9f7c****5a6b", false},
+		{"8f7c****5a6c", false},
+		{"8f7c****4b3d", false},
+		{"****", false},
+		{"8f7c2a1e", false},
+	}
+
+	for _, test := range tests {
+		if got := matchesDisplayToken(test.displayToken, value); got != test.matches {
+			t.Errorf("matchesDisplayToken(%q) = %t, want %t", test.displayToken, got, test.matches)
+		}
+	}
+}
+
+func TestAccApiTokenResourceDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccApiTokenResourceConfigDefault(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("railway_api_token.test", "id"),
+					resource.TestCheckResourceAttr("railway_api_token.test", "name", "terraform-tester"),
+					resource.TestCheckNoResourceAttr("railway_api_token.test", "workspace_id"),
+					resource.TestCheckResourceAttrSet("railway_api_token.test", "token"),
+					resource.TestCheckResourceAttrSet("railway_api_token.test", "display_token"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "railway_api_token.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"token"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccApiTokenResourceNonDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccApiTokenResourceConfigNonDefault("one"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("railway_api_token.test", "id"),
+					resource.TestCheckResourceAttr("railway_api_token.test", "name", "terraform-tester"),
+					resource.TestCheckResourceAttr("railway_api_token.test", "workspace_id", "ecb63be7-63fb-47fe-95fc-1585d24e172d"),
+					resource.TestCheckResourceAttr("railway_api_token.test", "rotation_trigger", "one"),
+					resource.TestCheckResourceAttrSet("railway_api_token.test", "token"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccApiTokenResourceConfigNonDefault("two"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("railway_api_token.test", "id"),
+					resource.TestCheckResourceAttr("railway_api_token.test", "workspace_id", "ecb63be7-63fb-47fe-95fc-1585d24e172d"),
+					resource.TestCheckResourceAttr("railway_api_token.test", "rotation_trigger", "two"),
+					resource.TestCheckResourceAttrSet("railway_api_token.test", "token"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccApiTokenResourceConfigDefault() string {
+	return `
+resource "railway_api_token" "test" {
+  name = "terraform-tester"
+}
+`
+}
+
+func testAccApiTokenResourceConfigNonDefault(trigger string) string {
+	return fmt.Sprintf(`
+resource "railway_api_token" "test" {
+  name = "terraform-tester"
+  workspace_id = "ecb63be7-63fb-47fe-95fc-1585d24e172d"
+  rotation_trigger = "%s"
+}
+`, trigger)
+}
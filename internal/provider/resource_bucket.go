@@ -0,0 +1,407 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &BucketResource{}
+var _ resource.ResourceWithImportState = &BucketResource{}
+var _ resource.ResourceWithModifyPlan = &BucketResource{}
+
+func NewBucketResource() resource.Resource {
+	return &BucketResource{}
+}
+
+type BucketResource struct {
+	client *graphql.Client
+}
+
+type BucketResourceModel struct {
+	Id                         types.String `tfsdk:"id"`
+	Name                       types.String `tfsdk:"name"`
+	ProjectId                  types.String `tfsdk:"project_id"`
+	EnvironmentId              types.String `tfsdk:"environment_id"`
+	CredentialsRotationTrigger types.String `tfsdk:"credentials_rotation_trigger"`
+	BucketName                 types.String `tfsdk:"bucket_name"`
+	Endpoint                   types.String `tfsdk:"endpoint"`
+	Region                     types.String `tfsdk:"region"`
+	UrlStyle                   types.String `tfsdk:"url_style"`
+	AccessKeyId                types.String `tfsdk:"access_key_id"`
+	SecretAccessKey            types.String `tfsdk:"secret_access_key"`
+}
+
+func (r *BucketResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bucket"
+}
+
+func (r *BucketResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Railway storage bucket. S3 compatible object storage.\n\n> ⚠️ **NOTE:** Railway does not support deleting buckets through the API. Destroying this resource only removes it from the Terraform state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the bucket.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the bucket.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the project the bucket belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an id"),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the environment the bucket credentials belong to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an id"),
+				},
+			},
+			"credentials_rotation_trigger": schema.StringAttribute{
+				MarkdownDescription: "Arbitrary value which resets the access credentials of the bucket whenever it changes.",
+				Optional:            true,
+			},
+			"bucket_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the bucket to be used with S3 compatible clients.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"endpoint": schema.StringAttribute{
+				MarkdownDescription: "S3 compatible endpoint of the bucket.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "Region of the bucket.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"url_style": schema.StringAttribute{
+				MarkdownDescription: "URL style of the bucket, either virtual-hosted or path style.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"access_key_id": schema.StringAttribute{
+				MarkdownDescription: "Access key identifier of the bucket.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"secret_access_key": schema.StringAttribute{
+				MarkdownDescription: "Secret access key of the bucket.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *BucketResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *BucketResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var data *BucketResourceModel
+	var state *BucketResourceModel
+
+	// Nothing to plan on create or destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Credentials are going to be reset, so their new values are only known after apply
+	if !data.CredentialsRotationTrigger.Equal(state.CredentialsRotationTrigger) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("access_key_id"), types.StringUnknown())...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("secret_access_key"), types.StringUnknown())...)
+	}
+}
+
+func (r *BucketResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *BucketResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := BucketCreateInput{
+		Name:      data.Name.ValueStringPointer(),
+		ProjectId: data.ProjectId.ValueString(),
+	}
+
+	response, err := createBucket(ctx, *r.client, input)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create bucket, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created a bucket")
+
+	bucket := response.BucketCreate.Bucket
+
+	data.Id = types.StringValue(bucket.Id)
+	data.Name = types.StringValue(bucket.Name)
+	data.ProjectId = types.StringValue(bucket.ProjectId)
+	data.BucketName = types.StringNull()
+	data.Endpoint = types.StringNull()
+	data.Region = types.StringNull()
+	data.UrlStyle = types.StringNull()
+	data.AccessKeyId = types.StringNull()
+	data.SecretAccessKey = types.StringNull()
+
+	// Buckets cannot be deleted through the API, so the bucket is saved before anything else can fail
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err = getAndBuildBucketCredentials(ctx, *r.client, data)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read bucket credentials, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BucketResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *BucketResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bucket, err := findBucket(ctx, *r.client, data.ProjectId.ValueString(), data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read bucket, got error: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(bucket.Id)
+	data.Name = types.StringValue(bucket.Name)
+	data.ProjectId = types.StringValue(bucket.ProjectId)
+	data.BucketName = types.StringNull()
+	data.Endpoint = types.StringNull()
+	data.Region = types.StringNull()
+	data.UrlStyle = types.StringNull()
+	data.AccessKeyId = types.StringNull()
+	data.SecretAccessKey = types.StringNull()
+
+	// Buckets cannot be deleted through the API, so the bucket is saved before anything else can fail
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err = getAndBuildBucketCredentials(ctx, *r.client, data)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read bucket credentials, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BucketResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *BucketResourceModel
+	var state *BucketResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Name.ValueString() != state.Name.ValueString() {
+		input := BucketUpdateInput{
+			Name: data.Name.ValueString(),
+		}
+
+		response, err := updateBucket(ctx, *r.client, state.Id.ValueString(), input)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update bucket, got error: %s", err))
+			return
+		}
+
+		tflog.Trace(ctx, "updated a bucket")
+
+		data.Name = types.StringValue(response.BucketUpdate.Bucket.Name)
+	}
+
+	if !data.CredentialsRotationTrigger.Equal(state.CredentialsRotationTrigger) {
+		response, err := resetBucketCredentials(ctx, *r.client, state.Id.ValueString(), state.EnvironmentId.ValueString(), state.ProjectId.ValueString())
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to reset bucket credentials, got error: %s", err))
+			return
+		}
+
+		tflog.Trace(ctx, "reset bucket credentials")
+
+		buildBucketCredentials(response.BucketCredentialsReset.BucketS3CompatibleCredentials, data)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BucketResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *BucketResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.AddWarning(
+		"Bucket Not Deleted",
+		fmt.Sprintf("Railway does not support deleting buckets through the API. Bucket %q has only been removed from the Terraform state and needs to be deleted from the Railway dashboard.", data.Name.ValueString()),
+	)
+
+	tflog.Trace(ctx, "removed a bucket from state")
+}
+
+func (r *BucketResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, ":")
+
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: project_id:environment_name:bucket_id. Got: %q", req.ID),
+		)
+
+		return
+	}
+
+	environmentId, err := findEnvironment(ctx, *r.client, parts[0], parts[1])
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read environment, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[2])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_id"), environmentId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), parts[0])...)
+}
+
+func findBucket(ctx context.Context, client graphql.Client, projectId string, id string) (*Bucket, error) {
+	response, err := listBuckets(ctx, client, projectId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, bucket := range response.Project.Buckets.Edges {
+		if bucket.Node.Id == id {
+			return &bucket.Node.Bucket, nil
+		}
+	}
+
+	return nil, fmt.Errorf("bucket doesn't exist")
+}
+
+func getAndBuildBucketCredentials(ctx context.Context, client graphql.Client, data *BucketResourceModel) error {
+	response, err := getBucketCredentials(ctx, client, data.Id.ValueString(), data.EnvironmentId.ValueString(), data.ProjectId.ValueString())
+
+	if err != nil {
+		return err
+	}
+
+	if len(response.BucketS3Credentials) == 0 {
+		return fmt.Errorf("bucket has no credentials in the environment")
+	}
+
+	buildBucketCredentials(response.BucketS3Credentials[0].BucketS3CompatibleCredentials, data)
+
+	return nil
+}
+
+func buildBucketCredentials(credentials BucketS3CompatibleCredentials, data *BucketResourceModel) {
+	data.BucketName = types.StringValue(credentials.BucketName)
+	data.Endpoint = types.StringValue(credentials.Endpoint)
+	data.Region = types.StringValue(credentials.Region)
+	data.UrlStyle = types.StringValue(credentials.UrlStyle)
+	data.AccessKeyId = types.StringValue(credentials.AccessKeyId)
+	data.SecretAccessKey = types.StringValue(credentials.SecretAccessKey)
+}
@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccBucketResourceDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccBucketResourceConfigDefault("terraform-tester", "one"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("railway_bucket.test", "id"),
+					resource.TestCheckResourceAttr("railway_bucket.test", "name", "terraform-tester"),
+					resource.TestCheckResourceAttr("railway_bucket.test", "project_id", "0bb01547-570d-4109-a5e8-138691f6a2d1"),
+					resource.TestCheckResourceAttr("railway_bucket.test", "environment_id", "d0519b29-5d12-4857-a5dd-76fa7418336c"),
+					resource.TestCheckResourceAttr("railway_bucket.test", "credentials_rotation_trigger", "one"),
+					resource.TestCheckResourceAttrSet("railway_bucket.test", "bucket_name"),
+					resource.TestCheckResourceAttrSet("railway_bucket.test", "endpoint"),
+					resource.TestCheckResourceAttrSet("railway_bucket.test", "region"),
+					resource.TestCheckResourceAttrSet("railway_bucket.test", "access_key_id"),
+					resource.TestCheckResourceAttrSet("railway_bucket.test", "secret_access_key"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "railway_bucket.test",
+				ImportState:             true,
+				ImportStateIdFunc:       bucketImportIdFunc,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"credentials_rotation_trigger"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccBucketResourceConfigDefault("terraform-tester-2", "two"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("railway_bucket.test", "id"),
+					resource.TestCheckResourceAttr("railway_bucket.test", "name", "terraform-tester-2"),
+					resource.TestCheckResourceAttr("railway_bucket.test", "project_id", "0bb01547-570d-4109-a5e8-138691f6a2d1"),
+					resource.TestCheckResourceAttr("railway_bucket.test", "environment_id", "d0519b29-5d12-4857-a5dd-76fa7418336c"),
+					resource.TestCheckResourceAttr("railway_bucket.test", "credentials_rotation_trigger", "two"),
+					resource.TestCheckResourceAttrSet("railway_bucket.test", "bucket_name"),
+					resource.TestCheckResourceAttrSet("railway_bucket.test", "endpoint"),
+					resource.TestCheckResourceAttrSet("railway_bucket.test", "region"),
+					resource.TestCheckResourceAttrSet("railway_bucket.test", "access_key_id"),
+					resource.TestCheckResourceAttrSet("railway_bucket.test", "secret_access_key"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccBucketResourceConfigDefault(name string, trigger string) string {
+	return fmt.Sprintf(`
+resource "railway_bucket" "test" {
+  name = "%s"
+  project_id = "0bb01547-570d-4109-a5e8-138691f6a2d1"
+  environment_id = "d0519b29-5d12-4857-a5dd-76fa7418336c"
+  credentials_rotation_trigger = "%s"
+}
+`, name, trigger)
+}
+
+func bucketImportIdFunc(state *terraform.State) (string, error) {
+	rawState, ok := state.RootModule().Resources["railway_bucket.test"]
+
+	if !ok {
+		return "", fmt.Errorf("Resource Not found")
+	}
+
+	return fmt.Sprintf("%s:staging:%s", rawState.Primary.Attributes["project_id"], rawState.Primary.Attributes["id"]), nil
+}
@@ -4,13 +4,18 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/Khan/genqlient/graphql"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -21,6 +26,8 @@ import (
 var _ resource.Resource = &CustomDomainResource{}
 var _ resource.ResourceWithImportState = &CustomDomainResource{}
 
+const customDomainVerificationTimeout = 30 * time.Minute
+
 func NewCustomDomainResource() resource.Resource {
 	return &CustomDomainResource{}
 }
@@ -41,6 +48,28 @@ type CustomDomainResourceModel struct {
 	DNSRecordValue          types.String `tfsdk:"dns_record_value"`
 	VerificationHostLabel   types.String `tfsdk:"verification_host_label"`
 	VerificationRecordValue types.String `tfsdk:"verification_record_value"`
+	Verified                types.Bool   `tfsdk:"verified"`
+	CertificateStatus       types.String `tfsdk:"certificate_status"`
+	CertificateExpiresAt    types.String `tfsdk:"certificate_expires_at"`
+	DNSRecords              types.List   `tfsdk:"dns_records"`
+	WaitForVerification     types.Bool   `tfsdk:"wait_for_verification"`
+	VerificationTimeout     types.Int64  `tfsdk:"verification_timeout"`
+}
+
+type CustomDomainResourceDNSRecordModel struct {
+	Fqdn          types.String `tfsdk:"fqdn"`
+	RecordType    types.String `tfsdk:"record_type"`
+	RequiredValue types.String `tfsdk:"required_value"`
+	CurrentValue  types.String `tfsdk:"current_value"`
+	Status        types.String `tfsdk:"status"`
+}
+
+var dnsRecordAttrTypes = map[string]attr.Type{
+	"fqdn":           types.StringType,
+	"record_type":    types.StringType,
+	"required_value": types.StringType,
+	"current_value":  types.StringType,
+	"status":         types.StringType,
 }
 
 func (r *CustomDomainResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -117,6 +146,61 @@ func (r *CustomDomainResource) Schema(ctx context.Context, req resource.SchemaRe
 				MarkdownDescription: "DNS record value for custom domain verification",
 				Computed:            true,
 			},
+			"verified": schema.BoolAttribute{
+				MarkdownDescription: "Whether the DNS records of the custom domain are verified.",
+				Computed:            true,
+			},
+			"certificate_status": schema.StringAttribute{
+				MarkdownDescription: "Status of the TLS certificate of the custom domain.",
+				Computed:            true,
+			},
+			"certificate_expires_at": schema.StringAttribute{
+				MarkdownDescription: "Expiry time of the TLS certificate of the custom domain in RFC 3339 format.",
+				Computed:            true,
+			},
+			"dns_records": schema.ListNestedAttribute{
+				MarkdownDescription: "DNS records required by the custom domain.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"fqdn": schema.StringAttribute{
+							MarkdownDescription: "Fully qualified name of the DNS record.",
+							Computed:            true,
+						},
+						"record_type": schema.StringAttribute{
+							MarkdownDescription: "Type of the DNS record.",
+							Computed:            true,
+						},
+						"required_value": schema.StringAttribute{
+							MarkdownDescription: "Value the DNS record is required to have.",
+							Computed:            true,
+						},
+						"current_value": schema.StringAttribute{
+							MarkdownDescription: "Value the DNS record currently has.",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Propagation status of the DNS record.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"wait_for_verification": schema.BoolAttribute{
+				MarkdownDescription: "Whether to wait until the DNS records are verified and the certificate is issued. A warning is shown when the domain is not verified in time. The wait cannot succeed when the DNS records are created from this domain in the same apply. **Default** `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"verification_timeout": schema.Int64Attribute{
+				MarkdownDescription: "Number of seconds to wait for the verification. **Default** `1800`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(int64(customDomainVerificationTimeout.Seconds())),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
 		},
 	}
 }
@@ -180,6 +264,18 @@ func (r *CustomDomainResource) Create(ctx context.Context, req resource.CreateRe
 
 	domain := response.CustomDomainCreate.CustomDomain
 
+	var verificationErr error
+
+	if data.WaitForVerification.ValueBool() {
+		var verifiedDomain *CustomDomain
+
+		verifiedDomain, verificationErr = waitForCustomDomainVerification(ctx, *r.client, domain.Id, service.Service.ProjectId, time.Duration(data.VerificationTimeout.ValueInt64())*time.Second)
+
+		if verifiedDomain != nil {
+			domain = *verifiedDomain
+		}
+	}
+
 	data.Id = types.StringValue(domain.Id)
 	data.Domain = types.StringValue(domain.Domain)
 	data.EnvironmentId = types.StringValue(domain.EnvironmentId)
@@ -197,7 +293,18 @@ func (r *CustomDomainResource) Create(ctx context.Context, req resource.CreateRe
 		data.TargetPort = types.Int64Value(int64(domain.TargetPort))
 	}
 
+	resp.Diagnostics.Append(buildCustomDomainStatus(ctx, &domain, data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	// Failing would replace the domain along with its verification token, so the domain is kept as it is
+	if verificationErr != nil {
+		resp.Diagnostics.AddWarning("Custom Domain Not Verified", fmt.Sprintf("Custom domain %s was created but not verified, got error: %s", data.Domain.ValueString(), verificationErr))
+	}
 }
 
 func (r *CustomDomainResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -255,6 +362,12 @@ func (r *CustomDomainResource) Update(ctx context.Context, req resource.UpdateRe
 		tflog.Trace(ctx, "updated a custom domain")
 	}
 
+	var verificationErr error
+
+	if data.WaitForVerification.ValueBool() {
+		_, verificationErr = waitForCustomDomainVerification(ctx, *r.client, state.Id.ValueString(), state.ProjectId.ValueString(), time.Duration(data.VerificationTimeout.ValueInt64())*time.Second)
+	}
+
 	err := readCustomDomain(ctx, *r.client, state.EnvironmentId.ValueString(), state.ServiceId.ValueString(), state.ProjectId.ValueString(), state.Domain.ValueString(), data)
 
 	if err != nil {
@@ -263,6 +376,10 @@ func (r *CustomDomainResource) Update(ctx context.Context, req resource.UpdateRe
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if verificationErr != nil {
+		resp.Diagnostics.AddWarning("Custom Domain Not Verified", fmt.Sprintf("Custom domain %s was updated but not verified, got error: %s", data.Domain.ValueString(), verificationErr))
+	}
 }
 
 func (r *CustomDomainResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -315,6 +432,8 @@ func (r *CustomDomainResource) ImportState(ctx context.Context, req resource.Imp
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_id"), parts[0])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_id"), environmentId)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("wait_for_verification"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("verification_timeout"), int64(customDomainVerificationTimeout.Seconds()))...)
 }
 
 func readCustomDomain(ctx context.Context, client graphql.Client, environmentId string, serviceId string, projectId string, domainHost string, data *CustomDomainResourceModel) error {
@@ -353,6 +472,10 @@ func readCustomDomain(ctx context.Context, client graphql.Client, environmentId
 		data.TargetPort = types.Int64Value(int64(domain.TargetPort))
 	}
 
+	if diags := buildCustomDomainStatus(ctx, &domain, data); diags.HasError() {
+		return fmt.Errorf("Unable to build custom domain status")
+	}
+
 	service, err := getService(ctx, client, domain.ServiceId)
 
 	if err != nil {
@@ -363,3 +486,69 @@ func readCustomDomain(ctx context.Context, client graphql.Client, environmentId
 
 	return nil
 }
+
+func buildCustomDomainStatus(ctx context.Context, domain *CustomDomain, data *CustomDomainResourceModel) diag.Diagnostics {
+	data.Verified = types.BoolValue(domain.Status.Verified)
+	data.CertificateStatus = types.StringValue(string(domain.Status.CertificateStatus))
+	data.CertificateExpiresAt = types.StringNull()
+
+	// Use the latest expiry when the certificate is being renewed
+	var expiresAt time.Time
+
+	for _, certificate := range domain.Status.Certificates {
+		if certificate.ExpiresAt.After(expiresAt) {
+			expiresAt = certificate.ExpiresAt
+		}
+	}
+
+	if !expiresAt.IsZero() {
+		data.CertificateExpiresAt = types.StringValue(expiresAt.Format(time.RFC3339))
+	}
+
+	records := make([]CustomDomainResourceDNSRecordModel, 0, len(domain.Status.DnsRecords))
+
+	for _, record := range domain.Status.DnsRecords {
+		records = append(records, CustomDomainResourceDNSRecordModel{
+			Fqdn:          types.StringValue(record.Fqdn),
+			RecordType:    types.StringValue(string(record.RecordType)),
+			RequiredValue: types.StringValue(record.RequiredValue),
+			CurrentValue:  types.StringValue(record.CurrentValue),
+			Status:        types.StringValue(string(record.Status)),
+		})
+	}
+
+	list, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: dnsRecordAttrTypes}, records)
+
+	data.DNSRecords = list
+
+	return diags
+}
+
+// waitForCustomDomainVerification polls the custom domain until its DNS records are verified
+// and its certificate is issued, and returns the latest custom domain.
+func waitForCustomDomainVerification(ctx context.Context, client graphql.Client, id string, projectId string, timeout time.Duration) (*CustomDomain, error) {
+	var domain CustomDomain
+
+	err := waitUntil(ctx, timeout, 10*time.Second, func() (bool, error) {
+		response, err := getCustomDomain(ctx, client, id, projectId)
+
+		if err != nil {
+			return false, err
+		}
+
+		domain = response.CustomDomain.CustomDomain
+
+		if domain.Status.CertificateStatus == CertificateStatusCertificateStatusTypeIssueFailed {
+			return false, fmt.Errorf("certificate issuance failed: %s", domain.Status.CertificateErrorMessage)
+		}
+
+		return domain.Status.Verified && domain.Status.CertificateStatus == CertificateStatusCertificateStatusTypeValid, nil
+	})
+
+	// The latest status is returned along with the error, so the domain can still be saved
+	if domain.Id == "" {
+		return nil, err
+	}
+
+	return &domain, err
+}
@@ -27,6 +27,12 @@ func TestAccCustomDomainResourceDefault(t *testing.T) {
 					resource.TestCheckResourceAttrSet("railway_custom_domain.test", "dns_record_value"),
 					resource.TestCheckResourceAttrSet("railway_custom_domain.test", "verification_host_label"),
 					resource.TestCheckResourceAttrSet("railway_custom_domain.test", "verification_record_value"),
+					resource.TestCheckResourceAttr("railway_custom_domain.test", "verified", "false"),
+					resource.TestCheckResourceAttrSet("railway_custom_domain.test", "certificate_status"),
+					resource.TestCheckResourceAttr("railway_custom_domain.test", "dns_records.#", "1"),
+					resource.TestCheckResourceAttr("railway_custom_domain.test", "dns_records.0.status", "DNS_RECORD_STATUS_REQUIRES_UPDATE"),
+					resource.TestCheckResourceAttr("railway_custom_domain.test", "wait_for_verification", "false"),
+					resource.TestCheckResourceAttr("railway_custom_domain.test", "verification_timeout", "1800"),
 				),
 			},
 			// ImportState testing
@@ -157,6 +163,26 @@ func TestAccCustomDomainResourceNonDefault(t *testing.T) {
 	})
 }
 
+func TestAccCustomDomainResourceVerificationTimeout(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing, an unverified domain is kept
+			{
+				Config: testAccCustomDomainResourceConfigWaitForVerification("terraform.example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("railway_custom_domain.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("railway_custom_domain.test", "verified", "false"),
+					resource.TestCheckResourceAttr("railway_custom_domain.test", "wait_for_verification", "true"),
+					resource.TestCheckResourceAttr("railway_custom_domain.test", "verification_timeout", "10"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
 func testAccCustomDomainResourceConfigDefault(name string) string {
 	return fmt.Sprintf(`
 resource "railway_custom_domain" "test" {
@@ -178,3 +204,16 @@ resource "railway_custom_domain" "test" {
 }
 `, name)
 }
+
+func testAccCustomDomainResourceConfigWaitForVerification(name string) string {
+	return fmt.Sprintf(`
+resource "railway_custom_domain" "test" {
+  domain = "%s"
+  environment_id = "d0519b29-5d12-4857-a5dd-76fa7418336c"
+  service_id = "39da7e07-fa3a-42fd-b695-d229319f2993"
+
+  wait_for_verification = true
+  verification_timeout = 10
+}
+`, name)
+}
@@ -0,0 +1,367 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const deploymentTimeout = 30 * time.Minute
+
+var _ resource.Resource = &DeploymentResource{}
+var _ resource.ResourceWithImportState = &DeploymentResource{}
+var _ resource.ResourceWithValidateConfig = &DeploymentResource{}
+
+func NewDeploymentResource() resource.Resource {
+	return &DeploymentResource{}
+}
+
+type DeploymentResource struct {
+	client *graphql.Client
+}
+
+type DeploymentResourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	CommitSha     types.String `tfsdk:"commit_sha"`
+	LatestCommit  types.Bool   `tfsdk:"latest_commit"`
+	EnvironmentId types.String `tfsdk:"environment_id"`
+	ServiceId     types.String `tfsdk:"service_id"`
+	Status        types.String `tfsdk:"status"`
+	Url           types.String `tfsdk:"url"`
+	ImageDigest   types.String `tfsdk:"image_digest"`
+}
+
+func (r *DeploymentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deployment"
+}
+
+func (r *DeploymentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Railway deployment. Deploys a service in an environment from an exact commit or the latest commit of its branch and waits for the deployment to finish. Deployments which need approval are waited on until they are approved in the Railway dashboard, they are never approved by terraform. Destroying it keeps the deployment running.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the deployment.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"commit_sha": schema.StringAttribute{
+				MarkdownDescription: "Full SHA of the commit to deploy. Set to the deployed commit when `latest_commit` is `true`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(commitShaRegex(), "must be a full commit sha"),
+				},
+			},
+			"latest_commit": schema.BoolAttribute{
+				MarkdownDescription: "Whether to deploy the latest commit of the connected branch. **Default** `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the environment to deploy in.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an id"),
+				},
+			},
+			"service_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the service to deploy.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an id"),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Status of the deployment.",
+				Computed:            true,
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "Static URL of the deployment, including the scheme.",
+				Computed:            true,
+			},
+			"image_digest": schema.StringAttribute{
+				MarkdownDescription: "Digest of the deployed image.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *DeploymentResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data DeploymentResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.CommitSha.IsUnknown() || data.LatestCommit.IsUnknown() {
+		return
+	}
+
+	if data.CommitSha.IsNull() == data.LatestCommit.ValueBool() {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		path.Root("commit_sha"),
+		"Invalid Attribute Combination",
+		"Exactly one of `commit_sha` or `latest_commit = true` must be specified.",
+	)
+}
+
+func (r *DeploymentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DeploymentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *DeploymentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	environmentId := data.EnvironmentId.ValueString()
+	serviceId := data.ServiceId.ValueString()
+
+	var commitSha *string
+
+	// The latest commit of the connected branch is deployed when no commit is given
+	if !data.LatestCommit.ValueBool() {
+		commitSha = data.CommitSha.ValueStringPointer()
+	}
+
+	response, err := deployServiceInstance(ctx, *r.client, environmentId, serviceId, commitSha)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create deployment, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created a deployment")
+
+	created, err := getDeployment(ctx, *r.client, response.ServiceInstanceDeployV2)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read created deployment, got error: %s", err))
+		return
+	}
+
+	deployment := &created.Deployment.Deployment
+
+	finished, err := waitForDeployment(ctx, *r.client, deployment.Id, deploymentTimeout)
+
+	if finished == nil {
+		finished = deployment
+	}
+
+	buildDeployment(finished, data)
+
+	// The deployment exists even if it failed, so it is saved to be replaced on the next apply
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to deploy service, got error: %s", err))
+		return
+	}
+}
+
+func (r *DeploymentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *DeploymentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := getDeployment(ctx, *r.client, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read deployment, got error: %s", err))
+		return
+	}
+
+	buildDeployment(&response.Deployment.Deployment, data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DeploymentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *DeploymentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DeploymentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Removing the deployment would take the service down, so it is only removed from the state
+	tflog.Trace(ctx, "deleted a deployment")
+}
+
+func (r *DeploymentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	response, err := getDeployment(ctx, *r.client, req.ID)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read deployment, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_id"), response.Deployment.EnvironmentId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_id"), response.Deployment.ServiceId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("latest_commit"), false)...)
+}
+
+// getDeploymentIds returns the identifiers of the recent deployments of a service.
+func getDeploymentIds(ctx context.Context, client graphql.Client, environmentId string, serviceId string) (map[string]bool, error) {
+	response, err := listDeployments(ctx, client, DeploymentListInput{EnvironmentId: &environmentId, ServiceId: &serviceId}, 10)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make(map[string]bool, len(response.Deployments.Edges))
+
+	for _, edge := range response.Deployments.Edges {
+		ids[edge.Node.Id] = true
+	}
+
+	return ids, nil
+}
+
+// waitForRollbackDeployment polls the deployments of the service until the one created by rolling back
+// to the target deployment shows up, and returns it. It is the new deployment built from the snapshot
+// of the target deployment.
+func waitForRollbackDeployment(ctx context.Context, client graphql.Client, target *Deployment, previousIds map[string]bool) (*Deployment, error) {
+	var deployment *Deployment
+
+	err := waitUntil(ctx, time.Minute, 2*time.Second, func() (bool, error) {
+		response, err := listDeployments(ctx, client, DeploymentListInput{EnvironmentId: &target.EnvironmentId, ServiceId: &target.ServiceId}, 10)
+
+		if err != nil {
+			return false, err
+		}
+
+		for _, edge := range response.Deployments.Edges {
+			if previousIds[edge.Node.Id] || edge.Node.SnapshotId != target.SnapshotId {
+				continue
+			}
+
+			deployment = &edge.Node.Deployment
+
+			return true, nil
+		}
+
+		return false, nil
+	})
+
+	return deployment, err
+}
+
+// waitForDeployment polls the deployment until it succeeds or fails, and returns the latest deployment.
+// Deployments which need approval keep being polled until they are approved outside of terraform.
+func waitForDeployment(ctx context.Context, client graphql.Client, id string, timeout time.Duration) (*Deployment, error) {
+	var deployment *Deployment
+
+	err := waitUntil(ctx, timeout, 5*time.Second, func() (bool, error) {
+		response, err := getDeployment(ctx, client, id)
+
+		if err != nil {
+			return false, err
+		}
+
+		deployment = &response.Deployment.Deployment
+
+		switch deployment.Status {
+		case DeploymentStatusSuccess, DeploymentStatusSleeping:
+			return true, nil
+		case DeploymentStatusFailed, DeploymentStatusCrashed, DeploymentStatusRemoved, DeploymentStatusSkipped:
+			return false, fmt.Errorf("deployment finished with status %s", deployment.Status)
+		case DeploymentStatusNeedsApproval:
+			tflog.Trace(ctx, "waiting for deployment to be approved")
+
+			return false, nil
+		}
+
+		tflog.Trace(ctx, "waiting for deployment to finish")
+
+		return false, nil
+	})
+
+	return deployment, err
+}
+
+func buildDeployment(deployment *Deployment, data *DeploymentResourceModel) {
+	data.Id = types.StringValue(deployment.Id)
+	data.EnvironmentId = types.StringValue(deployment.EnvironmentId)
+	data.ServiceId = types.StringValue(deployment.ServiceId)
+	data.Status = types.StringValue(string(deployment.Status))
+	data.Url = types.StringNull()
+	data.ImageDigest = types.StringNull()
+
+	if deployment.StaticUrl != "" {
+		data.Url = types.StringValue("https://" + deployment.StaticUrl)
+	} else if deployment.Url != "" {
+		data.Url = types.StringValue(deployment.Url)
+	}
+
+	if commitHash, ok := deployment.Meta["commitHash"].(string); ok && commitHash != "" {
+		data.CommitSha = types.StringValue(commitHash)
+	} else if data.CommitSha.IsUnknown() {
+		data.CommitSha = types.StringNull()
+	}
+
+	if imageDigest, ok := deployment.Meta["imageDigest"].(string); ok && imageDigest != "" {
+		data.ImageDigest = types.StringValue(imageDigest)
+	}
+}
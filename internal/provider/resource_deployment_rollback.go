@@ -0,0 +1,213 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &DeploymentRollbackResource{}
+
+func NewDeploymentRollbackResource() resource.Resource {
+	return &DeploymentRollbackResource{}
+}
+
+type DeploymentRollbackResource struct {
+	client *graphql.Client
+}
+
+type DeploymentRollbackResourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	DeploymentId  types.String `tfsdk:"deployment_id"`
+	EnvironmentId types.String `tfsdk:"environment_id"`
+	ServiceId     types.String `tfsdk:"service_id"`
+	Status        types.String `tfsdk:"status"`
+}
+
+func (r *DeploymentRollbackResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deployment_rollback"
+}
+
+func (r *DeploymentRollbackResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Railway deployment rollback. Rolls the service of a deployment back to it and waits for the rollback to finish. Destroying it keeps the rolled back deployment running.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the deployment created by the rollback.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"deployment_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the deployment to roll back to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an id"),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the environment the deployment belongs to.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the service the deployment belongs to.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Status of the deployment created by the rollback.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *DeploymentRollbackResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DeploymentRollbackResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *DeploymentRollbackResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	target, err := getDeployment(ctx, *r.client, data.DeploymentId.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read deployment, got error: %s", err))
+		return
+	}
+
+	if !target.Deployment.CanRollback {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to roll back to deployment %s, it cannot be rolled back to", data.DeploymentId.ValueString()))
+		return
+	}
+
+	// The rollback mutation doesn't return the deployment, so it is found by the snapshot it was built from
+	if target.Deployment.SnapshotId == "" {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to roll back to deployment %s, it has no snapshot", data.DeploymentId.ValueString()))
+		return
+	}
+
+	previousIds, err := getDeploymentIds(ctx, *r.client, target.Deployment.EnvironmentId, target.Deployment.ServiceId)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read deployments, got error: %s", err))
+		return
+	}
+
+	_, err = rollbackDeployment(ctx, *r.client, data.DeploymentId.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to roll back deployment, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "rolled back a deployment")
+
+	deployment, err := waitForRollbackDeployment(ctx, *r.client, &target.Deployment.Deployment, previousIds)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find rollback deployment, got error: %s", err))
+		return
+	}
+
+	finished, err := waitForDeployment(ctx, *r.client, deployment.Id, deploymentTimeout)
+
+	if finished == nil {
+		finished = deployment
+	}
+
+	buildDeploymentRollback(finished, data)
+
+	// The rollback happened even if its deployment failed, so it is saved to be replaced on the next apply
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to roll back deployment, got error: %s", err))
+		return
+	}
+}
+
+func (r *DeploymentRollbackResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *DeploymentRollbackResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := getDeployment(ctx, *r.client, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read deployment, got error: %s", err))
+		return
+	}
+
+	buildDeploymentRollback(&response.Deployment.Deployment, data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DeploymentRollbackResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *DeploymentRollbackResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DeploymentRollbackResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// A rollback cannot be undone, so it is only removed from the state
+	tflog.Trace(ctx, "deleted a deployment rollback")
+}
+
+func buildDeploymentRollback(deployment *Deployment, data *DeploymentRollbackResourceModel) {
+	data.Id = types.StringValue(deployment.Id)
+	data.EnvironmentId = types.StringValue(deployment.EnvironmentId)
+	data.ServiceId = types.StringValue(deployment.ServiceId)
+	data.Status = types.StringValue(string(deployment.Status))
+}
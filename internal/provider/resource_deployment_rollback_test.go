@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDeploymentRollbackResourceDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccDeploymentRollbackResourceConfigDefault(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("railway_deployment_rollback.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttrPair("railway_deployment_rollback.test", "deployment_id", "railway_deployment.first", "id"),
+					resource.TestCheckResourceAttr("railway_deployment_rollback.test", "environment_id", "d0519b29-5d12-4857-a5dd-76fa7418336c"),
+					resource.TestCheckResourceAttrPair("railway_deployment_rollback.test", "service_id", "railway_service.test", "id"),
+					resource.TestCheckResourceAttr("railway_deployment_rollback.test", "status", "SUCCESS"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccDeploymentRollbackResourceConfigDefault() string {
+	return `
+resource "railway_service" "test" {
+  name = "todo-app-rollback"
+  project_id = "0bb01547-570d-4109-a5e8-138691f6a2d1"
+  source_image = "traefik/whoami"
+}
+
+resource "railway_deployment" "first" {
+  latest_commit = true
+  environment_id = "d0519b29-5d12-4857-a5dd-76fa7418336c"
+  service_id = railway_service.test.id
+}
+
+resource "railway_deployment" "second" {
+  latest_commit = true
+  environment_id = "d0519b29-5d12-4857-a5dd-76fa7418336c"
+  service_id = railway_service.test.id
+
+  depends_on = [railway_deployment.first]
+}
+
+resource "railway_deployment_rollback" "test" {
+  deployment_id = railway_deployment.first.id
+
+  depends_on = [railway_deployment.second]
+}
+`
+}
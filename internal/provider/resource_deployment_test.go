@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccDeploymentResourceDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccDeploymentResourceConfigDefault(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("railway_deployment.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("railway_deployment.test", "latest_commit", "true"),
+					resource.TestCheckResourceAttr("railway_deployment.test", "environment_id", "d0519b29-5d12-4857-a5dd-76fa7418336c"),
+					resource.TestMatchResourceAttr("railway_deployment.test", "service_id", uuidRegex()),
+					resource.TestCheckResourceAttr("railway_deployment.test", "status", "SUCCESS"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "railway_deployment.test",
+				ImportState:             true,
+				ImportStateIdFunc:       deploymentImportIdFunc,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"latest_commit"},
+			},
+			// Update with default values
+			{
+				Config: testAccDeploymentResourceConfigDefault(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("railway_deployment.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("railway_deployment.test", "latest_commit", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccDeploymentResourceConfigDefault() string {
+	return `
+resource "railway_service" "test" {
+  name = "todo-app-deployment"
+  project_id = "0bb01547-570d-4109-a5e8-138691f6a2d1"
+  source_image = "traefik/whoami"
+}
+
+resource "railway_deployment" "test" {
+  latest_commit = true
+  environment_id = "d0519b29-5d12-4857-a5dd-76fa7418336c"
+  service_id = railway_service.test.id
+}
+`
+}
+
+func deploymentImportIdFunc(state *terraform.State) (string, error) {
+	rawState, ok := state.RootModule().Resources["railway_deployment.test"]
+
+	if !ok {
+		return "", fmt.Errorf("Resource Not found")
+	}
+
+	return rawState.Primary.Attributes["id"], nil
+}
@@ -0,0 +1,649 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"gopkg.in/yaml.v2"
+)
+
+const dockerComposeTimeout = 10 * time.Minute
+
+var _ resource.Resource = &DockerComposeResource{}
+var _ resource.ResourceWithValidateConfig = &DockerComposeResource{}
+
+func NewDockerComposeResource() resource.Resource {
+	return &DockerComposeResource{}
+}
+
+type DockerComposeResource struct {
+	client *graphql.Client
+}
+
+type DockerComposeResourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	Yaml          types.String `tfsdk:"yaml"`
+	CommitChanges types.Bool   `tfsdk:"commit_changes"`
+	ProjectId     types.String `tfsdk:"project_id"`
+	EnvironmentId types.String `tfsdk:"environment_id"`
+	ServiceIds    types.Map    `tfsdk:"service_ids"`
+}
+
+// For YAML transformation
+type dockerComposeFile struct {
+	Services map[string]interface{} `yaml:"services"`
+}
+
+func (r *DockerComposeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_docker_compose"
+}
+
+func (r *DockerComposeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Railway docker compose import. Creates services and volumes in an environment from a docker compose file. Changes are committed to the environment without committing other staged changes, or staged there when `commit_changes` is `false`. Only the services created by the import are managed, and importing a service named like an existing service of the project fails.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the docker compose import.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"yaml": schema.StringAttribute{
+				MarkdownDescription: "Content of the docker compose file.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"commit_changes": schema.BoolAttribute{
+				MarkdownDescription: "Whether to commit the changes to the environment. When `false`, changes are left staged to be reviewed in the Railway dashboard, they are committed once this is turned on and discarded when the import is destroyed. **Default** `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the project to import the docker compose file in.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an id"),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the environment to import the docker compose file in.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an id"),
+				},
+			},
+			"service_ids": schema.MapAttribute{
+				MarkdownDescription: "Identifiers of the created services, keyed by the name of the docker compose service.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *DockerComposeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data DockerComposeResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Yaml.IsNull() || data.Yaml.IsUnknown() {
+		return
+	}
+
+	_, err := getDockerComposeServiceNames(data.Yaml.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("yaml"),
+			"Invalid docker compose file",
+			err.Error(),
+		)
+	}
+}
+
+func (r *DockerComposeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DockerComposeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *DockerComposeResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceIds, err := applyDockerCompose(ctx, *r.client, data, map[string]string{})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import docker compose, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "imported a docker compose")
+
+	data.Id = types.StringValue(fmt.Sprintf("%s:%s", data.ProjectId.ValueString(), data.EnvironmentId.ValueString()))
+
+	err = buildDockerComposeServices(ctx, *r.client, data, serviceIds)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read docker compose services, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DockerComposeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *DockerComposeResourceModel
+	var serviceIds map[string]string
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	resp.Diagnostics.Append(data.ServiceIds.ElementsAs(ctx, &serviceIds, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := buildDockerComposeServices(ctx, *r.client, data, serviceIds)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read docker compose services, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DockerComposeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *DockerComposeResourceModel
+	var state *DockerComposeResourceModel
+	var serviceIds map[string]string
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(state.ServiceIds.ElementsAs(ctx, &serviceIds, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var stagedPatch map[string]interface{}
+
+	// Changes left staged by the previous apply are staged again or committed below
+	if !state.CommitChanges.ValueBool() {
+		var err error
+
+		stagedPatch, err = discardDockerComposeChanges(ctx, *r.client, data.EnvironmentId.ValueString(), serviceIds)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to discard staged docker compose changes, got error: %s", err))
+			return
+		}
+	}
+
+	if !data.Yaml.Equal(state.Yaml) {
+		// Services which were only staged are superseded by the new import
+		for name, id := range serviceIds {
+			if dockerComposePatchHasService(stagedPatch, id) {
+				delete(serviceIds, name)
+			}
+		}
+
+		createdServiceIds, err := applyDockerCompose(ctx, *r.client, data, serviceIds)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import docker compose, got error: %s", err))
+			return
+		}
+
+		tflog.Trace(ctx, "updated a docker compose")
+
+		// Services removed from the docker compose file are removed from the environment as well
+		if data.CommitChanges.ValueBool() {
+			names, err := getDockerComposeServiceNames(data.Yaml.ValueString())
+
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to parse docker compose, got error: %s", err))
+				return
+			}
+
+			for name, id := range serviceIds {
+				if slices.Contains(names, name) {
+					continue
+				}
+
+				_, err := deleteService(ctx, *r.client, id)
+
+				if err != nil {
+					resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete service %s, got error: %s", name, err))
+					return
+				}
+
+				tflog.Trace(ctx, "deleted a service")
+
+				delete(serviceIds, name)
+			}
+		}
+
+		for name, id := range createdServiceIds {
+			serviceIds[name] = id
+		}
+	} else if len(stagedPatch) > 0 && data.CommitChanges.ValueBool() {
+		err := commitDockerCompose(ctx, *r.client, data, stagedPatch)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to commit docker compose, got error: %s", err))
+			return
+		}
+
+		tflog.Trace(ctx, "committed a docker compose")
+	}
+
+	data.Id = state.Id
+
+	err := buildDockerComposeServices(ctx, *r.client, data, serviceIds)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read docker compose services, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DockerComposeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *DockerComposeResourceModel
+	var serviceIds map[string]string
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	resp.Diagnostics.Append(data.ServiceIds.ElementsAs(ctx, &serviceIds, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.CommitChanges.ValueBool() {
+		_, err := discardDockerComposeChanges(ctx, *r.client, data.EnvironmentId.ValueString(), serviceIds)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to discard staged docker compose changes, got error: %s", err))
+			return
+		}
+
+		tflog.Trace(ctx, "discarded staged docker compose changes")
+	}
+
+	services, err := listProjectServices(ctx, *r.client, data.ProjectId.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project services, got error: %s", err))
+		return
+	}
+
+	volumes, err := getVolumeInstances(ctx, *r.client, data.ProjectId.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project volumes, got error: %s", err))
+		return
+	}
+
+	existingServiceIds := make(map[string]bool)
+
+	for _, service := range services.Project.Services.Edges {
+		existingServiceIds[service.Node.Id] = true
+	}
+
+	for _, id := range serviceIds {
+		// Services of changes which were left staged do not exist yet
+		if !existingServiceIds[id] {
+			continue
+		}
+
+		_, err := deleteService(ctx, *r.client, id)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete service, got error: %s", err))
+			return
+		}
+
+		tflog.Trace(ctx, "deleted a service")
+	}
+
+	// Volumes are not deleted along with the services they are mounted to
+	for _, volume := range volumes.Project.Volumes.Edges {
+		for _, volumeInstance := range volume.Node.VolumeInstances.Edges {
+			if volumeInstance.Node.EnvironmentId != data.EnvironmentId.ValueString() || !mapContainsValue(serviceIds, volumeInstance.Node.ServiceId) {
+				continue
+			}
+
+			_, err := deleteVolume(ctx, *r.client, volume.Node.Id)
+
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete volume, got error: %s", err))
+				return
+			}
+
+			tflog.Trace(ctx, "deleted a volume")
+
+			break
+		}
+	}
+
+	tflog.Trace(ctx, "deleted a docker compose")
+}
+
+func getDockerComposeServiceNames(content string) ([]string, error) {
+	var file dockerComposeFile
+
+	err := yaml.Unmarshal([]byte(content), &file)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(file.Services) == 0 {
+		return nil, fmt.Errorf("docker compose file has no services")
+	}
+
+	names := make([]string, 0, len(file.Services))
+
+	for name := range file.Services {
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+
+	return names, nil
+}
+
+// applyDockerCompose imports the docker compose file as a patch and commits it to the environment,
+// or stages it there when asked not to commit. When committed, it waits for all the created services
+// to exist. It returns the services created by the patch, keyed by name. Existing services are never
+// taken over, so importing a service named like a service which is not already part of the import fails.
+func applyDockerCompose(ctx context.Context, client graphql.Client, data *DockerComposeResourceModel, serviceIds map[string]string) (map[string]string, error) {
+	names, err := getDockerComposeServiceNames(data.Yaml.ValueString())
+
+	if err != nil {
+		return nil, err
+	}
+
+	services, err := listProjectServices(ctx, client, data.ProjectId.ValueString())
+
+	if err != nil {
+		return nil, err
+	}
+
+	existingServiceIds := make(map[string]bool)
+
+	for _, service := range services.Project.Services.Edges {
+		existingServiceIds[service.Node.Id] = true
+
+		if slices.Contains(names, service.Node.Name) && !mapContainsValue(serviceIds, service.Node.Id) {
+			return nil, fmt.Errorf("service %s already exists in the project and is not managed by this docker compose import", service.Node.Name)
+		}
+	}
+
+	response, err := importDockerCompose(ctx, client, data.ProjectId.ValueString(), data.EnvironmentId.ValueString(), data.Yaml.ValueString(), true)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(response.DockerComposeImport.Errors) > 0 {
+		return nil, fmt.Errorf("%s", strings.Join(response.DockerComposeImport.Errors, ", "))
+	}
+
+	patch := response.DockerComposeImport.Patch
+
+	// Services of the patch are keyed by their identifier, so the created ones are those which did not exist before
+	createdServiceIds := make(map[string]string)
+	patchServices, _ := patch["services"].(map[string]interface{})
+
+	for id, value := range patchServices {
+		if existingServiceIds[id] {
+			continue
+		}
+
+		name := id
+
+		if service, ok := value.(map[string]interface{}); ok {
+			if serviceName, ok := service["name"].(string); ok && serviceName != "" {
+				name = serviceName
+			}
+		}
+
+		createdServiceIds[name] = id
+	}
+
+	if !data.CommitChanges.ValueBool() {
+		_, err = stageEnvironmentChanges(ctx, client, data.EnvironmentId.ValueString(), patch, true)
+
+		if err != nil {
+			return nil, err
+		}
+
+		tflog.Trace(ctx, "staged docker compose changes")
+
+		return createdServiceIds, nil
+	}
+
+	err = commitDockerCompose(ctx, client, data, patch)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return createdServiceIds, nil
+}
+
+// commitDockerCompose commits the patch of a docker compose import without touching other changes
+// staged in the environment and waits for all the services of the patch to exist.
+func commitDockerCompose(ctx context.Context, client graphql.Client, data *DockerComposeResourceModel, patch map[string]interface{}) error {
+	err := commitEnvironmentPatch(ctx, client, data.EnvironmentId.ValueString(), patch, "Import docker compose from Terraform", false)
+
+	if err != nil {
+		return err
+	}
+
+	tflog.Trace(ctx, "committed docker compose changes")
+
+	patchServices, _ := patch["services"].(map[string]interface{})
+
+	return waitUntil(ctx, dockerComposeTimeout, 5*time.Second, func() (bool, error) {
+		services, err := listProjectServices(ctx, client, data.ProjectId.ValueString())
+
+		if err != nil {
+			return false, err
+		}
+
+		existing := make(map[string]bool)
+
+		for _, service := range services.Project.Services.Edges {
+			existing[service.Node.Id] = true
+		}
+
+		for id := range patchServices {
+			if !existing[id] {
+				return false, nil
+			}
+		}
+
+		return true, nil
+	})
+}
+
+// discardDockerComposeChanges removes the changes of the given services, along with the volumes mounted
+// to them, from the staged changes of the environment and returns them. Other staged changes are kept.
+func discardDockerComposeChanges(ctx context.Context, client graphql.Client, environmentId string, serviceIds map[string]string) (map[string]interface{}, error) {
+	staged, err := getStagedChanges(ctx, client, environmentId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rest := make(map[string]interface{}, len(staged.EnvironmentStagedChanges.Patch))
+
+	for key, value := range staged.EnvironmentStagedChanges.Patch {
+		rest[key] = value
+	}
+
+	own := make(map[string]interface{})
+	ownServices := make(map[string]interface{})
+	ownVolumes := make(map[string]interface{})
+	restServices := make(map[string]interface{})
+	restVolumes := make(map[string]interface{})
+	volumeIds := make(map[string]bool)
+
+	stagedServices, _ := rest["services"].(map[string]interface{})
+
+	for id, value := range stagedServices {
+		if !mapContainsValue(serviceIds, id) {
+			restServices[id] = value
+			continue
+		}
+
+		ownServices[id] = value
+
+		if service, ok := value.(map[string]interface{}); ok {
+			if mounts, ok := service["volumeMounts"].(map[string]interface{}); ok {
+				for volumeId := range mounts {
+					volumeIds[volumeId] = true
+				}
+			}
+		}
+	}
+
+	stagedVolumes, _ := rest["volumes"].(map[string]interface{})
+
+	for id, value := range stagedVolumes {
+		if volumeIds[id] {
+			ownVolumes[id] = value
+		} else {
+			restVolumes[id] = value
+		}
+	}
+
+	if len(ownServices) == 0 {
+		return nil, nil
+	}
+
+	own["services"] = ownServices
+	rest["services"] = restServices
+
+	if len(ownVolumes) > 0 {
+		own["volumes"] = ownVolumes
+		rest["volumes"] = restVolumes
+	}
+
+	_, err = stageEnvironmentChanges(ctx, client, environmentId, rest, false)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return own, nil
+}
+
+func dockerComposePatchHasService(patch map[string]interface{}, id string) bool {
+	services, _ := patch["services"].(map[string]interface{})
+	_, ok := services[id]
+
+	return ok
+}
+
+// buildDockerComposeServices sets the services of the import from the given identifiers, keyed by their
+// current name. Services which do not exist anymore are dropped unless the changes were left staged.
+func buildDockerComposeServices(ctx context.Context, client graphql.Client, data *DockerComposeResourceModel, serviceIds map[string]string) error {
+	services, err := listProjectServices(ctx, client, data.ProjectId.ValueString())
+
+	if err != nil {
+		return err
+	}
+
+	serviceNames := make(map[string]string)
+
+	for _, service := range services.Project.Services.Edges {
+		serviceNames[service.Node.Id] = service.Node.Name
+	}
+
+	values := make(map[string]attr.Value)
+
+	for name, id := range serviceIds {
+		if current, ok := serviceNames[id]; ok {
+			values[current] = types.StringValue(id)
+		} else if !data.CommitChanges.ValueBool() {
+			values[name] = types.StringValue(id)
+		}
+	}
+
+	data.ServiceIds = types.MapValueMust(types.StringType, values)
+
+	return nil
+}
+
+func mapContainsValue(values map[string]string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
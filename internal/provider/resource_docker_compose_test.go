@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDockerComposeResourceDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccDockerComposeResourceConfigDefault("redis:7"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("railway_docker_compose.test", "id", "0bb01547-570d-4109-a5e8-138691f6a2d1:d0519b29-5d12-4857-a5dd-76fa7418336c"),
+					resource.TestCheckResourceAttr("railway_docker_compose.test", "commit_changes", "true"),
+					resource.TestCheckResourceAttr("railway_docker_compose.test", "project_id", "0bb01547-570d-4109-a5e8-138691f6a2d1"),
+					resource.TestCheckResourceAttr("railway_docker_compose.test", "environment_id", "d0519b29-5d12-4857-a5dd-76fa7418336c"),
+					resource.TestCheckResourceAttr("railway_docker_compose.test", "service_ids.%", "1"),
+					resource.TestMatchResourceAttr("railway_docker_compose.test", "service_ids.compose-cache", uuidRegex()),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccDockerComposeResourceConfigDefault("redis:7-alpine"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("railway_docker_compose.test", "id", "0bb01547-570d-4109-a5e8-138691f6a2d1:d0519b29-5d12-4857-a5dd-76fa7418336c"),
+					resource.TestCheckResourceAttr("railway_docker_compose.test", "service_ids.%", "1"),
+					resource.TestMatchResourceAttr("railway_docker_compose.test", "service_ids.compose-cache", uuidRegex()),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccDockerComposeResourceStaged(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccDockerComposeResourceConfigCommitChanges("redis:7", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("railway_docker_compose.test", "commit_changes", "false"),
+					resource.TestCheckResourceAttr("railway_docker_compose.test", "service_ids.%", "1"),
+					resource.TestMatchResourceAttr("railway_docker_compose.test", "service_ids.compose-cache", uuidRegex()),
+				),
+			},
+			// Committing the staged changes
+			{
+				Config: testAccDockerComposeResourceConfigCommitChanges("redis:7", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("railway_docker_compose.test", "commit_changes", "true"),
+					resource.TestCheckResourceAttr("railway_docker_compose.test", "service_ids.%", "1"),
+					resource.TestMatchResourceAttr("railway_docker_compose.test", "service_ids.compose-cache", uuidRegex()),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccDockerComposeResourceConfigDefault(image string) string {
+	return fmt.Sprintf(`
+resource "railway_docker_compose" "test" {
+  yaml = <<-EOT
+    services:
+      compose-cache:
+        image: %s
+  EOT
+  project_id = "0bb01547-570d-4109-a5e8-138691f6a2d1"
+  environment_id = "d0519b29-5d12-4857-a5dd-76fa7418336c"
+}
+`, image)
+}
+
+func testAccDockerComposeResourceConfigCommitChanges(image string, commitChanges bool) string {
+	return fmt.Sprintf(`
+resource "railway_docker_compose" "test" {
+  yaml = <<-EOT
+    services:
+      compose-cache:
+        image: %s
+  EOT
+  commit_changes = %t
+  project_id = "0bb01547-570d-4109-a5e8-138691f6a2d1"
+  environment_id = "d0519b29-5d12-4857-a5dd-76fa7418336c"
+}
+`, image, commitChanges)
+}
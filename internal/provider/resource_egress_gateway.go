@@ -0,0 +1,350 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &EgressGatewayResource{}
+var _ resource.ResourceWithImportState = &EgressGatewayResource{}
+
+func NewEgressGatewayResource() resource.Resource {
+	return &EgressGatewayResource{}
+}
+
+type EgressGatewayResource struct {
+	client *graphql.Client
+}
+
+type EgressGatewayResourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	Region        types.String `tfsdk:"region"`
+	EnvironmentId types.String `tfsdk:"environment_id"`
+	ServiceId     types.String `tfsdk:"service_id"`
+	IPv4Addresses types.List   `tfsdk:"ipv4_addresses"`
+}
+
+func (r *EgressGatewayResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_egress_gateway"
+}
+
+func (r *EgressGatewayResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Railway egress gateway. Static outbound IPv4 addresses of a service in a region. Creating or deleting it triggers service redeployment. Railway can only remove all the egress gateways of a service at once, so deleting it associates the gateways in other regions again, which may change their addresses. The new addresses are only read on the next refresh, so resources using them in the same apply get the previous addresses.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the egress gateway.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "Region of the egress gateway.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the environment the egress gateway belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an id"),
+				},
+			},
+			"service_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the service the egress gateway belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an id"),
+				},
+			},
+			"ipv4_addresses": schema.ListAttribute{
+				MarkdownDescription: "Static outbound IPv4 addresses assigned to the service.",
+				ElementType:         types.StringType,
+				Computed:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *EgressGatewayResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *EgressGatewayResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *EgressGatewayResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := EgressGatewayCreateInput{
+		EnvironmentId: data.EnvironmentId.ValueString(),
+		ServiceId:     data.ServiceId.ValueString(),
+		Region:        data.Region.ValueStringPointer(),
+	}
+
+	response, err := createEgressGateway(ctx, *r.client, input)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create egress gateway, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created an egress gateway")
+
+	gateways := make([]EgressGateway, 0, len(response.EgressGatewayAssociationCreate))
+
+	for _, gateway := range response.EgressGatewayAssociationCreate {
+		gateways = append(gateways, gateway.EgressGateway)
+	}
+
+	err = buildEgressGateway(gateways, data)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read egress gateway after creating it, got error: %s", err))
+		return
+	}
+
+	_, err = redeployServiceInstance(ctx, *r.client, data.EnvironmentId.ValueString(), data.ServiceId.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to redeploy service after egress gateway created, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *EgressGatewayResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *EgressGatewayResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	gateways, err := getEgressGateways(ctx, *r.client, data.EnvironmentId.ValueString(), data.ServiceId.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read egress gateway, got error: %s", err))
+		return
+	}
+
+	// The egress gateway was removed outside of terraform, so it needs to be created again
+	if !slices.ContainsFunc(gateways, func(gateway EgressGateway) bool { return gateway.Region == data.Region.ValueString() }) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	err = buildEgressGateway(gateways, data)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read egress gateway, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *EgressGatewayResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *EgressGatewayResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *EgressGatewayResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *EgressGatewayResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	gateways, err := getEgressGateways(ctx, *r.client, data.EnvironmentId.ValueString(), data.ServiceId.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read egress gateways, got error: %s", err))
+		return
+	}
+
+	input := EgressGatewayServiceTargetInput{
+		EnvironmentId: data.EnvironmentId.ValueString(),
+		ServiceId:     data.ServiceId.ValueString(),
+	}
+
+	_, err = clearEgressGateways(ctx, *r.client, input)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete egress gateway, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted an egress gateway")
+
+	// Railway can only clear all the gateways of a service, so the ones in other regions are associated again
+	otherRegions := otherEgressGatewayRegions(gateways, data.Region.ValueString())
+
+	if len(otherRegions) > 0 {
+		resp.Diagnostics.AddWarning(
+			"Egress Gateways Reassociated",
+			fmt.Sprintf("Railway can only remove all the egress gateways of a service, so the gateways in regions %s were associated again and their IPv4 addresses may have changed. Run terraform apply again to update resources using them.", strings.Join(otherRegions, ", ")),
+		)
+	}
+
+	for _, region := range otherRegions {
+		_, err := createEgressGateway(ctx, *r.client, EgressGatewayCreateInput{
+			EnvironmentId: data.EnvironmentId.ValueString(),
+			ServiceId:     data.ServiceId.ValueString(),
+			Region:        &region,
+		})
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to restore egress gateway in region %s, got error: %s", region, err))
+			return
+		}
+	}
+
+	_, err = redeployServiceInstance(ctx, *r.client, data.EnvironmentId.ValueString(), data.ServiceId.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to redeploy service after egress gateway deleted, got error: %s", err))
+		return
+	}
+}
+
+func (r *EgressGatewayResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, ":")
+
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: service_id:environment_name:region. Got: %q", req.ID),
+		)
+
+		return
+	}
+
+	service, err := getService(ctx, *r.client, parts[0])
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read service, got error: %s", err))
+		return
+	}
+
+	environmentId, err := findEnvironment(ctx, *r.client, service.Service.ProjectId, parts[1])
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read environment, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("region"), parts[2])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_id"), environmentId)...)
+}
+
+func getEgressGateways(ctx context.Context, client graphql.Client, environmentId string, serviceId string) ([]EgressGateway, error) {
+	response, err := listEgressGateways(ctx, client, environmentId, serviceId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	gateways := make([]EgressGateway, 0, len(response.EgressGateways))
+
+	for _, gateway := range response.EgressGateways {
+		gateways = append(gateways, gateway.EgressGateway)
+	}
+
+	return gateways, nil
+}
+
+func buildEgressGateway(gateways []EgressGateway, data *EgressGatewayResourceModel) error {
+	region := data.Region.ValueString()
+	addresses := make([]attr.Value, 0, len(gateways))
+
+	for _, gateway := range gateways {
+		if gateway.Region == region {
+			addresses = append(addresses, types.StringValue(gateway.Ipv4))
+		}
+	}
+
+	if len(addresses) == 0 {
+		return fmt.Errorf("egress gateway doesn't exist in region %s", region)
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%s:%s:%s", data.ServiceId.ValueString(), data.EnvironmentId.ValueString(), region))
+	data.IPv4Addresses = types.ListValueMust(types.StringType, addresses)
+
+	return nil
+}
+
+func otherEgressGatewayRegions(gateways []EgressGateway, region string) []string {
+	regions := make([]string, 0)
+	seen := map[string]bool{region: true}
+
+	for _, gateway := range gateways {
+		if !seen[gateway.Region] {
+			seen[gateway.Region] = true
+			regions = append(regions, gateway.Region)
+		}
+	}
+
+	return regions
+}
@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccEgressGatewayResourceDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccEgressGatewayResourceConfigDefault(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("railway_egress_gateway.test", "id", "39da7e07-fa3a-42fd-b695-d229319f2993:d0519b29-5d12-4857-a5dd-76fa7418336c:us-west2"),
+					resource.TestCheckResourceAttr("railway_egress_gateway.test", "region", "us-west2"),
+					resource.TestCheckResourceAttr("railway_egress_gateway.test", "environment_id", "d0519b29-5d12-4857-a5dd-76fa7418336c"),
+					resource.TestCheckResourceAttr("railway_egress_gateway.test", "service_id", "39da7e07-fa3a-42fd-b695-d229319f2993"),
+					resource.TestCheckResourceAttrSet("railway_egress_gateway.test", "ipv4_addresses.0"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "railway_egress_gateway.test",
+				ImportState:       true,
+				ImportStateId:     "39da7e07-fa3a-42fd-b695-d229319f2993:staging:us-west2",
+				ImportStateVerify: true,
+			},
+			// Update with default values
+			{
+				Config: testAccEgressGatewayResourceConfigDefault(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("railway_egress_gateway.test", "id", "39da7e07-fa3a-42fd-b695-d229319f2993:d0519b29-5d12-4857-a5dd-76fa7418336c:us-west2"),
+					resource.TestCheckResourceAttr("railway_egress_gateway.test", "region", "us-west2"),
+					resource.TestCheckResourceAttr("railway_egress_gateway.test", "environment_id", "d0519b29-5d12-4857-a5dd-76fa7418336c"),
+					resource.TestCheckResourceAttr("railway_egress_gateway.test", "service_id", "39da7e07-fa3a-42fd-b695-d229319f2993"),
+					resource.TestCheckResourceAttrSet("railway_egress_gateway.test", "ipv4_addresses.0"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccEgressGatewayResourceConfigDefault() string {
+	return `
+resource "railway_egress_gateway" "test" {
+  region = "us-west2"
+  environment_id = "d0519b29-5d12-4857-a5dd-76fa7418336c"
+  service_id = "39da7e07-fa3a-42fd-b695-d229319f2993"
+}
+`
+}
@@ -0,0 +1,357 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &IntegrationResource{}
+var _ resource.ResourceWithImportState = &IntegrationResource{}
+
+func NewIntegrationResource() resource.Resource {
+	return &IntegrationResource{}
+}
+
+type IntegrationResource struct {
+	client *graphql.Client
+}
+
+type IntegrationResourceModel struct {
+	Id                types.String         `tfsdk:"id"`
+	Name              types.String         `tfsdk:"name"`
+	ProjectId         types.String         `tfsdk:"project_id"`
+	IntegrationAuthId types.String         `tfsdk:"integration_auth_id"`
+	Config            jsontypes.Normalized `tfsdk:"config"`
+}
+
+func (r *IntegrationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_integration"
+}
+
+func (r *IntegrationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Railway integration. Connection of a project to a third-party provider.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the integration.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the integration.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the project the integration belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an id"),
+				},
+			},
+			"integration_auth_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the connected provider account used by the integration. Removing it keeps the current account.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"config": schema.StringAttribute{
+				MarkdownDescription: "Configuration of the integration as a JSON object. Use `jsonencode` to write it in HCL. Differences in formatting and key order are ignored.",
+				CustomType:          jsontypes.NormalizedType{},
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *IntegrationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *IntegrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *IntegrationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, err := parseIntegrationConfig(data.Config)
+
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("config"), "Invalid Attribute Value", err.Error())
+		return
+	}
+
+	input := IntegrationCreateInput{
+		Name:      data.Name.ValueString(),
+		ProjectId: data.ProjectId.ValueString(),
+		Config:    config,
+	}
+
+	if !data.IntegrationAuthId.IsUnknown() && !data.IntegrationAuthId.IsNull() {
+		input.IntegrationAuthId = data.IntegrationAuthId.ValueStringPointer()
+	}
+
+	response, err := createIntegration(ctx, *r.client, input)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create integration, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created an integration")
+
+	err = buildIntegration(&response.IntegrationCreate.Integration, data)
+
+	if err == nil {
+		data.IntegrationAuthId, err = getIntegrationAuthId(ctx, *r.client, data.Id.ValueString())
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read integration after creating it, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IntegrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *IntegrationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := listIntegrations(ctx, *r.client, data.ProjectId.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read integration, got error: %s", err))
+		return
+	}
+
+	var integration *Integration
+
+	for _, edge := range response.Integrations.Edges {
+		if edge.Node.Id == data.Id.ValueString() {
+			integration = &edge.Node.Integration
+			break
+		}
+	}
+
+	if integration == nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read integration, got error: integration %s doesn't exist", data.Id.ValueString()))
+		return
+	}
+
+	err = buildIntegration(integration, data)
+
+	if err == nil {
+		data.IntegrationAuthId, err = getIntegrationAuthId(ctx, *r.client, data.Id.ValueString())
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read integration, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IntegrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *IntegrationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, err := parseIntegrationConfig(data.Config)
+
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("config"), "Invalid Attribute Value", err.Error())
+		return
+	}
+
+	input := IntegrationUpdateInput{
+		Name:      data.Name.ValueString(),
+		ProjectId: data.ProjectId.ValueString(),
+		Config:    config,
+	}
+
+	if !data.IntegrationAuthId.IsUnknown() && !data.IntegrationAuthId.IsNull() {
+		input.IntegrationAuthId = data.IntegrationAuthId.ValueStringPointer()
+	}
+
+	response, err := updateIntegration(ctx, *r.client, data.Id.ValueString(), input)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update integration, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "updated an integration")
+
+	err = buildIntegration(&response.IntegrationUpdate.Integration, data)
+
+	if err == nil {
+		data.IntegrationAuthId, err = getIntegrationAuthId(ctx, *r.client, data.Id.ValueString())
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read integration after updating it, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IntegrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *IntegrationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := deleteIntegration(ctx, *r.client, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete integration, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted an integration")
+}
+
+func (r *IntegrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, ":")
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), parts[0])...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+		return
+	}
+
+	if len(parts) != 1 || parts[0] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: id or project_id:id. Got: %q", req.ID),
+		)
+
+		return
+	}
+
+	// Integrations can only be listed by project, so the project is found through the connected provider accounts
+	response, err := listIntegrationAuths(ctx, *r.client)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read integration auths, got error: %s", err))
+		return
+	}
+
+	for _, auth := range response.IntegrationAuths.Edges {
+		for _, edge := range auth.Node.Integrations.Edges {
+			if edge.Node.Id == req.ID {
+				resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+				resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), edge.Node.ProjectId)...)
+				resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("integration_auth_id"), auth.Node.Id)...)
+				return
+			}
+		}
+	}
+
+	resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find integration %s, import it with format: project_id:id", req.ID))
+}
+
+// getIntegrationAuthId returns the connected provider account of the integration, which is only
+// known through the integrations of the accounts.
+func getIntegrationAuthId(ctx context.Context, client graphql.Client, id string) (types.String, error) {
+	response, err := listIntegrationAuths(ctx, client)
+
+	if err != nil {
+		return types.StringNull(), err
+	}
+
+	for _, auth := range response.IntegrationAuths.Edges {
+		for _, edge := range auth.Node.Integrations.Edges {
+			if edge.Node.Id == id {
+				return types.StringValue(auth.Node.Id), nil
+			}
+		}
+	}
+
+	return types.StringNull(), nil
+}
+
+func parseIntegrationConfig(value jsontypes.Normalized) (map[string]interface{}, error) {
+	var config map[string]interface{}
+
+	if err := json.Unmarshal([]byte(value.ValueString()), &config); err != nil || config == nil {
+		return nil, fmt.Errorf("config must be a JSON object")
+	}
+
+	return config, nil
+}
+
+func buildIntegration(integration *Integration, data *IntegrationResourceModel) error {
+	config, err := json.Marshal(integration.Config)
+
+	if err != nil {
+		return err
+	}
+
+	data.Id = types.StringValue(integration.Id)
+	data.Name = types.StringValue(integration.Name)
+	data.ProjectId = types.StringValue(integration.ProjectId)
+	data.Config = jsontypes.NewNormalizedValue(string(config))
+
+	return nil
+}
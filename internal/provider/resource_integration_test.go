@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccIntegrationResourceDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccIntegrationResourceConfigDefault("terraform-tester", `{"region":"eu","site":"datadoghq.eu"}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("railway_integration.test", "id"),
+					resource.TestCheckResourceAttr("railway_integration.test", "name", "terraform-tester"),
+					resource.TestCheckResourceAttr("railway_integration.test", "project_id", "0bb01547-570d-4109-a5e8-138691f6a2d1"),
+					resource.TestCheckNoResourceAttr("railway_integration.test", "integration_auth_id"),
+					resource.TestCheckResourceAttr("railway_integration.test", "config", `{"region":"eu","site":"datadoghq.eu"}`),
+				),
+			},
+			// Reordered keys don't cause a diff
+			{
+				Config:   testAccIntegrationResourceConfigDefault("terraform-tester", `{ "site": "datadoghq.eu", "region": "eu" }`),
+				PlanOnly: true,
+			},
+			// ImportState testing
+			{
+				ResourceName:      "railway_integration.test",
+				ImportState:       true,
+				ImportStateIdFunc: integrationImportIdFunc,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccIntegrationResourceConfigDefault("terraform-tester-2", `{"region":"us","site":"datadoghq.com"}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("railway_integration.test", "id"),
+					resource.TestCheckResourceAttr("railway_integration.test", "name", "terraform-tester-2"),
+					resource.TestCheckResourceAttr("railway_integration.test", "project_id", "0bb01547-570d-4109-a5e8-138691f6a2d1"),
+					resource.TestCheckResourceAttr("railway_integration.test", "config", `{"region":"us","site":"datadoghq.com"}`),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccIntegrationResourceConfigDefault(name string, config string) string {
+	return fmt.Sprintf(`
+resource "railway_integration" "test" {
+  name = "%s"
+  project_id = "0bb01547-570d-4109-a5e8-138691f6a2d1"
+  config = %q
+}
+`, name, config)
+}
+
+func integrationImportIdFunc(state *terraform.State) (string, error) {
+	rawState, ok := state.RootModule().Resources["railway_integration.test"]
+
+	if !ok {
+		return "", fmt.Errorf("Resource Not found")
+	}
+
+	return fmt.Sprintf("%s:%s", rawState.Primary.Attributes["project_id"], rawState.Primary.Attributes["id"]), nil
+}
@@ -0,0 +1,596 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &ObservabilityDashboardResource{}
+var _ resource.ResourceWithImportState = &ObservabilityDashboardResource{}
+
+func NewObservabilityDashboardResource() resource.Resource {
+	return &ObservabilityDashboardResource{}
+}
+
+type ObservabilityDashboardResource struct {
+	client *graphql.Client
+}
+
+type ObservabilityDashboardResourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	EnvironmentId types.String `tfsdk:"environment_id"`
+	Items         types.List   `tfsdk:"items"`
+}
+
+type ObservabilityDashboardResourceItemModel struct {
+	Name                   types.String `tfsdk:"name"`
+	Description            types.String `tfsdk:"description"`
+	Type                   types.String `tfsdk:"type"`
+	ResourceIds            types.Set    `tfsdk:"resource_ids"`
+	Measurements           types.Set    `tfsdk:"measurements"`
+	ProjectUsageProperties types.Set    `tfsdk:"project_usage_properties"`
+	LogsFilter             types.String `tfsdk:"logs_filter"`
+	Layout                 types.Object `tfsdk:"layout"`
+}
+
+type ObservabilityDashboardResourceLayoutModel struct {
+	X      types.Int64 `tfsdk:"x"`
+	Y      types.Int64 `tfsdk:"y"`
+	Width  types.Int64 `tfsdk:"width"`
+	Height types.Int64 `tfsdk:"height"`
+}
+
+var dashboardLayoutAttrTypes = map[string]attr.Type{
+	"x":      types.Int64Type,
+	"y":      types.Int64Type,
+	"width":  types.Int64Type,
+	"height": types.Int64Type,
+}
+
+var dashboardItemAttrTypes = map[string]attr.Type{
+	"name":                     types.StringType,
+	"description":              types.StringType,
+	"type":                     types.StringType,
+	"resource_ids":             types.SetType{ElemType: types.StringType},
+	"measurements":             types.SetType{ElemType: types.StringType},
+	"project_usage_properties": types.SetType{ElemType: types.StringType},
+	"logs_filter":              types.StringType,
+	"layout":                   types.ObjectType{AttrTypes: dashboardLayoutAttrTypes},
+}
+
+var dashboardItemTypes = []string{
+	string(ObservabilityDashboardItemTypeProjectUsageItem),
+	string(ObservabilityDashboardItemTypeServiceLogsItem),
+	string(ObservabilityDashboardItemTypeServiceMetricsItem),
+	string(ObservabilityDashboardItemTypeVolumeMetricsItem),
+}
+
+var dashboardMeasurements = []string{
+	string(MetricMeasurementBackupUsageGb),
+	string(MetricMeasurementCpuLimit),
+	string(MetricMeasurementCpuUsage),
+	string(MetricMeasurementDiskUsageGb),
+	string(MetricMeasurementEphemeralDiskUsageGb),
+	string(MetricMeasurementMemoryLimitGb),
+	string(MetricMeasurementMemoryUsageGb),
+	string(MetricMeasurementNetworkRxGb),
+	string(MetricMeasurementNetworkTxGb),
+}
+
+var dashboardProjectUsageProperties = []string{
+	string(ProjectUsagePropertyBackupUsage),
+	string(ProjectUsagePropertyCpuUsage),
+	string(ProjectUsagePropertyCurrentUsage),
+	string(ProjectUsagePropertyDiskUsage),
+	string(ProjectUsagePropertyEstimatedUsage),
+	string(ProjectUsagePropertyMemoryUsage),
+	string(ProjectUsagePropertyNetworkUsage),
+}
+
+func (r *ObservabilityDashboardResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_observability_dashboard"
+}
+
+func (r *ObservabilityDashboardResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Railway observability dashboard. The dashboard of an environment. Destroying it resets the dashboard to the default items.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the observability dashboard.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the environment the observability dashboard belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an id"),
+				},
+			},
+			"items": schema.ListNestedAttribute{
+				MarkdownDescription: "Items of the observability dashboard.",
+				Required:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the item.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.UTF8LengthAtLeast(1),
+							},
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Description of the item.",
+							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.UTF8LengthAtLeast(1),
+							},
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Type of the item. Valid values are `PROJECT_USAGE_ITEM`, `SERVICE_LOGS_ITEM`, `SERVICE_METRICS_ITEM` and `VOLUME_METRICS_ITEM`.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(dashboardItemTypes...),
+							},
+						},
+						"resource_ids": schema.SetAttribute{
+							MarkdownDescription: "Identifiers of the services or volumes shown in the item.",
+							ElementType:         types.StringType,
+							Optional:            true,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+								setvalidator.ValueStringsAre(stringvalidator.RegexMatches(uuidRegex(), "must be an id")),
+							},
+						},
+						"measurements": schema.SetAttribute{
+							MarkdownDescription: "Metrics shown in the item.",
+							ElementType:         types.StringType,
+							Optional:            true,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+								setvalidator.ValueStringsAre(stringvalidator.OneOf(dashboardMeasurements...)),
+							},
+						},
+						"project_usage_properties": schema.SetAttribute{
+							MarkdownDescription: "Project usage shown in the item.",
+							ElementType:         types.StringType,
+							Optional:            true,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+								setvalidator.ValueStringsAre(stringvalidator.OneOf(dashboardProjectUsageProperties...)),
+							},
+						},
+						"logs_filter": schema.StringAttribute{
+							MarkdownDescription: "Filter of the logs shown in the item.",
+							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.UTF8LengthAtLeast(1),
+							},
+						},
+						"layout": schema.SingleNestedAttribute{
+							MarkdownDescription: "Position and size of the item in the dashboard grid.",
+							Required:            true,
+							Attributes: map[string]schema.Attribute{
+								"x": schema.Int64Attribute{
+									MarkdownDescription: "Column of the item.",
+									Required:            true,
+									Validators: []validator.Int64{
+										int64validator.AtLeast(0),
+									},
+								},
+								"y": schema.Int64Attribute{
+									MarkdownDescription: "Row of the item.",
+									Required:            true,
+									Validators: []validator.Int64{
+										int64validator.AtLeast(0),
+									},
+								},
+								"width": schema.Int64Attribute{
+									MarkdownDescription: "Number of columns the item spans.",
+									Required:            true,
+									Validators: []validator.Int64{
+										int64validator.AtLeast(1),
+									},
+								},
+								"height": schema.Int64Attribute{
+									MarkdownDescription: "Number of rows the item spans.",
+									Required:            true,
+									Validators: []validator.Int64{
+										int64validator.AtLeast(1),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *ObservabilityDashboardResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ObservabilityDashboardResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *ObservabilityDashboardResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	environmentId := data.EnvironmentId.ValueString()
+
+	dashboard, err := findObservabilityDashboard(ctx, *r.client, environmentId)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read observability dashboard, got error: %s", err))
+		return
+	}
+
+	items, diags := buildObservabilityDashboardInput(ctx, data, dashboard)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Railway creates the dashboard of an environment when it is first opened, so an existing one is taken over
+	if dashboard != nil {
+		_, err = updateObservabilityDashboard(ctx, *r.client, dashboard.Id, items)
+	} else {
+		_, err = createObservabilityDashboard(ctx, *r.client, ObservabilityDashboardCreateInput{
+			EnvironmentId: environmentId,
+			Items:         items,
+		})
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create observability dashboard, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created an observability dashboard")
+
+	dashboard, err = findObservabilityDashboard(ctx, *r.client, environmentId)
+
+	if err != nil || dashboard == nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read observability dashboard after creating it, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(buildObservabilityDashboard(ctx, dashboard, data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ObservabilityDashboardResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *ObservabilityDashboardResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dashboard, err := findObservabilityDashboard(ctx, *r.client, data.EnvironmentId.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read observability dashboard, got error: %s", err))
+		return
+	}
+
+	if dashboard == nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read observability dashboard, environment %s has no dashboard", data.EnvironmentId.ValueString()))
+		return
+	}
+
+	resp.Diagnostics.Append(buildObservabilityDashboard(ctx, dashboard, data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ObservabilityDashboardResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *ObservabilityDashboardResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dashboard, err := findObservabilityDashboard(ctx, *r.client, data.EnvironmentId.ValueString())
+
+	if err != nil || dashboard == nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read observability dashboard, got error: %s", err))
+		return
+	}
+
+	items, diags := buildObservabilityDashboardInput(ctx, data, dashboard)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err = updateObservabilityDashboard(ctx, *r.client, dashboard.Id, items)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update observability dashboard, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "updated an observability dashboard")
+
+	dashboard, err = findObservabilityDashboard(ctx, *r.client, data.EnvironmentId.ValueString())
+
+	if err != nil || dashboard == nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read observability dashboard after updating it, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(buildObservabilityDashboard(ctx, dashboard, data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ObservabilityDashboardResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *ObservabilityDashboardResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Dashboards cannot be deleted, so it is reset to the default items
+	_, err := resetObservabilityDashboard(ctx, *r.client, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete observability dashboard, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted an observability dashboard")
+}
+
+func (r *ObservabilityDashboardResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("environment_id"), req, resp)
+}
+
+func findObservabilityDashboard(ctx context.Context, client graphql.Client, environmentId string) (*ObservabilityDashboard, error) {
+	response, err := listObservabilityDashboards(ctx, client, environmentId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(response.ObservabilityDashboards.Edges) == 0 {
+		return nil, nil
+	}
+
+	return &response.ObservabilityDashboards.Edges[0].Node.ObservabilityDashboard, nil
+}
+
+func buildObservabilityDashboardInput(ctx context.Context, data *ObservabilityDashboardResourceModel, dashboard *ObservabilityDashboard) ([]ObservabilityDashboardUpdateInput, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var itemsData []ObservabilityDashboardResourceItemModel
+
+	diags.Append(data.Items.ElementsAs(ctx, &itemsData, false)...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	items := make([]ObservabilityDashboardUpdateInput, 0, len(itemsData))
+
+	// Existing items are matched by name and type, so that reordering them keeps their monitors
+	existing := make(map[string][]ObservabilityDashboardItemsObservabilityDashboardItemInstance)
+
+	if dashboard != nil {
+		for _, instance := range dashboard.Items {
+			key := observabilityDashboardItemKey(instance.DashboardItem.Name, string(instance.DashboardItem.Type))
+			existing[key] = append(existing[key], instance)
+		}
+	}
+
+	for _, itemData := range itemsData {
+		var layoutData ObservabilityDashboardResourceLayoutModel
+
+		diags.Append(itemData.Layout.As(ctx, &layoutData, basetypes.ObjectAsOptions{})...)
+
+		config := ObservabilityDashboardItemConfigInput{
+			LogsFilter: itemData.LogsFilter.ValueStringPointer(),
+		}
+
+		var measurements, projectUsageProperties []string
+
+		if !itemData.ResourceIds.IsNull() {
+			diags.Append(itemData.ResourceIds.ElementsAs(ctx, &config.ResourceIds, false)...)
+		}
+
+		if !itemData.Measurements.IsNull() {
+			diags.Append(itemData.Measurements.ElementsAs(ctx, &measurements, false)...)
+		}
+
+		if !itemData.ProjectUsageProperties.IsNull() {
+			diags.Append(itemData.ProjectUsageProperties.ElementsAs(ctx, &projectUsageProperties, false)...)
+		}
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		for _, measurement := range measurements {
+			config.Measurements = append(config.Measurements, MetricMeasurement(measurement))
+		}
+
+		for _, property := range projectUsageProperties {
+			config.ProjectUsageProperties = append(config.ProjectUsageProperties, ProjectUsageProperty(property))
+		}
+
+		// Identifiers of the existing items are kept so that their monitors are not lost
+		instanceId := uuid.NewString()
+		itemId := uuid.NewString()
+		key := observabilityDashboardItemKey(itemData.Name.ValueString(), itemData.Type.ValueString())
+
+		if matches := existing[key]; len(matches) > 0 {
+			instanceId = matches[0].Id
+			itemId = matches[0].DashboardItem.Id
+			existing[key] = matches[1:]
+		}
+
+		items = append(items, ObservabilityDashboardUpdateInput{
+			Id: instanceId,
+			DashboardItem: ObservabilityDashboardItemCreateInput{
+				Id:          itemId,
+				Name:        itemData.Name.ValueString(),
+				Description: itemData.Description.ValueStringPointer(),
+				Type:        ObservabilityDashboardItemType(itemData.Type.ValueString()),
+				Config:      config,
+			},
+			DisplayConfig: map[string]interface{}{
+				"x": layoutData.X.ValueInt64(),
+				"y": layoutData.Y.ValueInt64(),
+				"w": layoutData.Width.ValueInt64(),
+				"h": layoutData.Height.ValueInt64(),
+			},
+		})
+	}
+
+	return items, diags
+}
+
+func observabilityDashboardItemKey(name string, itemType string) string {
+	return fmt.Sprintf("%s:%s", itemType, name)
+}
+
+func buildObservabilityDashboard(ctx context.Context, dashboard *ObservabilityDashboard, data *ObservabilityDashboardResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	items := make([]attr.Value, 0, len(dashboard.Items))
+
+	for _, instance := range dashboard.Items {
+		item := instance.DashboardItem
+
+		description := types.StringNull()
+
+		if item.Description != "" {
+			description = types.StringValue(item.Description)
+		}
+
+		logsFilter := types.StringNull()
+
+		if item.Config.LogsFilter != "" {
+			logsFilter = types.StringValue(item.Config.LogsFilter)
+		}
+
+		resourceIds := types.SetNull(types.StringType)
+
+		if len(item.Config.ResourceIds) > 0 {
+			value, d := types.SetValueFrom(ctx, types.StringType, item.Config.ResourceIds)
+			diags.Append(d...)
+			resourceIds = value
+		}
+
+		measurements := types.SetNull(types.StringType)
+
+		if len(item.Config.Measurements) > 0 {
+			value, d := types.SetValueFrom(ctx, types.StringType, item.Config.Measurements)
+			diags.Append(d...)
+			measurements = value
+		}
+
+		projectUsageProperties := types.SetNull(types.StringType)
+
+		if len(item.Config.ProjectUsageProperties) > 0 {
+			value, d := types.SetValueFrom(ctx, types.StringType, item.Config.ProjectUsageProperties)
+			diags.Append(d...)
+			projectUsageProperties = value
+		}
+
+		layout, d := types.ObjectValue(dashboardLayoutAttrTypes, map[string]attr.Value{
+			"x":      displayConfigValue(instance.DisplayConfig, "x"),
+			"y":      displayConfigValue(instance.DisplayConfig, "y"),
+			"width":  displayConfigValue(instance.DisplayConfig, "w"),
+			"height": displayConfigValue(instance.DisplayConfig, "h"),
+		})
+		diags.Append(d...)
+
+		value, d := types.ObjectValue(dashboardItemAttrTypes, map[string]attr.Value{
+			"name":                     types.StringValue(item.Name),
+			"description":              description,
+			"type":                     types.StringValue(string(item.Type)),
+			"resource_ids":             resourceIds,
+			"measurements":             measurements,
+			"project_usage_properties": projectUsageProperties,
+			"logs_filter":              logsFilter,
+			"layout":                   layout,
+		})
+		diags.Append(d...)
+
+		items = append(items, value)
+	}
+
+	if diags.HasError() {
+		return diags
+	}
+
+	list, d := types.ListValue(types.ObjectType{AttrTypes: dashboardItemAttrTypes}, items)
+	diags.Append(d...)
+
+	data.Id = types.StringValue(dashboard.Id)
+	data.Items = list
+
+	return diags
+}
+
+func displayConfigValue(displayConfig map[string]interface{}, key string) types.Int64 {
+	// Numbers in JSON scalars are decoded as float64
+	if value, ok := displayConfig[key].(float64); ok {
+		return types.Int64Value(int64(value))
+	}
+
+	return types.Int64Null()
+}
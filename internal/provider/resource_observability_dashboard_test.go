@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccObservabilityDashboardResourceDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccObservabilityDashboardResourceConfigDefault("CPU metrics"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("railway_observability_dashboard.test", "id"),
+					resource.TestCheckResourceAttrPair("railway_observability_dashboard.test", "environment_id", "railway_environment.test", "id"),
+					resource.TestCheckResourceAttr("railway_observability_dashboard.test", "items.#", "1"),
+					resource.TestCheckResourceAttr("railway_observability_dashboard.test", "items.0.name", "CPU metrics"),
+					resource.TestCheckResourceAttr("railway_observability_dashboard.test", "items.0.type", "SERVICE_METRICS_ITEM"),
+					resource.TestCheckResourceAttr("railway_observability_dashboard.test", "items.0.resource_ids.#", "1"),
+					resource.TestCheckResourceAttr("railway_observability_dashboard.test", "items.0.measurements.#", "1"),
+					resource.TestCheckResourceAttr("railway_observability_dashboard.test", "items.0.layout.width", "6"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "railway_observability_dashboard.test",
+				ImportState:                          true,
+				ImportStateIdFunc:                    observabilityDashboardImportIdFunc,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "environment_id",
+			},
+			// Update and Read testing
+			{
+				Config: testAccObservabilityDashboardResourceConfigNonDefault(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("railway_observability_dashboard.test", "id"),
+					resource.TestCheckResourceAttr("railway_observability_dashboard.test", "items.#", "2"),
+					resource.TestCheckResourceAttr("railway_observability_dashboard.test", "items.0.name", "Memory metrics"),
+					resource.TestCheckResourceAttr("railway_observability_dashboard.test", "items.1.name", "Errors"),
+					resource.TestCheckResourceAttr("railway_observability_dashboard.test", "items.1.type", "SERVICE_LOGS_ITEM"),
+					resource.TestCheckResourceAttr("railway_observability_dashboard.test", "items.1.logs_filter", "@level:error"),
+					resource.TestCheckResourceAttr("railway_observability_dashboard.test", "items.1.layout.x", "6"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccObservabilityDashboardResourceConfigDefault(name string) string {
+	return fmt.Sprintf(`
+resource "railway_environment" "test" {
+  name = "dashboard-tester"
+  project_id = "0bb01547-570d-4109-a5e8-138691f6a2d1"
+}
+
+resource "railway_observability_dashboard" "test" {
+  environment_id = railway_environment.test.id
+
+  items = [
+    {
+      name = "%s"
+      type = "SERVICE_METRICS_ITEM"
+      resource_ids = ["39da7e07-fa3a-42fd-b695-d229319f2993"]
+      measurements = ["CPU_USAGE"]
+      layout = { x = 0, y = 0, width = 6, height = 4 }
+    },
+  ]
+}
+`, name)
+}
+
+func testAccObservabilityDashboardResourceConfigNonDefault() string {
+	return `
+resource "railway_environment" "test" {
+  name = "dashboard-tester"
+  project_id = "0bb01547-570d-4109-a5e8-138691f6a2d1"
+}
+
+resource "railway_observability_dashboard" "test" {
+  environment_id = railway_environment.test.id
+
+  items = [
+    {
+      name = "Memory metrics"
+      type = "SERVICE_METRICS_ITEM"
+      resource_ids = ["39da7e07-fa3a-42fd-b695-d229319f2993"]
+      measurements = ["MEMORY_USAGE_GB"]
+      layout = { x = 0, y = 0, width = 6, height = 4 }
+    },
+    {
+      name = "Errors"
+      type = "SERVICE_LOGS_ITEM"
+      resource_ids = ["39da7e07-fa3a-42fd-b695-d229319f2993"]
+      logs_filter = "@level:error"
+      layout = { x = 6, y = 0, width = 6, height = 4 }
+    },
+  ]
+}
+`
+}
+
+func observabilityDashboardImportIdFunc(state *terraform.State) (string, error) {
+	rawState, ok := state.RootModule().Resources["railway_observability_dashboard.test"]
+
+	if !ok {
+		return "", fmt.Errorf("Resource Not found")
+	}
+
+	return rawState.Primary.Attributes["environment_id"], nil
+}
@@ -0,0 +1,544 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const privateNetworkDomainSuffix = "railway.internal"
+
+var _ resource.Resource = &PrivateNetworkEndpointResource{}
+var _ resource.ResourceWithImportState = &PrivateNetworkEndpointResource{}
+var _ resource.ResourceWithModifyPlan = &PrivateNetworkEndpointResource{}
+
+func NewPrivateNetworkEndpointResource() resource.Resource {
+	return &PrivateNetworkEndpointResource{}
+}
+
+type PrivateNetworkEndpointResource struct {
+	client *graphql.Client
+}
+
+type PrivateNetworkEndpointResourceModel struct {
+	Id               types.String `tfsdk:"id"`
+	DnsName          types.String `tfsdk:"dns_name"`
+	Tags             types.Set    `tfsdk:"tags"`
+	EnvironmentId    types.String `tfsdk:"environment_id"`
+	ServiceId        types.String `tfsdk:"service_id"`
+	ProjectId        types.String `tfsdk:"project_id"`
+	PrivateNetworkId types.String `tfsdk:"private_network_id"`
+	Hostname         types.String `tfsdk:"hostname"`
+	PrivateIps       types.List   `tfsdk:"private_ips"`
+	OriginalDnsName  types.String `tfsdk:"original_dns_name"`
+}
+
+func (r *PrivateNetworkEndpointResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_private_network_endpoint"
+}
+
+func (r *PrivateNetworkEndpointResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Railway private network endpoint. Internal DNS name of a service in an environment. Services usually already have an endpoint named after them, which is renamed rather than created and gets back its original name on destroy.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the private network endpoint.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dns_name": schema.StringAttribute{
+				MarkdownDescription: "DNS name of the private network endpoint.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+					stringvalidator.UTF8LengthAtMost(63),
+					stringvalidator.RegexMatches(regexp.MustCompile("^[a-z0-9]([a-z0-9-]*[a-z0-9])?$"), "must only contain lowercase letters, digits and hyphens"),
+				},
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "Tags of the private network endpoint. **Default** `[]`.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the environment the private network endpoint belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an id"),
+				},
+			},
+			"service_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the service the private network endpoint belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an id"),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the project the private network endpoint belongs to.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"private_network_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the private network the endpoint belongs to.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"hostname": schema.StringAttribute{
+				MarkdownDescription: "Internal hostname of the service, in the form of `<dns_name>.railway.internal`.",
+				Computed:            true,
+			},
+			"private_ips": schema.ListAttribute{
+				MarkdownDescription: "Private IP addresses of the private network endpoint.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"original_dns_name": schema.StringAttribute{
+				MarkdownDescription: "DNS name the endpoint had before being managed by terraform. The endpoint is renamed back to it on destroy, and deleted when it was created by terraform instead.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *PrivateNetworkEndpointResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *PrivateNetworkEndpointResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var data *PrivateNetworkEndpointResourceModel
+	var state *PrivateNetworkEndpointResourceModel
+
+	// Nothing to check on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.DnsName.IsUnknown() {
+		return
+	}
+
+	data.Hostname = types.StringValue(privateNetworkHostname(data.DnsName.ValueString()))
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("hostname"), data.Hostname)...)
+
+	// The name only needs to be available if it is going to be claimed by this plan
+	if state != nil && state.DnsName.Equal(data.DnsName) && state.EnvironmentId.Equal(data.EnvironmentId) {
+		return
+	}
+
+	if r.client == nil || data.EnvironmentId.IsUnknown() || data.ServiceId.IsUnknown() {
+		return
+	}
+
+	networks, err := listPrivateNetworks(ctx, *r.client, data.EnvironmentId.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list private networks, got error: %s", err))
+		return
+	}
+
+	// The private network is created along with the endpoint in this case
+	if len(networks.PrivateNetworks) == 0 {
+		return
+	}
+
+	privateNetworkId := networks.PrivateNetworks[0].PublicId
+
+	current, err := getPrivateNetworkEndpoint(ctx, *r.client, data.EnvironmentId.ValueString(), privateNetworkId, data.ServiceId.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read private network endpoint, got error: %s", err))
+		return
+	}
+
+	// The service already has an endpoint with this name, which is the one being claimed
+	if current.PrivateNetworkEndpoint != nil && current.PrivateNetworkEndpoint.DnsName == data.DnsName.ValueString() {
+		return
+	}
+
+	response, err := privateNetworkEndpointNameAvailable(ctx, *r.client, data.EnvironmentId.ValueString(), privateNetworkId, data.DnsName.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to check private network endpoint name availability, got error: %s", err))
+		return
+	}
+
+	if !response.PrivateNetworkEndpointNameAvailable {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("dns_name"),
+			"Unavailable DNS name",
+			fmt.Sprintf("DNS name %q is already taken in the private network of the environment.", data.DnsName.ValueString()),
+		)
+	}
+}
+
+func (r *PrivateNetworkEndpointResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *PrivateNetworkEndpointResourceModel
+	var tags []string
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &tags, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service, err := getService(ctx, *r.client, data.ServiceId.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read service, got error: %s", err))
+		return
+	}
+
+	privateNetworkId, err := findOrCreatePrivateNetwork(ctx, *r.client, service.Service.ProjectId, data.EnvironmentId.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find private network, got error: %s", err))
+		return
+	}
+
+	existing, err := getPrivateNetworkEndpoint(ctx, *r.client, data.EnvironmentId.ValueString(), privateNetworkId, data.ServiceId.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read private network endpoint, got error: %s", err))
+		return
+	}
+
+	// The existing endpoint of the service is adopted, so its name is restored on destroy
+	data.OriginalDnsName = types.StringNull()
+
+	if existing.PrivateNetworkEndpoint != nil {
+		data.OriginalDnsName = types.StringValue(existing.PrivateNetworkEndpoint.DnsName)
+	}
+
+	input := PrivateNetworkEndpointCreateOrGetInput{
+		EnvironmentId:    data.EnvironmentId.ValueString(),
+		PrivateNetworkId: privateNetworkId,
+		ServiceId:        data.ServiceId.ValueString(),
+		ServiceName:      service.Service.Name,
+		Tags:             tags,
+	}
+
+	response, err := createOrGetPrivateNetworkEndpoint(ctx, *r.client, input)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create private network endpoint, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created a private network endpoint")
+
+	endpoint := response.PrivateNetworkEndpointCreateOrGet.PrivateNetworkEndpoint
+
+	// Endpoints are created with a name derived from the service name
+	if endpoint.DnsName != data.DnsName.ValueString() {
+		_, err := renamePrivateNetworkEndpoint(ctx, *r.client, endpoint.PublicId, privateNetworkId, data.DnsName.ValueString())
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to rename private network endpoint, got error: %s", err))
+			return
+		}
+
+		tflog.Trace(ctx, "renamed a private network endpoint")
+	}
+
+	data.ProjectId = types.StringValue(service.Service.ProjectId)
+
+	err = getAndBuildPrivateNetworkEndpoint(ctx, *r.client, data.EnvironmentId.ValueString(), privateNetworkId, data.ServiceId.ValueString(), data)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read private network endpoint after creating it, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PrivateNetworkEndpointResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *PrivateNetworkEndpointResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := getAndBuildPrivateNetworkEndpoint(ctx, *r.client, data.EnvironmentId.ValueString(), data.PrivateNetworkId.ValueString(), data.ServiceId.ValueString(), data)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read private network endpoint, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PrivateNetworkEndpointResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *PrivateNetworkEndpointResourceModel
+	var state *PrivateNetworkEndpointResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.DnsName.Equal(state.DnsName) {
+		_, err := renamePrivateNetworkEndpoint(ctx, *r.client, state.Id.ValueString(), state.PrivateNetworkId.ValueString(), data.DnsName.ValueString())
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to rename private network endpoint, got error: %s", err))
+			return
+		}
+
+		tflog.Trace(ctx, "renamed a private network endpoint")
+	}
+
+	data.ProjectId = state.ProjectId
+
+	err := getAndBuildPrivateNetworkEndpoint(ctx, *r.client, state.EnvironmentId.ValueString(), state.PrivateNetworkId.ValueString(), state.ServiceId.ValueString(), data)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read private network endpoint after updating it, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PrivateNetworkEndpointResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *PrivateNetworkEndpointResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Endpoints which existed before being managed by terraform are kept with their original name
+	if !data.OriginalDnsName.IsNull() {
+		if !data.OriginalDnsName.Equal(data.DnsName) {
+			_, err := renamePrivateNetworkEndpoint(ctx, *r.client, data.Id.ValueString(), data.PrivateNetworkId.ValueString(), data.OriginalDnsName.ValueString())
+
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to restore the original name of private network endpoint, got error: %s", err))
+				return
+			}
+
+			tflog.Trace(ctx, "renamed a private network endpoint")
+		}
+
+		return
+	}
+
+	_, err := deletePrivateNetworkEndpoint(ctx, *r.client, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete private network endpoint, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a private network endpoint")
+}
+
+func (r *PrivateNetworkEndpointResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, ":")
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: service_id:environment_name. Got: %q", req.ID),
+		)
+
+		return
+	}
+
+	service, err := getService(ctx, *r.client, parts[0])
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read service, got error: %s", err))
+		return
+	}
+
+	projectId := service.Service.ProjectId
+	environmentId, err := findEnvironment(ctx, *r.client, projectId, parts[1])
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read environment, got error: %s", err))
+		return
+	}
+
+	networks, err := listPrivateNetworks(ctx, *r.client, *environmentId)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list private networks, got error: %s", err))
+		return
+	}
+
+	if len(networks.PrivateNetworks) == 0 {
+		resp.Diagnostics.AddError("Client Error", "Unable to find private network for environment")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_id"), environmentId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("private_network_id"), networks.PrivateNetworks[0].PublicId)...)
+
+	// Imported endpoints already existed, so they are kept on destroy
+	endpoint, err := getPrivateNetworkEndpoint(ctx, *r.client, *environmentId, networks.PrivateNetworks[0].PublicId, parts[0])
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read private network endpoint, got error: %s", err))
+		return
+	}
+
+	if endpoint.PrivateNetworkEndpoint != nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("original_dns_name"), endpoint.PrivateNetworkEndpoint.DnsName)...)
+	}
+}
+
+func privateNetworkHostname(dnsName string) string {
+	return dnsName + "." + privateNetworkDomainSuffix
+}
+
+// findOrCreatePrivateNetwork returns the identifier of the private network of the environment. Railway creates
+// one for every environment, but older environments might not have one yet.
+func findOrCreatePrivateNetwork(ctx context.Context, client graphql.Client, projectId string, environmentId string) (string, error) {
+	networks, err := listPrivateNetworks(ctx, client, environmentId)
+
+	if err != nil {
+		return "", err
+	}
+
+	if len(networks.PrivateNetworks) > 0 {
+		return networks.PrivateNetworks[0].PublicId, nil
+	}
+
+	response, err := createOrGetPrivateNetwork(ctx, client, PrivateNetworkCreateOrGetInput{
+		EnvironmentId: environmentId,
+		Name:          environmentId,
+		ProjectId:     projectId,
+		Tags:          []string{},
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	tflog.Trace(ctx, "created a private network")
+
+	return response.PrivateNetworkCreateOrGet.PublicId, nil
+}
+
+func getAndBuildPrivateNetworkEndpoint(ctx context.Context, client graphql.Client, environmentId string, privateNetworkId string, serviceId string, data *PrivateNetworkEndpointResourceModel) error {
+	response, err := getPrivateNetworkEndpoint(ctx, client, environmentId, privateNetworkId, serviceId)
+
+	if err != nil {
+		return err
+	}
+
+	if response.PrivateNetworkEndpoint == nil {
+		return fmt.Errorf("private network endpoint doesn't exist")
+	}
+
+	endpoint := response.PrivateNetworkEndpoint.PrivateNetworkEndpoint
+
+	tags := make([]attr.Value, 0, len(endpoint.Tags))
+
+	for _, tag := range endpoint.Tags {
+		tags = append(tags, types.StringValue(tag))
+	}
+
+	privateIps := make([]attr.Value, 0, len(endpoint.PrivateIps))
+
+	for _, ip := range endpoint.PrivateIps {
+		privateIps = append(privateIps, types.StringValue(ip))
+	}
+
+	data.Id = types.StringValue(endpoint.PublicId)
+	data.DnsName = types.StringValue(endpoint.DnsName)
+	data.Hostname = types.StringValue(privateNetworkHostname(endpoint.DnsName))
+	data.Tags = types.SetValueMust(types.StringType, tags)
+	data.PrivateIps = types.ListValueMust(types.StringType, privateIps)
+	data.EnvironmentId = types.StringValue(environmentId)
+	data.ServiceId = types.StringValue(serviceId)
+	data.PrivateNetworkId = types.StringValue(privateNetworkId)
+
+	if data.ProjectId.IsNull() || data.ProjectId.IsUnknown() {
+		service, err := getService(ctx, client, serviceId)
+
+		if err != nil {
+			return err
+		}
+
+		data.ProjectId = types.StringValue(service.Service.ProjectId)
+	}
+
+	return nil
+}
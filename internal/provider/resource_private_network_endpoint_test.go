@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPrivateNetworkEndpointResourceDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccPrivateNetworkEndpointResourceConfigDefault("terraform-tester"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("railway_private_network_endpoint.test", "id"),
+					resource.TestCheckResourceAttr("railway_private_network_endpoint.test", "dns_name", "terraform-tester"),
+					resource.TestCheckResourceAttr("railway_private_network_endpoint.test", "hostname", "terraform-tester.railway.internal"),
+					resource.TestCheckResourceAttr("railway_private_network_endpoint.test", "tags.#", "0"),
+					resource.TestCheckResourceAttr("railway_private_network_endpoint.test", "environment_id", "d0519b29-5d12-4857-a5dd-76fa7418336c"),
+					resource.TestCheckResourceAttr("railway_private_network_endpoint.test", "service_id", "39da7e07-fa3a-42fd-b695-d229319f2993"),
+					resource.TestCheckResourceAttr("railway_private_network_endpoint.test", "project_id", "0bb01547-570d-4109-a5e8-138691f6a2d1"),
+					resource.TestCheckResourceAttrSet("railway_private_network_endpoint.test", "private_network_id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "railway_private_network_endpoint.test",
+				ImportState:             true,
+				ImportStateId:           "39da7e07-fa3a-42fd-b695-d229319f2993:staging",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"original_dns_name"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccPrivateNetworkEndpointResourceConfigDefault("terraform-tester-2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("railway_private_network_endpoint.test", "id"),
+					resource.TestCheckResourceAttr("railway_private_network_endpoint.test", "dns_name", "terraform-tester-2"),
+					resource.TestCheckResourceAttr("railway_private_network_endpoint.test", "hostname", "terraform-tester-2.railway.internal"),
+					resource.TestCheckResourceAttr("railway_private_network_endpoint.test", "tags.#", "0"),
+					resource.TestCheckResourceAttr("railway_private_network_endpoint.test", "environment_id", "d0519b29-5d12-4857-a5dd-76fa7418336c"),
+					resource.TestCheckResourceAttr("railway_private_network_endpoint.test", "service_id", "39da7e07-fa3a-42fd-b695-d229319f2993"),
+					resource.TestCheckResourceAttr("railway_private_network_endpoint.test", "project_id", "0bb01547-570d-4109-a5e8-138691f6a2d1"),
+					resource.TestCheckResourceAttrSet("railway_private_network_endpoint.test", "private_network_id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "railway_private_network_endpoint.test",
+				ImportState:             true,
+				ImportStateId:           "39da7e07-fa3a-42fd-b695-d229319f2993:staging",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"original_dns_name"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccPrivateNetworkEndpointResourceConfigDefault(name string) string {
+	return fmt.Sprintf(`
+resource "railway_private_network_endpoint" "test" {
+  dns_name = "%s"
+  environment_id = "d0519b29-5d12-4857-a5dd-76fa7418336c"
+  service_id = "39da7e07-fa3a-42fd-b695-d229319f2993"
+}
+`, name)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"time"
 
 	"github.com/Khan/genqlient/graphql"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -24,6 +25,7 @@ import (
 
 var _ resource.Resource = &ProjectResource{}
 var _ resource.ResourceWithImportState = &ProjectResource{}
+var _ resource.ResourceWithModifyPlan = &ProjectResource{}
 
 func NewProjectResource() resource.Resource {
 	return &ProjectResource{}
@@ -44,13 +46,16 @@ var defaultEnvironmentAttrTypes = map[string]attr.Type{
 }
 
 type ProjectResourceModel struct {
-	Id                 types.String `tfsdk:"id"`
-	Name               types.String `tfsdk:"name"`
-	Description        types.String `tfsdk:"description"`
-	Private            types.Bool   `tfsdk:"private"`
-	HasPrDeploys       types.Bool   `tfsdk:"has_pr_deploys"`
-	WorkspaceId        types.String `tfsdk:"workspace_id"`
-	DefaultEnvironment types.Object `tfsdk:"default_environment"`
+	Id                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	Description         types.String `tfsdk:"description"`
+	Private             types.Bool   `tfsdk:"private"`
+	HasPrDeploys        types.Bool   `tfsdk:"has_pr_deploys"`
+	WorkspaceId         types.String `tfsdk:"workspace_id"`
+	DefaultEnvironment  types.Object `tfsdk:"default_environment"`
+	DeletionProtection  types.Bool   `tfsdk:"deletion_protection"`
+	DeleteViaSchedule   types.Bool   `tfsdk:"delete_via_schedule"`
+	DeletionScheduledAt types.String `tfsdk:"deletion_scheduled_at"`
 }
 
 func (r *ProjectResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -140,10 +145,58 @@ func (r *ProjectResource) Schema(ctx context.Context, req resource.SchemaRequest
 					},
 				},
 			},
+			"deletion_protection": schema.BoolAttribute{
+				MarkdownDescription: "Whether the project is protected from being deleted. It needs to be turned off and applied before the project can be destroyed. **Default** `false`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"delete_via_schedule": schema.BoolAttribute{
+				MarkdownDescription: "Whether destroying the project schedules its deletion after a 48 hour grace period instead of deleting it immediately. A scheduled deletion is cancelled by importing the project again and applying. **Default** `false`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"deletion_scheduled_at": schema.StringAttribute{
+				MarkdownDescription: "Time the deletion of the project was scheduled at in RFC 3339 format. Applying cancels the scheduled deletion.",
+				Computed:            true,
+			},
 		},
 	}
 }
 
+func (r *ProjectResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var state *ProjectResourceModel
+
+	// Nothing to plan on create
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Fail the plan before anything in the project is destroyed
+	if req.Plan.Raw.IsNull() {
+		if state.DeletionProtection.ValueBool() {
+			resp.Diagnostics.AddError(
+				"Project Deletion Protected",
+				fmt.Sprintf("Unable to delete project %s, set `deletion_protection` to false and apply before destroying it", state.Id.ValueString()),
+			)
+		}
+
+		return
+	}
+
+	// A scheduled deletion is cancelled on update
+	if !state.DeletionScheduledAt.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("deletion_scheduled_at"), types.StringNull())...)
+	}
+}
+
 func (r *ProjectResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -209,6 +262,7 @@ func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest
 	data.Description = types.StringValue(project.Description)
 	data.Private = types.BoolValue(!project.IsPublic)
 	data.HasPrDeploys = types.BoolValue(project.PrDeploys)
+	data.DeletionScheduledAt = buildProjectDeletionScheduledAt(project.DeletedAt)
 
 	if project.Workspace != nil {
 		data.WorkspaceId = types.StringValue(project.Workspace.Id)
@@ -253,6 +307,7 @@ func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, re
 	data.Description = types.StringValue(project.Description)
 	data.Private = types.BoolValue(!project.IsPublic)
 	data.HasPrDeploys = types.BoolValue(project.PrDeploys)
+	data.DeletionScheduledAt = buildProjectDeletionScheduledAt(project.DeletedAt)
 
 	if project.Workspace != nil {
 		data.WorkspaceId = types.StringValue(project.Workspace.Id)
@@ -271,9 +326,11 @@ func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var data *ProjectResourceModel
+	var state *ProjectResourceModel
 	var defaultEnvironmentData *ProjectResourceDefaultEnvironmentModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -292,6 +349,17 @@ func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	if !state.DeletionScheduledAt.IsNull() {
+		_, err := cancelScheduledDeleteProject(ctx, *r.client, data.Id.ValueString())
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to cancel scheduled deletion of project, got error: %s", err))
+			return
+		}
+
+		tflog.Trace(ctx, "cancelled scheduled deletion of a project")
+	}
+
 	response, err := updateProject(ctx, *r.client, data.Id.ValueString(), input)
 
 	if err != nil {
@@ -315,6 +383,7 @@ func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest
 	data.Description = types.StringValue(project.Description)
 	data.Private = types.BoolValue(!project.IsPublic)
 	data.HasPrDeploys = types.BoolValue(project.PrDeploys)
+	data.DeletionScheduledAt = buildProjectDeletionScheduledAt(project.DeletedAt)
 
 	if project.Workspace != nil {
 		data.WorkspaceId = types.StringValue(project.Workspace.Id)
@@ -342,6 +411,28 @@ func (r *ProjectResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	if data.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Project Deletion Protected",
+			fmt.Sprintf("Unable to delete project %s, set `deletion_protection` to false and apply before destroying it", data.Id.ValueString()),
+		)
+
+		return
+	}
+
+	if data.DeleteViaSchedule.ValueBool() {
+		_, err := scheduleDeleteProject(ctx, *r.client, data.Id.ValueString())
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to schedule deletion of project, got error: %s", err))
+			return
+		}
+
+		tflog.Trace(ctx, "scheduled deletion of a project")
+
+		return
+	}
+
 	_, err := deleteProject(ctx, *r.client, data.Id.ValueString())
 
 	if err != nil {
@@ -354,6 +445,16 @@ func (r *ProjectResource) Delete(ctx context.Context, req resource.DeleteRequest
 
 func (r *ProjectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deletion_protection"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("delete_via_schedule"), false)...)
+}
+
+func buildProjectDeletionScheduledAt(deletedAt time.Time) types.String {
+	if deletedAt.IsZero() {
+		return types.StringNull()
+	}
+
+	return types.StringValue(deletedAt.Format(time.RFC3339))
 }
 
 func defaultEnvironmentForProject(ctx context.Context, client graphql.Client, projectId string) (*Project, *ProjectEnvironmentsProjectEnvironmentsConnectionEdgesProjectEnvironmentsConnectionEdgeNodeEnvironment, error) {
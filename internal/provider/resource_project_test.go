@@ -2,6 +2,7 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -24,6 +25,9 @@ func TestAccProjectResourceDefault(t *testing.T) {
 					resource.TestCheckResourceAttr("railway_project.test", "has_pr_deploys", "false"),
 					resource.TestMatchResourceAttr("railway_project.test", "default_environment.id", uuidRegex()),
 					resource.TestCheckResourceAttr("railway_project.test", "default_environment.name", "production"),
+					resource.TestCheckResourceAttr("railway_project.test", "deletion_protection", "false"),
+					resource.TestCheckResourceAttr("railway_project.test", "delete_via_schedule", "false"),
+					resource.TestCheckNoResourceAttr("railway_project.test", "deletion_scheduled_at"),
 				),
 			},
 			// ImportState testing
@@ -135,6 +139,47 @@ func TestAccProjectResourceNonDefault(t *testing.T) {
 	})
 }
 
+func TestAccProjectResourceDeletionProtection(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProjectResourceConfigDeletionProtection("todo-app", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("railway_project.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("railway_project.test", "name", "todo-app"),
+					resource.TestCheckResourceAttr("railway_project.test", "deletion_protection", "true"),
+				),
+			},
+			// Delete is blocked at plan time while protected
+			{
+				Config:      testAccProjectResourceConfigDeletionProtection("todo-app", true),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("Project Deletion Protected"),
+			},
+			// Services in the project are left untouched
+			{
+				Config: testAccProjectResourceConfigDeletionProtection("todo-app", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("railway_service.test", "id", uuidRegex()),
+				),
+				PlanOnly: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccProjectResourceConfigDeletionProtection("todo-app", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("railway_project.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("railway_project.test", "deletion_protection", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
 func testAccProjectResourceConfigDefault(name string) string {
 	return fmt.Sprintf(`
 resource "railway_project" "test" {
@@ -170,3 +215,17 @@ resource "railway_project" "test" {
 }
 `, name, environmentName)
 }
+
+func testAccProjectResourceConfigDeletionProtection(name string, deletionProtection bool) string {
+	return fmt.Sprintf(`
+resource "railway_project" "test" {
+  name = "%s"
+  deletion_protection = %t
+}
+
+resource "railway_service" "test" {
+  name = "todo-service"
+  project_id = railway_project.test.id
+}
+`, name, deletionProtection)
+}
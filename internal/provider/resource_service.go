@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"maps"
 	"slices"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
@@ -71,6 +72,7 @@ type ServiceResourceModel struct {
 	Name                               types.String `tfsdk:"name"`
 	ProjectId                          types.String `tfsdk:"project_id"`
 	CronSchedule                       types.String `tfsdk:"cron_schedule"`
+	NextCronRunAt                      types.String `tfsdk:"next_cron_run_at"`
 	SourceImage                        types.String `tfsdk:"source_image"`
 	SourceImagePrivateRegistryUsername types.String `tfsdk:"source_image_registry_username"`
 	SourceImagePrivateRegistryPassword types.String `tfsdk:"source_image_registry_password"`
@@ -121,6 +123,10 @@ func (r *ServiceResource) Schema(ctx context.Context, req resource.SchemaRequest
 					stringvalidator.UTF8LengthAtLeast(9),
 				},
 			},
+			"next_cron_run_at": schema.StringAttribute{
+				MarkdownDescription: "Time of the next cron run of the service in RFC 3339 format.",
+				Computed:            true,
+			},
 			"source_image": schema.StringAttribute{
 				MarkdownDescription: "Source image of the service. Conflicts with `source_repo`, `source_repo_branch`, `root_directory` and `config_path`.",
 				Optional:            true,
@@ -751,6 +757,12 @@ func getAndBuildServiceInstance(ctx context.Context, client graphql.Client, proj
 		data.CronSchedule = types.StringValue(*response.ServiceInstance.CronSchedule)
 	}
 
+	if response.ServiceInstance.NextCronRunAt.IsZero() {
+		data.NextCronRunAt = types.StringNull()
+	} else {
+		data.NextCronRunAt = types.StringValue(response.ServiceInstance.NextCronRunAt.Format(time.RFC3339))
+	}
+
 	if response.ServiceInstance.RootDirectory != nil && len(*response.ServiceInstance.RootDirectory) != 0 {
 		data.RootDirectory = types.StringValue(*response.ServiceInstance.RootDirectory)
 	}
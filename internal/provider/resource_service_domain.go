@@ -6,10 +6,12 @@ import (
 	"strings"
 
 	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -19,6 +21,10 @@ import (
 
 var _ resource.Resource = &ServiceDomainResource{}
 var _ resource.ResourceWithImportState = &ServiceDomainResource{}
+var _ resource.ResourceWithModifyPlan = &ServiceDomainResource{}
+
+// Railway generates service domains under this suffix
+const defaultServiceDomainSuffix = "up.railway.app"
 
 func NewServiceDomainResource() resource.Resource {
 	return &ServiceDomainResource{}
@@ -31,6 +37,7 @@ type ServiceDomainResource struct {
 type ServiceDomainResourceModel struct {
 	Id            types.String `tfsdk:"id"`
 	Subdomain     types.String `tfsdk:"subdomain"`
+	TargetPort    types.Int64  `tfsdk:"target_port"`
 	EnvironmentId types.String `tfsdk:"environment_id"`
 	ServiceId     types.String `tfsdk:"service_id"`
 	ProjectId     types.String `tfsdk:"project_id"`
@@ -49,14 +56,33 @@ func (r *ServiceDomainResource) Schema(ctx context.Context, req resource.SchemaR
 			"id": schema.StringAttribute{
 				MarkdownDescription: "Identifier of the service domain.",
 				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"subdomain": schema.StringAttribute{
-				MarkdownDescription: "Subdomain of the service domain.",
-				Required:            true,
+				MarkdownDescription: "Subdomain of the service domain. Railway generates one when not set.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 				Validators: []validator.String{
 					stringvalidator.UTF8LengthAtLeast(1),
 				},
 			},
+			"target_port": schema.Int64Attribute{
+				MarkdownDescription: "Target port of the service for the service domain. Railway picks one when not set, and removing it keeps the current target port.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+					int64validator.AtMost(65535),
+				},
+			},
 			"environment_id": schema.StringAttribute{
 				MarkdownDescription: "Identifier of the environment the service domain belongs to.",
 				Required:            true,
@@ -80,10 +106,16 @@ func (r *ServiceDomainResource) Schema(ctx context.Context, req resource.SchemaR
 			"project_id": schema.StringAttribute{
 				MarkdownDescription: "Identifier of the project the service domain belongs to.",
 				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"suffix": schema.StringAttribute{
 				MarkdownDescription: "Suffix of the service domain.",
 				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"domain": schema.StringAttribute{
 				MarkdownDescription: "Full domain of the service domain.",
@@ -113,6 +145,46 @@ func (r *ServiceDomainResource) Configure(ctx context.Context, req resource.Conf
 	r.client = client
 }
 
+func (r *ServiceDomainResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy or before the provider is configured
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var data, state *ServiceDomainResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.Subdomain.IsNull() || data.Subdomain.IsUnknown() {
+		return
+	}
+
+	suffix := defaultServiceDomainSuffix
+
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+		if resp.Diagnostics.HasError() || data.Subdomain.Equal(state.Subdomain) {
+			return
+		}
+
+		suffix = state.Suffix.ValueString()
+	}
+
+	domain := data.Subdomain.ValueString() + "." + suffix
+
+	response, err := checkServiceDomainAvailable(ctx, *r.client, domain)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to check service domain availability, got error: %s", err))
+		return
+	}
+
+	if !response.ServiceDomainAvailable.Available {
+		resp.Diagnostics.AddAttributeError(path.Root("subdomain"), "Service Domain Not Available", fmt.Sprintf("Service domain %s is not available: %s", domain, response.ServiceDomainAvailable.Message))
+	}
+}
+
 func (r *ServiceDomainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data *ServiceDomainResourceModel
 
@@ -125,6 +197,7 @@ func (r *ServiceDomainResource) Create(ctx context.Context, req resource.CreateR
 	input := ServiceDomainCreateInput{
 		ServiceId:     data.ServiceId.ValueString(),
 		EnvironmentId: data.EnvironmentId.ValueString(),
+		TargetPort:    getServiceDomainTargetPort(data),
 	}
 
 	response, err := createServiceDomain(ctx, *r.client, input)
@@ -137,29 +210,35 @@ func (r *ServiceDomainResource) Create(ctx context.Context, req resource.CreateR
 	tflog.Trace(ctx, "created a service domain")
 
 	domain := response.ServiceDomainCreate.ServiceDomain
-	domainName := data.Subdomain.ValueString() + "." + domain.Suffix
+	domainName := domain.Domain
+
+	// Keep the generated subdomain unless one is configured
+	if !data.Subdomain.IsUnknown() && !data.Subdomain.IsNull() {
+		domainName = data.Subdomain.ValueString() + "." + domain.Suffix
+
+		updateInput := ServiceDomainUpdateInput{
+			ServiceDomainId: domain.Id,
+			Domain:          domainName,
+			ServiceId:       data.ServiceId.ValueString(),
+			EnvironmentId:   data.EnvironmentId.ValueString(),
+			TargetPort:      getServiceDomainTargetPort(data),
+		}
 
-	updateInput := ServiceDomainUpdateInput{
-		ServiceDomainId: domain.Id,
-		Domain:          domainName,
-		ServiceId:       data.ServiceId.ValueString(),
-		EnvironmentId:   data.EnvironmentId.ValueString(),
-	}
+		updateResponse, err := updateServiceDomain(ctx, *r.client, updateInput)
 
-	updateResponse, err := updateServiceDomain(ctx, *r.client, updateInput)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update service domain, got error: %s", err))
+			return
+		}
 
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update service domain, got error: %s", err))
-		return
-	}
+		if !updateResponse.ServiceDomainUpdate {
+			resp.Diagnostics.AddError("Client Error", "Unable to update service domain, got false as response")
+			return
+		}
 
-	if !updateResponse.ServiceDomainUpdate {
-		resp.Diagnostics.AddError("Client Error", "Unable to update service domain, got false as response")
-		return
+		tflog.Trace(ctx, "updated a service domain")
 	}
 
-	tflog.Trace(ctx, "updated a service domain")
-
 	service, err := getService(ctx, *r.client, domain.ServiceId)
 
 	if err != nil {
@@ -219,6 +298,7 @@ func (r *ServiceDomainResource) Update(ctx context.Context, req resource.UpdateR
 		Domain:          domainName,
 		ServiceId:       data.ServiceId.ValueString(),
 		EnvironmentId:   data.EnvironmentId.ValueString(),
+		TargetPort:      getServiceDomainTargetPort(data),
 	}
 
 	response, err := updateServiceDomain(ctx, *r.client, updateInput)
@@ -326,8 +406,24 @@ func getAndBuildServiceDomain(ctx context.Context, client graphql.Client, projec
 	data.Suffix = types.StringValue(serviceDomain.Suffix)
 	data.Domain = types.StringValue(serviceDomain.Domain)
 
+	if serviceDomain.TargetPort == 0 {
+		data.TargetPort = types.Int64Null()
+	} else {
+		data.TargetPort = types.Int64Value(int64(serviceDomain.TargetPort))
+	}
+
 	data.Subdomain = types.StringValue(serviceDomain.Domain[:len(serviceDomain.Domain)-len(serviceDomain.Suffix)-1])
 	data.ProjectId = types.StringValue(projectId)
 
 	return nil
 }
+
+func getServiceDomainTargetPort(data *ServiceDomainResourceModel) *int {
+	if data.TargetPort.IsNull() || data.TargetPort.IsUnknown() {
+		return nil
+	}
+
+	value := int(data.TargetPort.ValueInt64())
+
+	return &value
+}
@@ -70,6 +70,44 @@ func TestAccServiceDomainResourceDefault(t *testing.T) {
 	})
 }
 
+func TestAccServiceDomainResourceNonDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccServiceDomainResourceConfigNonDefault(8080),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("railway_service_domain.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttrSet("railway_service_domain.test", "subdomain"),
+					resource.TestCheckResourceAttr("railway_service_domain.test", "target_port", "8080"),
+					resource.TestCheckResourceAttr("railway_service_domain.test", "suffix", "up.railway.app"),
+					resource.TestCheckResourceAttrSet("railway_service_domain.test", "domain"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccServiceDomainResourceConfigNonDefault(3000),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("railway_service_domain.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttrSet("railway_service_domain.test", "subdomain"),
+					resource.TestCheckResourceAttr("railway_service_domain.test", "target_port", "3000"),
+				),
+			},
+			// Update with target port removed
+			{
+				Config: testAccServiceDomainResourceConfigGenerated(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("railway_service_domain.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("railway_service_domain.test", "target_port", "3000"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
 func testAccServiceDomainResourceConfigDefault(name string) string {
 	return fmt.Sprintf(`
 resource "railway_service_domain" "test" {
@@ -79,3 +117,22 @@ resource "railway_service_domain" "test" {
 }
 `, name)
 }
+
+func testAccServiceDomainResourceConfigNonDefault(port int) string {
+	return fmt.Sprintf(`
+resource "railway_service_domain" "test" {
+  target_port = %d
+  environment_id = "d0519b29-5d12-4857-a5dd-76fa7418336c"
+  service_id = "39da7e07-fa3a-42fd-b695-d229319f2993"
+}
+`, port)
+}
+
+func testAccServiceDomainResourceConfigGenerated() string {
+	return `
+resource "railway_service_domain" "test" {
+  environment_id = "d0519b29-5d12-4857-a5dd-76fa7418336c"
+  service_id = "39da7e07-fa3a-42fd-b695-d229319f2993"
+}
+`
+}
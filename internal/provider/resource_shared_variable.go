@@ -6,7 +6,9 @@ import (
 	"strings"
 
 	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -29,11 +31,12 @@ type SharedVariableResource struct {
 }
 
 type SharedVariableResourceModel struct {
-	Id            types.String `tfsdk:"id"`
-	Name          types.String `tfsdk:"name"`
-	Value         types.String `tfsdk:"value"`
-	EnvironmentId types.String `tfsdk:"environment_id"`
-	ProjectId     types.String `tfsdk:"project_id"`
+	Id                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Value             types.String `tfsdk:"value"`
+	EnvironmentId     types.String `tfsdk:"environment_id"`
+	ProjectId         types.String `tfsdk:"project_id"`
+	EnabledServiceIds types.Set    `tfsdk:"enabled_service_ids"`
 }
 
 func (r *SharedVariableResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -83,6 +86,14 @@ func (r *SharedVariableResource) Schema(ctx context.Context, req resource.Schema
 					stringvalidator.RegexMatches(uuidRegex(), "must be an id"),
 				},
 			},
+			"enabled_service_ids": schema.SetAttribute{
+				MarkdownDescription: "Identifiers of the services the variable is shared with. The variable is not shared with any other service of the project. When not set, the services the variable is shared with are not managed, so removing it keeps the variable shared with the same services.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.RegexMatches(uuidRegex(), "must be an id")),
+				},
+			},
 		},
 	}
 }
@@ -132,6 +143,15 @@ func (r *SharedVariableResource) Create(ctx context.Context, req resource.Create
 
 	tflog.Trace(ctx, "created a shared variable")
 
+	if !data.EnabledServiceIds.IsNull() {
+		err = configureSharedVariableServices(ctx, *r.client, data)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to configure services of shared variable, got error: %s", err))
+			return
+		}
+	}
+
 	err = getSharedVariable(ctx, *r.client, data.ProjectId.ValueString(), data.EnvironmentId.ValueString(), data.Name.ValueString(), data)
 
 	if err != nil {
@@ -163,6 +183,7 @@ func (r *SharedVariableResource) Read(ctx context.Context, req resource.ReadRequ
 
 func (r *SharedVariableResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var data *SharedVariableResourceModel
+	var state *SharedVariableResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 
@@ -170,6 +191,12 @@ func (r *SharedVariableResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	input := VariableUpsertInput{
 		Name:          data.Name.ValueString(),
 		Value:         data.Value.ValueString(),
@@ -186,6 +213,15 @@ func (r *SharedVariableResource) Update(ctx context.Context, req resource.Update
 
 	tflog.Trace(ctx, "updated a shared variable")
 
+	if !data.EnabledServiceIds.IsNull() && !data.EnabledServiceIds.Equal(state.EnabledServiceIds) {
+		err = configureSharedVariableServices(ctx, *r.client, data)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to configure services of shared variable, got error: %s", err))
+			return
+		}
+	}
+
 	err = getSharedVariable(ctx, *r.client, data.ProjectId.ValueString(), data.EnvironmentId.ValueString(), data.Name.ValueString(), data)
 
 	if err != nil {
@@ -260,5 +296,72 @@ func getSharedVariable(ctx context.Context, client graphql.Client, projectId str
 		data.EnvironmentId = types.StringValue(environmentId)
 	}
 
+	// Reading the services needs a request per service, so it is only done when they are managed
+	if data.EnabledServiceIds.IsNull() {
+		return nil
+	}
+
+	services, err := listProjectServices(ctx, client, projectId)
+
+	if err != nil {
+		return err
+	}
+
+	// A service sees the shared variable when it has a variable referencing it
+	reference := fmt.Sprintf("${{shared.%s}}", name)
+	enabledServiceIds := make([]attr.Value, 0)
+
+	for _, service := range services.Project.Services.Edges {
+		variables, err := getVariables(ctx, client, projectId, environmentId, service.Node.Id)
+
+		if err != nil {
+			return err
+		}
+
+		if value, ok := variables.Variables[name]; ok && fmt.Sprintf("%v", value) == reference {
+			enabledServiceIds = append(enabledServiceIds, types.StringValue(service.Node.Id))
+		}
+	}
+
+	data.EnabledServiceIds = types.SetValueMust(types.StringType, enabledServiceIds)
+
 	return nil
 }
+
+func configureSharedVariableServices(ctx context.Context, client graphql.Client, data *SharedVariableResourceModel) error {
+	services, err := listProjectServices(ctx, client, data.ProjectId.ValueString())
+
+	if err != nil {
+		return err
+	}
+
+	enabled := make(map[string]bool, len(data.EnabledServiceIds.Elements()))
+
+	for _, serviceId := range data.EnabledServiceIds.Elements() {
+		enabled[serviceId.(types.String).ValueString()] = true
+	}
+
+	input := SharedVariableConfigureInput{
+		Name:               data.Name.ValueString(),
+		EnvironmentId:      data.EnvironmentId.ValueString(),
+		ProjectId:          data.ProjectId.ValueString(),
+		EnabledServiceIds:  make([]string, 0, len(enabled)),
+		DisabledServiceIds: make([]string, 0),
+	}
+
+	for _, service := range services.Project.Services.Edges {
+		if enabled[service.Node.Id] {
+			input.EnabledServiceIds = append(input.EnabledServiceIds, service.Node.Id)
+		} else {
+			input.DisabledServiceIds = append(input.DisabledServiceIds, service.Node.Id)
+		}
+	}
+
+	if len(input.EnabledServiceIds) != len(enabled) {
+		return fmt.Errorf("some of the enabled services do not belong to the project")
+	}
+
+	_, err = configureSharedVariable(ctx, client, input)
+
+	return err
+}
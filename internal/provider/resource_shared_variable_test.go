@@ -21,6 +21,7 @@ func TestAccSharedVariableResourceDefault(t *testing.T) {
 					resource.TestCheckResourceAttr("railway_shared_variable.test", "value", "1234567890"),
 					resource.TestCheckResourceAttr("railway_shared_variable.test", "environment_id", "d0519b29-5d12-4857-a5dd-76fa7418336c"),
 					resource.TestCheckResourceAttr("railway_shared_variable.test", "project_id", "0bb01547-570d-4109-a5e8-138691f6a2d1"),
+					resource.TestCheckNoResourceAttr("railway_shared_variable.test", "enabled_service_ids"),
 				),
 			},
 			// ImportState testing
@@ -64,6 +65,43 @@ func TestAccSharedVariableResourceDefault(t *testing.T) {
 	})
 }
 
+func TestAccSharedVariableResourceNonDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSharedVariableResourceConfigNonDefault(`["39da7e07-fa3a-42fd-b695-d229319f2993"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("railway_shared_variable.test", "id", "0bb01547-570d-4109-a5e8-138691f6a2d1:d0519b29-5d12-4857-a5dd-76fa7418336c:PAYMENT_KEY"),
+					resource.TestCheckResourceAttr("railway_shared_variable.test", "name", "PAYMENT_KEY"),
+					resource.TestCheckResourceAttr("railway_shared_variable.test", "enabled_service_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttr("railway_shared_variable.test", "enabled_service_ids.*", "39da7e07-fa3a-42fd-b695-d229319f2993"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "railway_shared_variable.test",
+				ImportState:       true,
+				ImportStateId:     "0bb01547-570d-4109-a5e8-138691f6a2d1:staging:PAYMENT_KEY",
+				ImportStateVerify: true,
+				// Services are only managed once configured
+				ImportStateVerifyIgnore: []string{"enabled_service_ids"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccSharedVariableResourceConfigNonDefault(`[]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("railway_shared_variable.test", "id", "0bb01547-570d-4109-a5e8-138691f6a2d1:d0519b29-5d12-4857-a5dd-76fa7418336c:PAYMENT_KEY"),
+					resource.TestCheckResourceAttr("railway_shared_variable.test", "enabled_service_ids.#", "0"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
 func testAccSharedVariableResourceConfigDefault(value string) string {
 	return fmt.Sprintf(`
 resource "railway_shared_variable" "test" {
@@ -74,3 +112,15 @@ resource "railway_shared_variable" "test" {
 }
 `, value)
 }
+
+func testAccSharedVariableResourceConfigNonDefault(enabledServiceIds string) string {
+	return fmt.Sprintf(`
+resource "railway_shared_variable" "test" {
+  name = "PAYMENT_KEY"
+  value = "secret"
+  environment_id = "d0519b29-5d12-4857-a5dd-76fa7418336c"
+  project_id = "0bb01547-570d-4109-a5e8-138691f6a2d1"
+  enabled_service_ids = %s
+}
+`, enabledServiceIds)
+}
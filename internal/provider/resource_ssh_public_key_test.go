@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSshPublicKeyResourceDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSshPublicKeyResourceConfigDefault("terraform-tester"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("railway_ssh_public_key.test", "id"),
+					resource.TestCheckResourceAttr("railway_ssh_public_key.test", "name", "terraform-tester"),
+					resource.TestCheckResourceAttr("railway_ssh_public_key.test", "public_key", "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIA6EzGqCeBmuRLLR0x5AlLvmlkJTL3lSojl7+7h8g78C terraform-tester"),
+					resource.TestCheckResourceAttrSet("railway_ssh_public_key.test", "fingerprint"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "railway_ssh_public_key.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccSshPublicKeyResourceConfigDefault("terraform-tester-2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("railway_ssh_public_key.test", "id"),
+					resource.TestCheckResourceAttr("railway_ssh_public_key.test", "name", "terraform-tester-2"),
+					resource.TestCheckResourceAttrSet("railway_ssh_public_key.test", "fingerprint"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccSshPublicKeyResourceConfigDefault(name string) string {
+	return fmt.Sprintf(`
+resource "railway_ssh_public_key" "test" {
+  name = "%s"
+  public_key = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIA6EzGqCeBmuRLLR0x5AlLvmlkJTL3lSojl7+7h8g78C terraform-tester"
+}
+`, name)
+}
@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/Khan/genqlient/graphql"
@@ -50,9 +51,12 @@ func (r *TcpProxyResource) Schema(ctx context.Context, req resource.SchemaReques
 			"id": schema.StringAttribute{
 				MarkdownDescription: "Identifier of the TCP proxy.",
 				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"application_port": schema.Int64Attribute{
-				MarkdownDescription: "Port of the application the TCP proxy points to.",
+				MarkdownDescription: "Port of the application the TCP proxy points to. Changing it replaces the TCP proxy, which gets a new proxy port and domain.",
 				Required:            true,
 				PlanModifiers: []planmodifier.Int64{
 					int64planmodifier.RequiresReplace(),
@@ -85,10 +89,16 @@ func (r *TcpProxyResource) Schema(ctx context.Context, req resource.SchemaReques
 			"proxy_port": schema.Int64Attribute{
 				MarkdownDescription: "Port of the TCP proxy.",
 				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
 			},
 			"domain": schema.StringAttribute{
 				MarkdownDescription: "Domain of the TCP proxy.",
 				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 		},
 	}
@@ -123,6 +133,18 @@ func (r *TcpProxyResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	existing, err := getTcpProxy(ctx, *r.client, data.EnvironmentId.ValueString(), data.ServiceId.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read tcp proxies, got error: %s", err))
+		return
+	}
+
+	if findTcpProxy(existing.TcpProxies, types.StringNull(), data.ApplicationPort) != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create tcp proxy, a tcp proxy for application port %d already exists", data.ApplicationPort.ValueInt64()))
+		return
+	}
+
 	input := TCPProxyCreateInput{
 		ApplicationPort: int(data.ApplicationPort.ValueInt64()),
 		ServiceId:       data.ServiceId.ValueString(),
@@ -145,14 +167,7 @@ func (r *TcpProxyResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	proxy := response.TcpProxyCreate.TCPProxy
-
-	data.Id = types.StringValue(proxy.Id)
-	data.ApplicationPort = types.Int64Value(int64(proxy.ApplicationPort))
-	data.EnvironmentId = types.StringValue(proxy.EnvironmentId)
-	data.ServiceId = types.StringValue(proxy.ServiceId)
-	data.ProxyPort = types.Int64Value(int64(proxy.ProxyPort))
-	data.Domain = types.StringValue(proxy.Domain)
+	setTcpProxy(data, &response.TcpProxyCreate.TCPProxy)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -173,15 +188,8 @@ func (r *TcpProxyResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
-	for _, proxy := range response.TcpProxies {
-		if proxy.Id == data.Id.ValueString() {
-			data.Id = types.StringValue(proxy.Id)
-			data.ApplicationPort = types.Int64Value(int64(proxy.ApplicationPort))
-			data.EnvironmentId = types.StringValue(proxy.EnvironmentId)
-			data.ServiceId = types.StringValue(proxy.ServiceId)
-			data.ProxyPort = types.Int64Value(int64(proxy.ProxyPort))
-			data.Domain = types.StringValue(proxy.Domain)
-		}
+	if proxy := findTcpProxy(response.TcpProxies, data.Id, data.ApplicationPort); proxy != nil {
+		setTcpProxy(data, proxy)
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -224,13 +232,48 @@ func (r *TcpProxyResource) ImportState(ctx context.Context, req resource.ImportS
 	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: service_id:environment_id:tcp_proxy_id. Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: service_id:environment_id:tcp_proxy_id or service_id:environment_id:application_port. Got: %q", req.ID),
 		)
 
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[2])...)
+	// Services can have several proxies, so they can also be imported by their application port
+	if port, err := strconv.ParseInt(parts[2], 10, 64); err == nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("application_port"), port)...)
+	} else {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[2])...)
+	}
+
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_id"), parts[1])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_id"), parts[0])...)
 }
+
+// findTcpProxy returns the proxy with the given identifier or, when the identifier is not known,
+// the proxy for the given application port.
+func findTcpProxy(proxies []getTcpProxyTcpProxiesTCPProxy, id types.String, applicationPort types.Int64) *TCPProxy {
+	for _, proxy := range proxies {
+		if !id.IsNull() && !id.IsUnknown() {
+			if proxy.Id == id.ValueString() {
+				return &proxy.TCPProxy
+			}
+
+			continue
+		}
+
+		if int64(proxy.ApplicationPort) == applicationPort.ValueInt64() {
+			return &proxy.TCPProxy
+		}
+	}
+
+	return nil
+}
+
+func setTcpProxy(data *TcpProxyResourceModel, proxy *TCPProxy) {
+	data.Id = types.StringValue(proxy.Id)
+	data.ApplicationPort = types.Int64Value(int64(proxy.ApplicationPort))
+	data.EnvironmentId = types.StringValue(proxy.EnvironmentId)
+	data.ServiceId = types.StringValue(proxy.ServiceId)
+	data.ProxyPort = types.Int64Value(int64(proxy.ProxyPort))
+	data.Domain = types.StringValue(proxy.Domain)
+}
@@ -44,6 +44,43 @@ func TestAccTcpProxyResourceDefault(t *testing.T) {
 					resource.TestCheckResourceAttrSet("railway_tcp_proxy.test", "domain"),
 				),
 			},
+			// Update and Read testing
+			{
+				Config: testAccTcpProxyResourceConfigDefault(6380),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("railway_tcp_proxy.test", "id", uuidRegex()),
+					resource.TestCheckResourceAttr("railway_tcp_proxy.test", "application_port", "6380"),
+					resource.TestCheckResourceAttrSet("railway_tcp_proxy.test", "proxy_port"),
+					resource.TestCheckResourceAttrSet("railway_tcp_proxy.test", "domain"),
+				),
+			},
+			// ImportState by application port testing
+			{
+				ResourceName:      "railway_tcp_proxy.test",
+				ImportState:       true,
+				ImportStateId:     "39da7e07-fa3a-42fd-b695-d229319f2993:d0519b29-5d12-4857-a5dd-76fa7418336c:6380",
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccTcpProxyResourceMultiple(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccTcpProxyResourceConfigMultiple(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("railway_tcp_proxy.redis", "application_port", "6379"),
+					resource.TestCheckResourceAttrSet("railway_tcp_proxy.redis", "proxy_port"),
+					resource.TestCheckResourceAttr("railway_tcp_proxy.postgres", "application_port", "5432"),
+					resource.TestCheckResourceAttrSet("railway_tcp_proxy.postgres", "proxy_port"),
+				),
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
@@ -59,6 +96,22 @@ resource "railway_tcp_proxy" "test" {
 `, port)
 }
 
+func testAccTcpProxyResourceConfigMultiple() string {
+	return `
+resource "railway_tcp_proxy" "redis" {
+  application_port = 6379
+  environment_id = "d0519b29-5d12-4857-a5dd-76fa7418336c"
+  service_id = "39da7e07-fa3a-42fd-b695-d229319f2993"
+}
+
+resource "railway_tcp_proxy" "postgres" {
+  application_port = 5432
+  environment_id = "d0519b29-5d12-4857-a5dd-76fa7418336c"
+  service_id = "39da7e07-fa3a-42fd-b695-d229319f2993"
+}
+`
+}
+
 func tcpProxyImportIdFunc(state *terraform.State) (string, error) {
 	rawState, ok := state.RootModule().Resources["railway_tcp_proxy.test"]
 
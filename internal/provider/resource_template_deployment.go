@@ -0,0 +1,512 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const templateDeploymentTimeout = 20 * time.Minute
+
+var _ resource.Resource = &TemplateDeploymentResource{}
+
+func NewTemplateDeploymentResource() resource.Resource {
+	return &TemplateDeploymentResource{}
+}
+
+type TemplateDeploymentResource struct {
+	client *graphql.Client
+}
+
+type TemplateDeploymentResourceModel struct {
+	Id               types.String `tfsdk:"id"`
+	TemplateCode     types.String `tfsdk:"template_code"`
+	TemplateId       types.String `tfsdk:"template_id"`
+	ProjectId        types.String `tfsdk:"project_id"`
+	EnvironmentId    types.String `tfsdk:"environment_id"`
+	ServiceVariables types.Map    `tfsdk:"service_variables"`
+	ServiceIds       types.Map    `tfsdk:"service_ids"`
+	VolumeIds        types.Map    `tfsdk:"volume_ids"`
+}
+
+func (r *TemplateDeploymentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_template_deployment"
+}
+
+func (r *TemplateDeploymentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Railway template deployment. Deploys the services of a template into an environment and waits for the deployment to finish. Destroying it deletes the created services and volumes.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the template deployment.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"template_code": schema.StringAttribute{
+				MarkdownDescription: "Code of the template to deploy, e.g. `postgres`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"template_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the deployed template.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the project to deploy the template in.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an id"),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the environment to deploy the template in.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an id"),
+				},
+			},
+			"service_variables": schema.MapAttribute{
+				MarkdownDescription: "Variables overriding the template defaults, keyed by the name of the template service and then the name of the variable.",
+				ElementType:         types.MapType{ElemType: types.StringType},
+				Optional:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"service_ids": schema.MapAttribute{
+				MarkdownDescription: "Identifiers of the created services, keyed by service name.",
+				ElementType:         types.StringType,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"volume_ids": schema.MapAttribute{
+				MarkdownDescription: "Identifiers of the volumes attached to the created services, keyed by volume name.",
+				ElementType:         types.StringType,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *TemplateDeploymentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *TemplateDeploymentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *TemplateDeploymentResourceModel
+	var serviceVariables map[string]map[string]string
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.ServiceVariables.IsNull() {
+		resp.Diagnostics.Append(data.ServiceVariables.ElementsAs(ctx, &serviceVariables, false)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	template, err := getTemplate(ctx, *r.client, data.TemplateCode.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read template, got error: %s", err))
+		return
+	}
+
+	if template.Template.SerializedConfig == nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Template %s has no config to deploy", data.TemplateCode.ValueString()))
+		return
+	}
+
+	config := *template.Template.SerializedConfig
+
+	err = overrideTemplateVariables(config, serviceVariables)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to override template variables, got error: %s", err))
+		return
+	}
+
+	existingServices, err := listProjectServices(ctx, *r.client, data.ProjectId.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project services, got error: %s", err))
+		return
+	}
+
+	existingServiceIds := make(map[string]bool)
+
+	for _, service := range existingServices.Project.Services.Edges {
+		existingServiceIds[service.Node.Id] = true
+	}
+
+	input := TemplateDeployV2Input{
+		TemplateId:       template.Template.Id,
+		SerializedConfig: config,
+		ProjectId:        data.ProjectId.ValueStringPointer(),
+		EnvironmentId:    data.EnvironmentId.ValueStringPointer(),
+	}
+
+	response, err := deployTemplate(ctx, *r.client, input)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to deploy template, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deployed a template")
+
+	workflowId := response.TemplateDeployV2.WorkflowId
+
+	if workflowId != nil {
+		data.Id = types.StringValue(*workflowId)
+	} else {
+		data.Id = types.StringValue(fmt.Sprintf("%s:%s:%s", data.ProjectId.ValueString(), data.EnvironmentId.ValueString(), template.Template.Id))
+	}
+
+	data.TemplateId = types.StringValue(template.Template.Id)
+	data.ServiceIds = types.MapValueMust(types.StringType, map[string]attr.Value{})
+	data.VolumeIds = types.MapValueMust(types.StringType, map[string]attr.Value{})
+
+	// Keep track of the deployment right away, so nothing is orphaned when waiting for it fails
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var workflowErr error
+
+	if workflowId != nil {
+		workflowErr = waitForWorkflow(ctx, *r.client, *workflowId, templateDeploymentTimeout)
+	}
+
+	// The services created so far are saved even when the workflow failed, so that destroying them is possible
+	serviceIds, volumeIds, err := findTemplateDeploymentResources(ctx, *r.client, data.ProjectId.ValueString(), template.Template.Id, config, existingServiceIds)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read template deployment services, got error: %s", err))
+	} else {
+		data.ServiceIds = types.MapValueMust(types.StringType, serviceIds)
+		data.VolumeIds = types.MapValueMust(types.StringType, volumeIds)
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	}
+
+	if workflowErr != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to complete template deployment, got error: %s", workflowErr))
+	}
+}
+
+func (r *TemplateDeploymentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *TemplateDeploymentResourceModel
+	var serviceIds map[string]string
+	var volumeIds map[string]string
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	resp.Diagnostics.Append(data.ServiceIds.ElementsAs(ctx, &serviceIds, false)...)
+	resp.Diagnostics.Append(data.VolumeIds.ElementsAs(ctx, &volumeIds, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	services, err := listProjectServices(ctx, *r.client, data.ProjectId.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project services, got error: %s", err))
+		return
+	}
+
+	volumes, err := getVolumeInstances(ctx, *r.client, data.ProjectId.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project volumes, got error: %s", err))
+		return
+	}
+
+	// Only keep track of the services and volumes which still exist
+	serviceNames := make(map[string]string)
+
+	for _, service := range services.Project.Services.Edges {
+		serviceNames[service.Node.Id] = service.Node.Name
+	}
+
+	volumeNames := make(map[string]string)
+
+	for _, volume := range volumes.Project.Volumes.Edges {
+		volumeNames[volume.Node.Id] = volume.Node.Name
+	}
+
+	serviceValues := make(map[string]attr.Value)
+
+	for _, id := range serviceIds {
+		if name, ok := serviceNames[id]; ok {
+			serviceValues[name] = types.StringValue(id)
+		}
+	}
+
+	volumeValues := make(map[string]attr.Value)
+
+	for _, id := range volumeIds {
+		if name, ok := volumeNames[id]; ok {
+			volumeValues[name] = types.StringValue(id)
+		}
+	}
+
+	data.ServiceIds = types.MapValueMust(types.StringType, serviceValues)
+	data.VolumeIds = types.MapValueMust(types.StringType, volumeValues)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TemplateDeploymentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *TemplateDeploymentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TemplateDeploymentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *TemplateDeploymentResourceModel
+	var serviceIds map[string]string
+	var volumeIds map[string]string
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	resp.Diagnostics.Append(data.ServiceIds.ElementsAs(ctx, &serviceIds, false)...)
+	resp.Diagnostics.Append(data.VolumeIds.ElementsAs(ctx, &volumeIds, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, id := range serviceIds {
+		_, err := deleteService(ctx, *r.client, id)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete service, got error: %s", err))
+			return
+		}
+
+		tflog.Trace(ctx, "deleted a service")
+	}
+
+	for _, id := range volumeIds {
+		_, err := deleteVolume(ctx, *r.client, id)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete volume, got error: %s", err))
+			return
+		}
+
+		tflog.Trace(ctx, "deleted a volume")
+	}
+
+	tflog.Trace(ctx, "deleted a template deployment")
+}
+
+// findTemplateDeploymentResources returns the services and volumes created by a template deployment.
+// Services are matched by the template services of the deployed config, and a template service matching
+// more than one new service means another deployment of the template ran at the same time.
+// Volumes are matched through the volume instances attached to those services.
+func findTemplateDeploymentResources(ctx context.Context, client graphql.Client, projectId string, templateId string, config map[string]interface{}, existingServiceIds map[string]bool) (map[string]attr.Value, map[string]attr.Value, error) {
+	templateServices, _ := config["services"].(map[string]interface{})
+
+	services, err := listProjectServices(ctx, client, projectId)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	matches := make(map[string][]listProjectServicesProjectServicesProjectServicesConnectionEdgesProjectServicesConnectionEdgeNodeService)
+
+	for _, edge := range services.Project.Services.Edges {
+		service := edge.Node
+
+		if existingServiceIds[service.Id] || service.TemplateId == nil || *service.TemplateId != templateId || service.TemplateServiceId == nil {
+			continue
+		}
+
+		if _, ok := templateServices[*service.TemplateServiceId]; !ok {
+			continue
+		}
+
+		matches[*service.TemplateServiceId] = append(matches[*service.TemplateServiceId], service)
+	}
+
+	serviceIds := make(map[string]attr.Value)
+	createdServiceIds := make(map[string]bool)
+
+	for templateServiceId, candidates := range matches {
+		if len(candidates) > 1 {
+			return nil, nil, fmt.Errorf("template service %s matches %d new services, another deployment of the template ran at the same time", templateServiceId, len(candidates))
+		}
+
+		serviceIds[candidates[0].Name] = types.StringValue(candidates[0].Id)
+		createdServiceIds[candidates[0].Id] = true
+	}
+
+	volumes, err := getVolumeInstances(ctx, client, projectId)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	volumeIds := make(map[string]attr.Value)
+
+	for _, edge := range volumes.Project.Volumes.Edges {
+		for _, instance := range edge.Node.VolumeInstances.Edges {
+			if createdServiceIds[instance.Node.ServiceId] {
+				volumeIds[edge.Node.Name] = types.StringValue(edge.Node.Id)
+				break
+			}
+		}
+	}
+
+	return serviceIds, volumeIds, nil
+}
+
+// overrideTemplateVariables sets the values of the variables in the serialized template config.
+// The services of the config are keyed by their identifier, so they are matched by name.
+func overrideTemplateVariables(config map[string]interface{}, serviceVariables map[string]map[string]string) error {
+	if len(serviceVariables) == 0 {
+		return nil
+	}
+
+	services, ok := config["services"].(map[string]interface{})
+
+	if !ok {
+		return fmt.Errorf("services are not found")
+	}
+
+	found := make(map[string]bool)
+
+	for _, value := range services {
+		service, ok := value.(map[string]interface{})
+
+		if !ok {
+			return fmt.Errorf("service is not an object")
+		}
+
+		name, _ := service["name"].(string)
+		overrides, ok := serviceVariables[name]
+
+		if !ok {
+			continue
+		}
+
+		found[name] = true
+
+		variables, ok := service["variables"].(map[string]interface{})
+
+		if !ok {
+			variables = make(map[string]interface{})
+			service["variables"] = variables
+		}
+
+		for variableName, variableValue := range overrides {
+			variable, ok := variables[variableName].(map[string]interface{})
+
+			if !ok {
+				variable = make(map[string]interface{})
+				variables[variableName] = variable
+			}
+
+			variable["value"] = variableValue
+		}
+	}
+
+	for name := range serviceVariables {
+		if !found[name] {
+			return fmt.Errorf("service %s is not found in template", name)
+		}
+	}
+
+	return nil
+}
+
+func waitForWorkflow(ctx context.Context, client graphql.Client, workflowId string, timeout time.Duration) error {
+	return waitUntil(ctx, timeout, 5*time.Second, func() (bool, error) {
+		response, err := getWorkflowStatus(ctx, client, workflowId)
+
+		if err != nil {
+			return false, err
+		}
+
+		switch response.WorkflowStatus.Status {
+		case WorkflowStatusComplete:
+			return true, nil
+		case WorkflowStatusError:
+			if response.WorkflowStatus.Error != nil {
+				return false, fmt.Errorf("workflow failed: %s", *response.WorkflowStatus.Error)
+			}
+
+			return false, fmt.Errorf("workflow failed")
+		case WorkflowStatusNotfound:
+			return false, fmt.Errorf("workflow doesn't exist")
+		}
+
+		tflog.Trace(ctx, "waiting for workflow to complete")
+
+		return false, nil
+	})
+}
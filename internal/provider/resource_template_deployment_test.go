@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTemplateDeploymentResourceDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccTemplateDeploymentResourceConfigDefault(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("railway_template_deployment.test", "id"),
+					resource.TestCheckResourceAttr("railway_template_deployment.test", "template_code", "redis"),
+					resource.TestCheckResourceAttrSet("railway_template_deployment.test", "template_id"),
+					resource.TestCheckResourceAttr("railway_template_deployment.test", "project_id", "0bb01547-570d-4109-a5e8-138691f6a2d1"),
+					resource.TestCheckResourceAttr("railway_template_deployment.test", "environment_id", "d0519b29-5d12-4857-a5dd-76fa7418336c"),
+					resource.TestCheckResourceAttr("railway_template_deployment.test", "service_ids.%", "1"),
+					resource.TestMatchResourceAttr("railway_template_deployment.test", "service_ids.Redis", uuidRegex()),
+					resource.TestCheckResourceAttr("railway_template_deployment.test", "volume_ids.%", "1"),
+				),
+			},
+			// Update with default values
+			{
+				Config: testAccTemplateDeploymentResourceConfigDefault(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("railway_template_deployment.test", "id"),
+					resource.TestCheckResourceAttr("railway_template_deployment.test", "template_code", "redis"),
+					resource.TestCheckResourceAttr("railway_template_deployment.test", "service_ids.%", "1"),
+					resource.TestCheckResourceAttr("railway_template_deployment.test", "volume_ids.%", "1"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccTemplateDeploymentResourceConfigDefault() string {
+	return `
+resource "railway_template_deployment" "test" {
+  template_code = "redis"
+  project_id = "0bb01547-570d-4109-a5e8-138691f6a2d1"
+  environment_id = "d0519b29-5d12-4857-a5dd-76fa7418336c"
+}
+`
+}
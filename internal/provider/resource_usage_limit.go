@@ -0,0 +1,245 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &UsageLimitResource{}
+var _ resource.ResourceWithImportState = &UsageLimitResource{}
+var _ resource.ResourceWithValidateConfig = &UsageLimitResource{}
+
+func NewUsageLimitResource() resource.Resource {
+	return &UsageLimitResource{}
+}
+
+type UsageLimitResource struct {
+	client *graphql.Client
+}
+
+type UsageLimitResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	WorkspaceId types.String `tfsdk:"workspace_id"`
+	SoftLimit   types.Int64  `tfsdk:"soft_limit"`
+	HardLimit   types.Int64  `tfsdk:"hard_limit"`
+}
+
+func (r *UsageLimitResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_usage_limit"
+}
+
+func (r *UsageLimitResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Railway usage limit. Spending caps of a workspace for a billing period. Destroying it removes the limits of the workspace.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the billing customer of the workspace.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"workspace_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the workspace the usage limit belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an id"),
+				},
+			},
+			"soft_limit": schema.Int64Attribute{
+				MarkdownDescription: "Usage in dollars at which the workspace members are notified.",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"hard_limit": schema.Int64Attribute{
+				MarkdownDescription: "Usage in dollars at which the workspace services are stopped. Must be greater than or equal to `soft_limit`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *UsageLimitResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data UsageLimitResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.SoftLimit.IsUnknown() || data.SoftLimit.IsNull() || data.HardLimit.IsUnknown() || data.HardLimit.IsNull() {
+		return
+	}
+
+	if data.HardLimit.ValueInt64() >= data.SoftLimit.ValueInt64() {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		path.Root("hard_limit"),
+		"Invalid Attribute Value",
+		"`hard_limit` must be greater than or equal to `soft_limit`.",
+	)
+}
+
+func (r *UsageLimitResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *UsageLimitResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *UsageLimitResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := getWorkspaceUsageLimit(ctx, *r.client, data.WorkspaceId.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read workspace, got error: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(response.Workspace.Customer.Id)
+
+	_, err = setUsageLimit(ctx, *r.client, buildUsageLimitInput(data))
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create usage limit, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created a usage limit")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UsageLimitResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *UsageLimitResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := getWorkspaceUsageLimit(ctx, *r.client, data.WorkspaceId.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read usage limit, got error: %s", err))
+		return
+	}
+
+	customer := response.Workspace.Customer
+
+	// The limit was removed outside of terraform, so it needs to be set again
+	if customer.UsageLimit == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Id = types.StringValue(customer.Id)
+	data.SoftLimit = types.Int64Value(int64(customer.UsageLimit.SoftLimit))
+	data.HardLimit = types.Int64Null()
+
+	if customer.UsageLimit.HardLimit != nil {
+		data.HardLimit = types.Int64Value(int64(*customer.UsageLimit.HardLimit))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UsageLimitResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *UsageLimitResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := setUsageLimit(ctx, *r.client, buildUsageLimitInput(data))
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update usage limit, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "updated a usage limit")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UsageLimitResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *UsageLimitResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := removeUsageLimit(ctx, *r.client, UsageLimitRemoveInput{
+		CustomerId: data.Id.ValueString(),
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete usage limit, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a usage limit")
+}
+
+func (r *UsageLimitResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("workspace_id"), req, resp)
+}
+
+func buildUsageLimitInput(data *UsageLimitResourceModel) UsageLimitSetInput {
+	input := UsageLimitSetInput{
+		CustomerId:       data.Id.ValueString(),
+		SoftLimitDollars: int(data.SoftLimit.ValueInt64()),
+	}
+
+	if !data.HardLimit.IsNull() {
+		hardLimit := int(data.HardLimit.ValueInt64())
+		input.HardLimitDollars = &hardLimit
+	}
+
+	return input
+}
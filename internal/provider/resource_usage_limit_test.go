@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUsageLimitResourceDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccUsageLimitResourceConfigDefault(50),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("railway_usage_limit.test", "id"),
+					resource.TestCheckResourceAttr("railway_usage_limit.test", "workspace_id", "ecb63be7-63fb-47fe-95fc-1585d24e172d"),
+					resource.TestCheckResourceAttr("railway_usage_limit.test", "soft_limit", "50"),
+					resource.TestCheckNoResourceAttr("railway_usage_limit.test", "hard_limit"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "railway_usage_limit.test",
+				ImportState:                          true,
+				ImportStateId:                        "ecb63be7-63fb-47fe-95fc-1585d24e172d",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "workspace_id",
+			},
+			// Update and Read testing
+			{
+				Config: testAccUsageLimitResourceConfigNonDefault(100, 200),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("railway_usage_limit.test", "id"),
+					resource.TestCheckResourceAttr("railway_usage_limit.test", "workspace_id", "ecb63be7-63fb-47fe-95fc-1585d24e172d"),
+					resource.TestCheckResourceAttr("railway_usage_limit.test", "soft_limit", "100"),
+					resource.TestCheckResourceAttr("railway_usage_limit.test", "hard_limit", "200"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccUsageLimitResourceConfigDefault(softLimit int) string {
+	return fmt.Sprintf(`
+resource "railway_usage_limit" "test" {
+  workspace_id = "ecb63be7-63fb-47fe-95fc-1585d24e172d"
+  soft_limit = %d
+}
+`, softLimit)
+}
+
+func testAccUsageLimitResourceConfigNonDefault(softLimit int, hardLimit int) string {
+	return fmt.Sprintf(`
+resource "railway_usage_limit" "test" {
+  workspace_id = "ecb63be7-63fb-47fe-95fc-1585d24e172d"
+  soft_limit = %d
+  hard_limit = %d
+}
+`, softLimit, hardLimit)
+}
@@ -2,23 +2,31 @@ package provider
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"strings"
 
 	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var _ resource.Resource = &VariableResource{}
 var _ resource.ResourceWithImportState = &VariableResource{}
+var _ resource.ResourceWithModifyPlan = &VariableResource{}
 
 func NewVariableResource() resource.Resource {
 	return &VariableResource{}
@@ -29,12 +37,17 @@ type VariableResource struct {
 }
 
 type VariableResourceModel struct {
-	Id            types.String `tfsdk:"id"`
-	Name          types.String `tfsdk:"name"`
-	Value         types.String `tfsdk:"value"`
-	EnvironmentId types.String `tfsdk:"environment_id"`
-	ServiceId     types.String `tfsdk:"service_id"`
-	ProjectId     types.String `tfsdk:"project_id"`
+	Id             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Value          types.String `tfsdk:"value"`
+	ValueWo        types.String `tfsdk:"value_wo"`
+	ValueWoVersion types.Int64  `tfsdk:"value_wo_version"`
+	ValueHash      types.String `tfsdk:"value_hash"`
+	Sealed         types.Bool   `tfsdk:"sealed"`
+	Reference      types.Object `tfsdk:"reference"`
+	EnvironmentId  types.String `tfsdk:"environment_id"`
+	ServiceId      types.String `tfsdk:"service_id"`
+	ProjectId      types.String `tfsdk:"project_id"`
 }
 
 func (r *VariableResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -60,10 +73,38 @@ func (r *VariableResource) Schema(ctx context.Context, req resource.SchemaReques
 				},
 			},
 			"value": schema.StringAttribute{
-				MarkdownDescription: "Value of the variable.",
-				Required:            true,
+				MarkdownDescription: "Value of the variable. Conflicts with `value_wo` and `reference`.",
+				Optional:            true,
+				Computed:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("value_wo"), path.MatchRoot("reference")),
+				},
+			},
+			"value_wo": schema.StringAttribute{
+				MarkdownDescription: "Write-only value of the variable. It is never stored in the state and changes are detected through `value_hash`. Requires Terraform 1.11 or later.",
+				Optional:            true,
+				WriteOnly:           true,
 				Sensitive:           true,
 			},
+			"value_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Version of the write-only value. Changing it updates the variable with the current `value_wo`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("value_wo")),
+				},
+			},
+			"value_hash": schema.StringAttribute{
+				MarkdownDescription: "Salted HMAC-SHA256 of the write-only value, used to detect changes made outside of terraform.",
+				Computed:            true,
+			},
+			"reference": variableReferenceSchema(),
+			"sealed": schema.BoolAttribute{
+				MarkdownDescription: "Whether the variable is sealed. Sealed values cannot be read back, so the configured value is kept in the state. Sealed variables cannot be unsealed. **Default** `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 			"environment_id": schema.StringAttribute{
 				MarkdownDescription: "Identifier of the environment the variable belongs to.",
 				Required:            true,
@@ -112,10 +153,80 @@ func (r *VariableResource) Configure(ctx context.Context, req resource.Configure
 	r.client = client
 }
 
+func (r *VariableResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var data *VariableResourceModel
+	var config *VariableResourceModel
+	var reference *VariableReferenceModel
+
+	// Nothing to plan on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	previousHash := types.StringNull()
+
+	if !req.State.Raw.IsNull() {
+		var state *VariableResourceModel
+
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if state.Sealed.ValueBool() && !data.Sealed.IsUnknown() && !data.Sealed.ValueBool() {
+			resp.Diagnostics.AddAttributeError(path.Root("sealed"), "Invalid Sealed Variable", fmt.Sprintf("Variable %s is sealed and cannot be unsealed.", data.Name.ValueString()))
+			return
+		}
+
+		previousHash = state.ValueHash
+	}
+
+	// Write-only values never reach the plan, so their hash is planned instead
+	data.ValueHash = planWriteOnlyValueHash(config.ValueWo, previousHash)
+
+	if !config.ValueWo.IsNull() {
+		data.Value = types.StringNull()
+	}
+
+	// References can only be checked once the provider is configured
+	if !data.Reference.IsNull() && !data.Reference.IsUnknown() && r.client != nil {
+		resp.Diagnostics.Append(data.Reference.As(ctx, &reference, basetypes.ObjectAsOptions{})...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		value, warning, err := renderVariableReference(ctx, *r.client, data.ServiceId, data.EnvironmentId, reference)
+
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("reference"), "Invalid Variable Reference", err.Error())
+			return
+		}
+
+		if warning != "" {
+			resp.Diagnostics.AddAttributeWarning(path.Root("reference"), "Missing Referenced Variable", warning)
+		}
+
+		data.Value = value
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &data)...)
+}
+
 func (r *VariableResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data *VariableResourceModel
+	var config *VariableResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -130,7 +241,7 @@ func (r *VariableResource) Create(ctx context.Context, req resource.CreateReques
 
 	input := VariableUpsertInput{
 		Name:          data.Name.ValueString(),
-		Value:         data.Value.ValueString(),
+		Value:         getVariableValue(data, config),
 		ServiceId:     data.ServiceId.ValueStringPointer(),
 		EnvironmentId: data.EnvironmentId.ValueString(),
 		ProjectId:     service.Service.ProjectId,
@@ -145,6 +256,15 @@ func (r *VariableResource) Create(ctx context.Context, req resource.CreateReques
 
 	tflog.Trace(ctx, "created a variable")
 
+	if data.Sealed.ValueBool() {
+		err = sealVariables(ctx, *r.client, data.EnvironmentId.ValueString(), data.ServiceId.ValueString(), map[string]string{input.Name: input.Value})
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to seal variable, got error: %s", err))
+			return
+		}
+	}
+
 	err = getVariable(ctx, *r.client, service.Service.ProjectId, data.EnvironmentId.ValueString(), data.ServiceId.ValueString(), data.Name.ValueString(), data)
 
 	if err != nil {
@@ -183,9 +303,11 @@ func (r *VariableResource) Read(ctx context.Context, req resource.ReadRequest, r
 
 func (r *VariableResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var data *VariableResourceModel
+	var config *VariableResourceModel
 	var state *VariableResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -199,7 +321,7 @@ func (r *VariableResource) Update(ctx context.Context, req resource.UpdateReques
 
 	input := VariableUpsertInput{
 		Name:          data.Name.ValueString(),
-		Value:         data.Value.ValueString(),
+		Value:         getVariableValue(data, config),
 		ServiceId:     data.ServiceId.ValueStringPointer(),
 		EnvironmentId: data.EnvironmentId.ValueString(),
 		ProjectId:     state.ProjectId.ValueString(),
@@ -214,6 +336,15 @@ func (r *VariableResource) Update(ctx context.Context, req resource.UpdateReques
 
 	tflog.Trace(ctx, "updated a variable")
 
+	if data.Sealed.ValueBool() {
+		err = sealVariables(ctx, *r.client, data.EnvironmentId.ValueString(), data.ServiceId.ValueString(), map[string]string{input.Name: input.Value})
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to seal variable, got error: %s", err))
+			return
+		}
+	}
+
 	err = getVariable(ctx, *r.client, state.ProjectId.ValueString(), data.EnvironmentId.ValueString(), data.ServiceId.ValueString(), data.Name.ValueString(), data)
 
 	if err != nil {
@@ -304,14 +435,121 @@ func getVariable(ctx context.Context, client graphql.Client, projectId string, e
 		return err
 	}
 
-	if value, ok := response.Variables[name]; ok {
-		data.Id = types.StringValue(fmt.Sprintf("%s:%s:%s", serviceId, environmentId, name))
-		data.Name = types.StringValue(name)
+	sealed, err := getSealedVariableNames(ctx, client, environmentId, serviceId)
+
+	if err != nil {
+		return err
+	}
+
+	value, ok := response.Variables[name]
+
+	if !ok && !sealed[name] {
+		return nil
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%s:%s:%s", serviceId, environmentId, name))
+	data.Name = types.StringValue(name)
+	data.Sealed = types.BoolValue(sealed[name])
+	data.ProjectId = types.StringValue(projectId)
+	data.EnvironmentId = types.StringValue(environmentId)
+	data.ServiceId = types.StringValue(serviceId)
+
+	// Sealed values cannot be read back, so the known ones are kept
+	if sealed[name] {
+		return nil
+	}
+
+	// Write-only values are only kept as a hash
+	if data.ValueHash.IsNull() {
 		data.Value = types.StringValue(fmt.Sprintf("%v", value))
-		data.ProjectId = types.StringValue(projectId)
-		data.EnvironmentId = types.StringValue(environmentId)
-		data.ServiceId = types.StringValue(serviceId)
+	} else {
+		data.Value = types.StringNull()
+		data.ValueHash = types.StringValue(hashVariableValue(fmt.Sprintf("%v", value), data.ValueHash))
 	}
 
 	return nil
 }
+
+// sealVariables seals the given variables of a service with their values in a single change
+// which is committed without triggering deploys.
+func sealVariables(ctx context.Context, client graphql.Client, environmentId string, serviceId string, variables map[string]string) error {
+	sealedVariables := make(map[string]interface{}, len(variables))
+
+	for name, value := range variables {
+		sealedVariables[name] = map[string]interface{}{
+			"value":    value,
+			"isSealed": true,
+		}
+	}
+
+	patch := map[string]interface{}{
+		"services": map[string]interface{}{
+			serviceId: map[string]interface{}{
+				"variables": sealedVariables,
+			},
+		},
+	}
+
+	return commitEnvironmentPatch(ctx, client, environmentId, patch, "Seal variables from Terraform", true)
+}
+
+func getSealedVariableNames(ctx context.Context, client graphql.Client, environmentId string, serviceId string) (map[string]bool, error) {
+	response, err := listSealedVariables(ctx, client, environmentId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	sealed := make(map[string]bool)
+
+	for _, variable := range response.Environment.Variables.Edges {
+		if variable.Node.ServiceId == serviceId && variable.Node.IsSealed {
+			sealed[variable.Node.Name] = true
+		}
+	}
+
+	return sealed, nil
+}
+
+func getVariableValue(data *VariableResourceModel, config *VariableResourceModel) string {
+	if !config.ValueWo.IsNull() {
+		return config.ValueWo.ValueString()
+	}
+
+	return data.Value.ValueString()
+}
+
+// planWriteOnlyValueHash plans the hash of a write-only value with the key of the previous hash.
+// Without a previous hash, the key is only generated when applying.
+func planWriteOnlyValueHash(value types.String, previous types.String) types.String {
+	if value.IsNull() {
+		return types.StringNull()
+	}
+
+	if value.IsUnknown() || previous.IsNull() || previous.IsUnknown() {
+		return types.StringUnknown()
+	}
+
+	return types.StringValue(hashVariableValue(value.ValueString(), previous))
+}
+
+// hashVariableValue returns an HMAC-SHA256 of a write-only value, so that changes made outside of
+// terraform are detected without keeping the value. It is keyed with a random salt kept in front of
+// the hash, which is reused from the previous hash when there is one.
+func hashVariableValue(value string, previous types.String) string {
+	salt, _, ok := strings.Cut(previous.ValueString(), ":")
+
+	if !ok || salt == "" {
+		bytes := make([]byte, 16)
+
+		// Reading random bytes never fails on supported platforms
+		_, _ = rand.Read(bytes)
+
+		salt = hex.EncodeToString(bytes)
+	}
+
+	mac := hmac.New(sha256.New, []byte(salt))
+	mac.Write([]byte(value))
+
+	return salt + ":" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -21,10 +22,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
 var _ resource.Resource = &VariableCollectionResource{}
 var _ resource.ResourceWithImportState = &VariableCollectionResource{}
+var _ resource.ResourceWithModifyPlan = &VariableCollectionResource{}
 
 func NewVariableCollectionResource() resource.Resource {
 	return &VariableCollectionResource{}
@@ -35,21 +38,32 @@ type VariableCollectionResource struct {
 }
 
 type VariableCollectionResourceVariableModel struct {
-	Name  types.String `tfsdk:"name"`
-	Value types.String `tfsdk:"value"`
+	Name      types.String `tfsdk:"name"`
+	Value     types.String `tfsdk:"value"`
+	ValueWo   types.String `tfsdk:"value_wo"`
+	ValueHash types.String `tfsdk:"value_hash"`
+	Reference types.Object `tfsdk:"reference"`
+	Sealed    types.Bool   `tfsdk:"sealed"`
 }
 
 var variableAttrTypes = map[string]attr.Type{
-	"name":  types.StringType,
-	"value": types.StringType,
+	"name":       types.StringType,
+	"value":      types.StringType,
+	"value_wo":   types.StringType,
+	"value_hash": types.StringType,
+	"reference":  types.ObjectType{AttrTypes: variableReferenceAttrTypes},
+	"sealed":     types.BoolType,
 }
 
 type VariableCollectionResourceModel struct {
-	Id            types.String `tfsdk:"id"`
-	Variables     types.List   `tfsdk:"variables"`
-	EnvironmentId types.String `tfsdk:"environment_id"`
-	ServiceId     types.String `tfsdk:"service_id"`
-	ProjectId     types.String `tfsdk:"project_id"`
+	Id             types.String `tfsdk:"id"`
+	Variables      types.List   `tfsdk:"variables"`
+	EnvironmentId  types.String `tfsdk:"environment_id"`
+	ServiceId      types.String `tfsdk:"service_id"`
+	ProjectId      types.String `tfsdk:"project_id"`
+	Replace        types.Bool   `tfsdk:"replace"`
+	SkipDeploys    types.Bool   `tfsdk:"skip_deploys"`
+	ValueWoVersion types.Int64  `tfsdk:"value_wo_version"`
 }
 
 func (r *VariableCollectionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -58,7 +72,7 @@ func (r *VariableCollectionResource) Metadata(ctx context.Context, req resource.
 
 func (r *VariableCollectionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Railway variable collection. Group of variables managed as a whole. Any changes in collection triggers service redeployment.",
+		MarkdownDescription: "Railway variable collection. Group of variables managed as a whole. Any changes in collection triggers a single service redeployment unless `skip_deploys` is set.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				MarkdownDescription: "Identifier of the variable collection.",
@@ -73,10 +87,34 @@ func (r *VariableCollectionResource) Schema(ctx context.Context, req resource.Sc
 							Required:            true,
 						},
 						"value": schema.StringAttribute{
-							MarkdownDescription: "Value of the variable.",
-							Required:            true,
+							MarkdownDescription: "Value of the variable. Conflicts with `value_wo` and `reference`.",
+							Optional:            true,
+							Computed:            true,
+							Sensitive:           true,
+							Validators: []validator.String{
+								stringvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("value_wo"),
+									path.MatchRelative().AtParent().AtName("reference"),
+								),
+							},
+						},
+						"value_wo": schema.StringAttribute{
+							MarkdownDescription: "Write-only value of the variable. It is never stored in the state and changes are detected through `value_hash`. Requires Terraform 1.11 or later.",
+							Optional:            true,
+							WriteOnly:           true,
 							Sensitive:           true,
 						},
+						"value_hash": schema.StringAttribute{
+							MarkdownDescription: "Salted HMAC-SHA256 of the write-only value, used to detect changes made outside of terraform.",
+							Computed:            true,
+						},
+						"reference": variableReferenceSchema(),
+						"sealed": schema.BoolAttribute{
+							MarkdownDescription: "Whether the variable is sealed. Sealed values cannot be read back, so the configured value is kept in the state. Sealed variables cannot be unsealed. **Default** `false`.",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+						},
 					},
 				},
 				Required: true,
@@ -108,6 +146,22 @@ func (r *VariableCollectionResource) Schema(ctx context.Context, req resource.Sc
 				MarkdownDescription: "Identifier of the project the variable collection belongs to.",
 				Computed:            true,
 			},
+			"replace": schema.BoolAttribute{
+				MarkdownDescription: "Whether the collection is the exact set of variables of the service. Any other variable of the service is removed. **Default** `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"skip_deploys": schema.BoolAttribute{
+				MarkdownDescription: "Whether to skip redeploying the service when the collection changes. **Default** `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"value_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Version of the write-only values. Changing it updates the variables with their current `value_wo`.",
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -132,34 +186,138 @@ func (r *VariableCollectionResource) Configure(ctx context.Context, req resource
 	r.client = client
 }
 
-func (r *VariableCollectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+func (r *VariableCollectionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	var data *VariableCollectionResourceModel
+	var config *VariableCollectionResourceModel
+
+	// Nothing to plan on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() || data.Variables.IsUnknown() || config.Variables.IsUnknown() {
+		return
+	}
+
+	variablesData := make([]VariableCollectionResourceVariableModel, 0, len(data.Variables.Elements()))
+	variablesConfig := make([]VariableCollectionResourceVariableModel, 0, len(config.Variables.Elements()))
+
+	resp.Diagnostics.Append(data.Variables.ElementsAs(ctx, &variablesData, false)...)
+	resp.Diagnostics.Append(config.Variables.ElementsAs(ctx, &variablesConfig, false)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	service, err := getService(ctx, *r.client, data.ServiceId.ValueString())
+	sealed := make(map[string]bool)
+	hashes := make(map[string]types.String)
 
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read service, got error: %s", err))
+	if !req.State.Raw.IsNull() {
+		var state *VariableCollectionResourceModel
+
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		variablesState := make([]VariableCollectionResourceVariableModel, 0, len(state.Variables.Elements()))
+
+		resp.Diagnostics.Append(state.Variables.ElementsAs(ctx, &variablesState, false)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		for _, v := range variablesState {
+			sealed[v.Name.ValueString()] = v.Sealed.ValueBool()
+			hashes[v.Name.ValueString()] = v.ValueHash
+		}
+	}
+
+	for i, v := range variablesData {
+		var reference *VariableReferenceModel
+
+		if sealed[v.Name.ValueString()] && !v.Sealed.IsUnknown() && !v.Sealed.ValueBool() {
+			resp.Diagnostics.AddAttributeError(path.Root("variables").AtListIndex(i).AtName("sealed"), "Invalid Sealed Variable", fmt.Sprintf("Variable %s is sealed and cannot be unsealed.", v.Name.ValueString()))
+			return
+		}
+
+		// Write-only values never reach the plan, so their hash is planned instead
+		previousHash, ok := hashes[v.Name.ValueString()]
+
+		if !ok {
+			previousHash = types.StringNull()
+		}
+
+		variablesData[i].ValueHash = planWriteOnlyValueHash(variablesConfig[i].ValueWo, previousHash)
+
+		if !variablesConfig[i].ValueWo.IsNull() {
+			variablesData[i].Value = types.StringNull()
+		}
+
+		// References can only be checked once the provider is configured
+		if v.Reference.IsNull() || v.Reference.IsUnknown() || r.client == nil {
+			continue
+		}
+
+		resp.Diagnostics.Append(v.Reference.As(ctx, &reference, basetypes.ObjectAsOptions{})...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		value, warning, err := renderVariableReference(ctx, *r.client, data.ServiceId, data.EnvironmentId, reference)
+
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("variables").AtListIndex(i).AtName("reference"), "Invalid Variable Reference", err.Error())
+			return
+		}
+
+		if warning != "" {
+			resp.Diagnostics.AddAttributeWarning(path.Root("variables").AtListIndex(i).AtName("reference"), "Missing Referenced Variable", warning)
+		}
+
+		variablesData[i].Value = value
+	}
+
+	variables, diagErr := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: variableAttrTypes}, variablesData)
+
+	resp.Diagnostics.Append(diagErr...)
+
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	variablesData := make([]VariableCollectionResourceVariableModel, 0, len(data.Variables.Elements()))
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("variables"), variables)...)
+}
 
-	resp.Diagnostics.Append(data.Variables.ElementsAs(ctx, &variablesData, false)...)
+func (r *VariableCollectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *VariableCollectionResourceModel
+	var config *VariableCollectionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	variablesMap := make(map[string]interface{})
+	service, err := getService(ctx, *r.client, data.ServiceId.ValueString())
 
-	for _, v := range variablesData {
-		variablesMap[v.Name.ValueString()] = v.Value.ValueString()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read service, got error: %s", err))
+		return
+	}
+
+	variablesMap, diagErr := getVariablesMap(ctx, data, config)
+
+	if diagErr != nil {
+		resp.Diagnostics.Append(diagErr...)
+		return
 	}
 
 	input := VariableCollectionUpsertInput{
@@ -167,6 +325,8 @@ func (r *VariableCollectionResource) Create(ctx context.Context, req resource.Cr
 		ServiceId:     data.ServiceId.ValueStringPointer(),
 		EnvironmentId: data.EnvironmentId.ValueString(),
 		ProjectId:     service.Service.ProjectId,
+		Replace:       data.Replace.ValueBool(),
+		SkipDeploys:   true,
 	}
 
 	_, err = upsertVariableCollection(ctx, *r.client, input)
@@ -178,6 +338,22 @@ func (r *VariableCollectionResource) Create(ctx context.Context, req resource.Cr
 
 	tflog.Trace(ctx, "created a variable collection")
 
+	variablesToSeal, diagErr := getVariablesToSeal(ctx, data, config, nil, variablesMap)
+
+	if diagErr != nil {
+		resp.Diagnostics.Append(diagErr...)
+		return
+	}
+
+	if len(variablesToSeal) > 0 {
+		err = sealVariables(ctx, *r.client, data.EnvironmentId.ValueString(), data.ServiceId.ValueString(), variablesToSeal)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to seal variables of variable collection, got error: %s", err))
+			return
+		}
+	}
+
 	variableNames, diagErr := getVariableNames(ctx, data)
 
 	if diagErr != nil {
@@ -192,11 +368,13 @@ func (r *VariableCollectionResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
-	_, err = redeployServiceInstance(ctx, *r.client, data.EnvironmentId.ValueString(), data.ServiceId.ValueString())
+	if !data.SkipDeploys.ValueBool() {
+		_, err = redeployServiceInstance(ctx, *r.client, data.EnvironmentId.ValueString(), data.ServiceId.ValueString())
 
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to redeploy service after variable collection created, got error: %s", err))
-		return
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to redeploy service after variable collection created, got error: %s", err))
+			return
+		}
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -230,9 +408,11 @@ func (r *VariableCollectionResource) Read(ctx context.Context, req resource.Read
 
 func (r *VariableCollectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var data *VariableCollectionResourceModel
+	var config *VariableCollectionResourceModel
 	var state *VariableCollectionResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -244,46 +424,98 @@ func (r *VariableCollectionResource) Update(ctx context.Context, req resource.Up
 		return
 	}
 
-	variablesMapToUpsert, diagErr := getVariablesToUpsert(ctx, data, state)
+	variablesMapToUpsert, diagErr := getVariablesToUpsert(ctx, data, config, state)
+
+	if diagErr != nil {
+		resp.Diagnostics.Append(diagErr...)
+		return
+	}
+
+	variableNamesToDelete, diagErr := getVariableNamesToDelete(ctx, data, state)
 
 	if diagErr != nil {
 		resp.Diagnostics.Append(diagErr...)
 		return
 	}
 
-	if len(variablesMapToUpsert) > 0 {
-		input := VariableCollectionUpsertInput{
-			ServiceId:     data.ServiceId.ValueStringPointer(),
-			EnvironmentId: data.EnvironmentId.ValueString(),
-			ProjectId:     state.ProjectId.ValueString(),
-			Variables:     variablesMapToUpsert,
+	changed := len(variablesMapToUpsert) > 0 || len(variableNamesToDelete) > 0
+
+	if data.Replace.ValueBool() {
+		// Replacing sets the whole collection at once, which also removes the deleted variables
+		if changed || !state.Replace.ValueBool() {
+			variablesMap, diagErr := getVariablesMap(ctx, data, config)
+
+			if diagErr != nil {
+				resp.Diagnostics.Append(diagErr...)
+				return
+			}
+
+			input := VariableCollectionUpsertInput{
+				ServiceId:     data.ServiceId.ValueStringPointer(),
+				EnvironmentId: data.EnvironmentId.ValueString(),
+				ProjectId:     state.ProjectId.ValueString(),
+				Variables:     variablesMap,
+				Replace:       true,
+				SkipDeploys:   true,
+			}
+
+			_, err := upsertVariableCollection(ctx, *r.client, input)
+
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to replace variables of variable collection, got error: %s", err))
+				return
+			}
+
+			variablesMapToUpsert = variablesMap
+			changed = true
 		}
+	} else {
+		if len(variablesMapToUpsert) > 0 {
+			input := VariableCollectionUpsertInput{
+				ServiceId:     data.ServiceId.ValueStringPointer(),
+				EnvironmentId: data.EnvironmentId.ValueString(),
+				ProjectId:     state.ProjectId.ValueString(),
+				Variables:     variablesMapToUpsert,
+				SkipDeploys:   true,
+			}
 
-		_, err := upsertVariableCollection(ctx, *r.client, input)
+			_, err := upsertVariableCollection(ctx, *r.client, input)
 
-		if err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to upsert variables of variable collection, got error: %s", err))
-			return
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to upsert variables of variable collection, got error: %s", err))
+				return
+			}
+		}
+
+		if len(variableNamesToDelete) > 0 {
+			err := deleteManyVariables(ctx, *r.client, data.EnvironmentId.ValueString(), data.ServiceId.ValueStringPointer(), variableNamesToDelete)
+
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete variables of variable collection, got error: %s", err))
+				return
+			}
 		}
 	}
 
-	variableNamesToDelete, diagErr := getVariableNamesToDelete(ctx, data, state)
+	tflog.Trace(ctx, "updated a variable collection")
+
+	variablesToSeal, diagErr := getVariablesToSeal(ctx, data, config, state, variablesMapToUpsert)
 
 	if diagErr != nil {
 		resp.Diagnostics.Append(diagErr...)
 		return
 	}
 
-	if len(variableNamesToDelete) > 0 {
-		err := deleteManyVariables(ctx, *r.client, state.ProjectId.ValueString(), data.EnvironmentId.ValueString(), data.ServiceId.ValueString(), variableNamesToDelete)
+	if len(variablesToSeal) > 0 {
+		err := sealVariables(ctx, *r.client, data.EnvironmentId.ValueString(), data.ServiceId.ValueString(), variablesToSeal)
 
 		if err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete variables of variable collection, got error: %s", err))
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to seal variables of variable collection, got error: %s", err))
 			return
 		}
-	}
 
-	tflog.Trace(ctx, "updated a variable collection")
+		changed = true
+	}
 
 	allVariableNames, diagErr := getVariableNames(ctx, data)
 
@@ -299,11 +531,13 @@ func (r *VariableCollectionResource) Update(ctx context.Context, req resource.Up
 		return
 	}
 
-	_, err = redeployServiceInstance(ctx, *r.client, data.EnvironmentId.ValueString(), data.ServiceId.ValueString())
+	if changed && !data.SkipDeploys.ValueBool() {
+		_, err = redeployServiceInstance(ctx, *r.client, data.EnvironmentId.ValueString(), data.ServiceId.ValueString())
 
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to redeploy service after variable collection updated, got error: %s", err))
-		return
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to redeploy service after variable collection updated, got error: %s", err))
+			return
+		}
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -325,18 +559,20 @@ func (r *VariableCollectionResource) Delete(ctx context.Context, req resource.De
 		return
 	}
 
-	err := deleteManyVariables(ctx, *r.client, data.ProjectId.ValueString(), data.EnvironmentId.ValueString(), data.ServiceId.ValueString(), variableNames)
+	err := deleteManyVariables(ctx, *r.client, data.EnvironmentId.ValueString(), data.ServiceId.ValueStringPointer(), variableNames)
 
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete variable collection, got error: %s", err))
 		return
 	}
 
-	_, err = redeployServiceInstance(ctx, *r.client, data.EnvironmentId.ValueString(), data.ServiceId.ValueString())
+	if !data.SkipDeploys.ValueBool() {
+		_, err = redeployServiceInstance(ctx, *r.client, data.EnvironmentId.ValueString(), data.ServiceId.ValueString())
 
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to redeploy service after variable collection deleted, got error: %s", err))
-		return
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to redeploy service after variable collection deleted, got error: %s", err))
+			return
+		}
 	}
 
 	tflog.Trace(ctx, "deleted a variable collection")
@@ -385,8 +621,12 @@ func (r *VariableCollectionResource) ImportState(ctx context.Context, req resour
 
 	for _, variableName := range parts[2:] {
 		variables = append(variables, types.ObjectValueMust(variableAttrTypes, map[string]attr.Value{
-			"name":  types.StringValue(variableName),
-			"value": types.StringUnknown(),
+			"name":       types.StringValue(variableName),
+			"value":      types.StringUnknown(),
+			"value_wo":   types.StringNull(),
+			"value_hash": types.StringNull(),
+			"reference":  types.ObjectNull(variableReferenceAttrTypes),
+			"sealed":     types.BoolUnknown(),
 		}))
 	}
 
@@ -394,6 +634,8 @@ func (r *VariableCollectionResource) ImportState(ctx context.Context, req resour
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_id"), serviceId)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_id"), environmentId)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("replace"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("skip_deploys"), false)...)
 }
 
 func getVariableNames(ctx context.Context, data *VariableCollectionResourceModel) ([]string, diag.Diagnostics) {
@@ -415,6 +657,49 @@ func getVariableNames(ctx context.Context, data *VariableCollectionResourceModel
 	return variableNames, nil
 }
 
+func getVariablesMap(ctx context.Context, data, config *VariableCollectionResourceModel) (map[string]interface{}, diag.Diagnostics) {
+	variablesData, variablesConfig, err := getVariablesDataAndConfig(ctx, data, config)
+
+	if err != nil {
+		return nil, err
+	}
+
+	variablesMap := make(map[string]interface{}, len(variablesData))
+
+	for i, v := range variablesData {
+		variablesMap[v.Name.ValueString()] = getCollectionVariableValue(v, variablesConfig[i])
+	}
+
+	return variablesMap, nil
+}
+
+func getVariablesDataAndConfig(ctx context.Context, data, config *VariableCollectionResourceModel) ([]VariableCollectionResourceVariableModel, []VariableCollectionResourceVariableModel, diag.Diagnostics) {
+	variablesData := make([]VariableCollectionResourceVariableModel, 0, len(data.Variables.Elements()))
+	variablesConfig := make([]VariableCollectionResourceVariableModel, 0, len(config.Variables.Elements()))
+
+	err := data.Variables.ElementsAs(ctx, &variablesData, false)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = config.Variables.ElementsAs(ctx, &variablesConfig, false)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return variablesData, variablesConfig, nil
+}
+
+func getCollectionVariableValue(data, config VariableCollectionResourceVariableModel) string {
+	if !config.ValueWo.IsNull() {
+		return config.ValueWo.ValueString()
+	}
+
+	return data.Value.ValueString()
+}
+
 func getVariableCollection(ctx context.Context, client graphql.Client, projectId string, environmentId string, serviceId string, names []string, data *VariableCollectionResourceModel) error {
 	if len(names) == 0 {
 		return errors.New("cannot get variable collection with no variable names")
@@ -426,14 +711,77 @@ func getVariableCollection(ctx context.Context, client graphql.Client, projectId
 		return err
 	}
 
+	sealed, err := getSealedVariableNames(ctx, client, environmentId, serviceId)
+
+	if err != nil {
+		return err
+	}
+
+	// References and sealed values are not returned by the API, so they are kept from the current
+	// data along with which variables are write-only
+	existing := make(map[string]VariableCollectionResourceVariableModel, len(names))
+
+	if !data.Variables.IsNull() && !data.Variables.IsUnknown() {
+		variablesData := make([]VariableCollectionResourceVariableModel, 0, len(data.Variables.Elements()))
+
+		if diagErr := data.Variables.ElementsAs(ctx, &variablesData, false); diagErr.HasError() {
+			return fmt.Errorf("cannot read variables of variable collection")
+		}
+
+		for _, v := range variablesData {
+			existing[v.Name.ValueString()] = v
+		}
+	}
+
 	variables := make([]attr.Value, 0, len(names))
 
 	for _, name := range names {
+		current, known := existing[name]
+		reference := types.ObjectNull(variableReferenceAttrTypes)
+
+		if known && !current.Reference.IsNull() {
+			reference = current.Reference
+		}
+
+		if sealed[name] {
+			value := types.StringNull()
+			valueHash := types.StringNull()
+
+			if known {
+				value = current.Value
+				valueHash = current.ValueHash
+			}
+
+			variables = append(variables, types.ObjectValueMust(variableAttrTypes, map[string]attr.Value{
+				"name":       types.StringValue(name),
+				"value":      value,
+				"value_wo":   types.StringNull(),
+				"value_hash": valueHash,
+				"reference":  reference,
+				"sealed":     types.BoolValue(true),
+			}))
+
+			continue
+		}
+
 		if value, ok := response.Variables[name]; ok {
 			if str, ok := value.(string); ok {
+				value := types.StringValue(str)
+				valueHash := types.StringNull()
+
+				// Write-only values are only kept as a hash
+				if known && !current.ValueHash.IsNull() {
+					value = types.StringNull()
+					valueHash = types.StringValue(hashVariableValue(str, current.ValueHash))
+				}
+
 				variables = append(variables, types.ObjectValueMust(variableAttrTypes, map[string]attr.Value{
-					"name":  types.StringValue(name),
-					"value": types.StringValue(str),
+					"name":       types.StringValue(name),
+					"value":      value,
+					"value_wo":   types.StringNull(),
+					"value_hash": valueHash,
+					"reference":  reference,
+					"sealed":     types.BoolValue(false),
 				}))
 			} else {
 				return fmt.Errorf("cannot convert variable %s to string", name)
@@ -459,38 +807,76 @@ func getVariableCollectionId(ctx context.Context, serviceId, environmentId strin
 	return fmt.Sprintf("%s:%s:%s", serviceId, environmentId, strings.Join(namesSortedAsc, ":"))
 }
 
-func deleteManyVariables(ctx context.Context, client graphql.Client, projectId, environmentId, serviceId string, names []string) error {
+// deleteManyVariables removes all the given variables in a single change which is committed
+// without triggering deploys. Redeploying the service is left to the caller. Shared variables
+// are deleted when serviceId is nil.
+func deleteManyVariables(ctx context.Context, client graphql.Client, environmentId string, serviceId *string, names []string) error {
+	variables := make(map[string]interface{}, len(names))
+
 	for _, name := range names {
-		input := VariableDeleteInput{
-			Name:          name,
-			ServiceId:     &serviceId,
-			EnvironmentId: environmentId,
-			ProjectId:     projectId,
+		variables[name] = nil
+	}
+
+	patch := map[string]interface{}{
+		"sharedVariables": variables,
+	}
+
+	if serviceId != nil {
+		patch = map[string]interface{}{
+			"services": map[string]interface{}{
+				*serviceId: map[string]interface{}{
+					"variables": variables,
+				},
+			},
 		}
+	}
 
-		_, err := deleteVariable(ctx, client, input)
+	return commitEnvironmentPatch(ctx, client, environmentId, patch, "Delete variables from Terraform", true)
+}
 
-		if err != nil {
-			return err
+// commitEnvironmentPatch commits the given patch to the environment. Committing applies everything
+// which is staged in the environment, so changes which are already staged there are set aside while
+// the patch is committed and staged again afterwards.
+func commitEnvironmentPatch(ctx context.Context, client graphql.Client, environmentId string, patch map[string]interface{}, message string, skipDeploys bool) error {
+	staged, err := getStagedChanges(ctx, client, environmentId)
+
+	if err != nil {
+		return err
+	}
+
+	_, err = stageEnvironmentChanges(ctx, client, environmentId, patch, false)
+
+	if err != nil {
+		return err
+	}
+
+	_, err = commitStagedChanges(ctx, client, environmentId, message, skipDeploys)
+
+	// A failed commit leaves the patch staged, so it is replaced as well
+	if err != nil || len(staged.EnvironmentStagedChanges.Patch) > 0 {
+		_, restoreErr := stageEnvironmentChanges(ctx, client, environmentId, staged.EnvironmentStagedChanges.Patch, false)
+
+		if restoreErr != nil {
+			return fmt.Errorf("unable to stage the previously staged changes of environment %s again: %w", environmentId, restoreErr)
 		}
 	}
 
-	return nil
+	return err
 }
 
 // getVariablesToUpsert returns a map where entries have to be upserted. The criteria is the following:
 // if entry is in the data, but not in the state, then it has to be created
 // if entry is in the data and is in the state, and values are different, then it has to be updated
-func getVariablesToUpsert(ctx context.Context, data, state *VariableCollectionResourceModel) (map[string]interface{}, diag.Diagnostics) {
-	variablesData := make([]VariableCollectionResourceVariableModel, 0, len(data.Variables.Elements()))
-	variablesState := make([]VariableCollectionResourceVariableModel, 0, len(state.Variables.Elements()))
-
-	err := data.Variables.ElementsAs(ctx, &variablesData, false)
+// if entry is write-only, its hash is compared instead and it is always updated when the version changes
+func getVariablesToUpsert(ctx context.Context, data, config, state *VariableCollectionResourceModel) (map[string]interface{}, diag.Diagnostics) {
+	variablesData, variablesConfig, err := getVariablesDataAndConfig(ctx, data, config)
 
 	if err != nil {
 		return nil, err
 	}
 
+	variablesState := make([]VariableCollectionResourceVariableModel, 0, len(state.Variables.Elements()))
+
 	err = state.Variables.ElementsAs(ctx, &variablesState, false)
 
 	if err != nil {
@@ -498,21 +884,64 @@ func getVariablesToUpsert(ctx context.Context, data, state *VariableCollectionRe
 	}
 
 	variablesToUpsert := make(map[string]interface{})
-	variablesStateMap := make(map[string]string, len(variablesState))
+	variablesStateMap := make(map[string]VariableCollectionResourceVariableModel, len(variablesState))
 
 	for _, v := range variablesState {
-		variablesStateMap[v.Name.ValueString()] = v.Value.ValueString()
+		variablesStateMap[v.Name.ValueString()] = v
 	}
 
-	for _, v := range variablesData {
-		if stateValue, ok := variablesStateMap[v.Name.ValueString()]; !ok || (ok && v.Value.ValueString() != stateValue) {
-			variablesToUpsert[v.Name.ValueString()] = v.Value.ValueString()
+	for i, v := range variablesData {
+		stateVariable, ok := variablesStateMap[v.Name.ValueString()]
+
+		if !ok || !v.Value.Equal(stateVariable.Value) || !v.ValueHash.Equal(stateVariable.ValueHash) || (!v.ValueHash.IsNull() && !data.ValueWoVersion.Equal(state.ValueWoVersion)) {
+			variablesToUpsert[v.Name.ValueString()] = getCollectionVariableValue(v, variablesConfig[i])
 		}
 	}
 
 	return variablesToUpsert, nil
 }
 
+// getVariablesToSeal returns a map of the variables which have to be sealed with their values. The criteria is the following:
+// if entry is sealed in the data and has just been upserted, then it has to be sealed again
+// if entry is sealed in the data, but not in the state, then it has to be sealed
+func getVariablesToSeal(ctx context.Context, data, config, state *VariableCollectionResourceModel, upserted map[string]interface{}) (map[string]string, diag.Diagnostics) {
+	variablesData, variablesConfig, err := getVariablesDataAndConfig(ctx, data, config)
+
+	if err != nil {
+		return nil, err
+	}
+
+	sealedState := make(map[string]bool)
+
+	if state != nil {
+		variablesState := make([]VariableCollectionResourceVariableModel, 0, len(state.Variables.Elements()))
+
+		err = state.Variables.ElementsAs(ctx, &variablesState, false)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range variablesState {
+			sealedState[v.Name.ValueString()] = v.Sealed.ValueBool()
+		}
+	}
+
+	variablesToSeal := make(map[string]string)
+
+	for i, v := range variablesData {
+		if !v.Sealed.ValueBool() {
+			continue
+		}
+
+		if _, ok := upserted[v.Name.ValueString()]; ok || !sealedState[v.Name.ValueString()] {
+			variablesToSeal[v.Name.ValueString()] = getCollectionVariableValue(v, variablesConfig[i])
+		}
+	}
+
+	return variablesToSeal, nil
+}
+
 // getVariableNamesToDelete returns an array where entries are names of variables to delete. The criteria is the following:
 // if variables is in the state, but not in the data, then it has to be deleted
 func getVariableNamesToDelete(ctx context.Context, data, state *VariableCollectionResourceModel) ([]string, diag.Diagnostics) {
@@ -83,6 +83,46 @@ func TestAccVariableCollectionResourceDefault(t *testing.T) {
 	})
 }
 
+func TestAccVariableCollectionResourceReplace(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccVariableCollectionResourceConfigReplace("one", "two"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("railway_variable_collection.test", "id", "39da7e07-fa3a-42fd-b695-d229319f2993:d0519b29-5d12-4857-a5dd-76fa7418336c:VALUE_A:VALUE_B"),
+					resource.TestCheckResourceAttr("railway_variable_collection.test", "replace", "true"),
+					resource.TestCheckResourceAttr("railway_variable_collection.test", "skip_deploys", "true"),
+					resource.TestCheckResourceAttr("railway_variable_collection.test", "variables.0.name", "VALUE_A"),
+					resource.TestCheckResourceAttr("railway_variable_collection.test", "variables.0.value", "one"),
+					resource.TestCheckResourceAttr("railway_variable_collection.test", "variables.1.name", "VALUE_B"),
+					resource.TestCheckResourceAttr("railway_variable_collection.test", "variables.1.value", "two"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "railway_variable_collection.test",
+				ImportState:             true,
+				ImportStateId:           "39da7e07-fa3a-42fd-b695-d229319f2993:staging:VALUE_A:VALUE_B",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"replace", "skip_deploys"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccVariableCollectionResourceConfigReplace("three", "four"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("railway_variable_collection.test", "id", "39da7e07-fa3a-42fd-b695-d229319f2993:d0519b29-5d12-4857-a5dd-76fa7418336c:VALUE_A:VALUE_B"),
+					resource.TestCheckResourceAttr("railway_variable_collection.test", "variables.0.value", "three"),
+					resource.TestCheckResourceAttr("railway_variable_collection.test", "variables.1.value", "four"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
 func testAccVariableCollectionResourceConfigDefault(valueA, valueB, valueC string) string {
 	return fmt.Sprintf(`
 resource "railway_variable_collection" "test" {
@@ -130,3 +170,25 @@ resource "railway_variable_collection" "test" {
 }
 `, valueB, valueC, valueD)
 }
+
+func testAccVariableCollectionResourceConfigReplace(valueA, valueB string) string {
+	return fmt.Sprintf(`
+resource "railway_variable_collection" "test" {
+  environment_id = "d0519b29-5d12-4857-a5dd-76fa7418336c"
+  service_id = "39da7e07-fa3a-42fd-b695-d229319f2993"
+  replace = true
+  skip_deploys = true
+
+  variables = [
+    {
+      name = "VALUE_A"
+      value = "%s"
+    },
+    {
+      name = "VALUE_B"
+      value = "%s"
+    }
+  ]
+}
+`, valueA, valueB)
+}
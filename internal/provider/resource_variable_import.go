@@ -0,0 +1,529 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &VariableImportResource{}
+var _ resource.ResourceWithValidateConfig = &VariableImportResource{}
+var _ resource.ResourceWithModifyPlan = &VariableImportResource{}
+
+func NewVariableImportResource() resource.Resource {
+	return &VariableImportResource{}
+}
+
+type VariableImportResource struct {
+	client *graphql.Client
+}
+
+type VariableImportResourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	Dotenv          types.String `tfsdk:"dotenv"`
+	HerokuAppId     types.String `tfsdk:"heroku_app_id"`
+	Variables       types.Map    `tfsdk:"variables"`
+	HerokuVariables types.Map    `tfsdk:"heroku_variables"`
+	EnvironmentId   types.String `tfsdk:"environment_id"`
+	ServiceId       types.String `tfsdk:"service_id"`
+	ProjectId       types.String `tfsdk:"project_id"`
+}
+
+func (r *VariableImportResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_variable_import"
+}
+
+func (r *VariableImportResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Railway variable import. Group of variables imported from dotenv content or a Heroku app and managed as a whole. Any changes in the import triggers service redeployment.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the variable import.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dotenv": schema.StringAttribute{
+				MarkdownDescription: "Content in dotenv format. Supports comments, `export` prefixes, quoted and multi-line values. Takes precedence over variables imported from Heroku.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.AtLeastOneOf(path.MatchRoot("heroku_app_id")),
+				},
+			},
+			"heroku_app_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the Heroku app to import the variables from. Requires the Heroku account to be connected to Railway.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"variables": schema.MapAttribute{
+				MarkdownDescription: "Imported variables keyed by name.",
+				ElementType:         types.StringType,
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"heroku_variables": schema.MapAttribute{
+				MarkdownDescription: "Variables imported from Heroku keyed by name, with their imported values. A variable shadowed by the dotenv content gets back its imported value when removed from it.",
+				ElementType:         types.StringType,
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the environment the variables belong to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an id"),
+				},
+			},
+			"service_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the service the variables belong to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an id"),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the project the variables belong to.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *VariableImportResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data VariableImportResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Dotenv.IsNull() || data.Dotenv.IsUnknown() {
+		return
+	}
+
+	_, err := parseDotenv(data.Dotenv.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("dotenv"),
+			"Invalid dotenv content",
+			err.Error(),
+		)
+	}
+}
+
+func (r *VariableImportResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var data *VariableImportResourceModel
+	var state *VariableImportResourceModel
+
+	// Nothing to plan on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.Dotenv.IsUnknown() {
+		return
+	}
+
+	// Variables imported from Heroku are only known after creating
+	if req.State.Raw.IsNull() && !data.HerokuAppId.IsNull() {
+		return
+	}
+
+	variables := make(map[string]attr.Value)
+
+	if req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("heroku_variables"), types.MapValueMust(types.StringType, map[string]attr.Value{}))...)
+	} else {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		for name, value := range state.HerokuVariables.Elements() {
+			variables[name] = value
+		}
+	}
+
+	dotenvVariables, err := getDotenvVariables(data)
+
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("dotenv"), "Invalid dotenv content", err.Error())
+		return
+	}
+
+	for name, value := range dotenvVariables {
+		variables[name] = types.StringValue(value)
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("variables"), types.MapValueMust(types.StringType, variables))...)
+}
+
+func (r *VariableImportResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VariableImportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *VariableImportResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service, err := getService(ctx, *r.client, data.ServiceId.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read service, got error: %s", err))
+		return
+	}
+
+	data.ProjectId = types.StringValue(service.Service.ProjectId)
+	names := make([]string, 0)
+	herokuVariables := make(map[string]attr.Value)
+
+	if !data.HerokuAppId.IsNull() {
+		before, err := getVariables(ctx, *r.client, data.ProjectId.ValueString(), data.EnvironmentId.ValueString(), data.ServiceId.ValueString())
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read variables, got error: %s", err))
+			return
+		}
+
+		input := HerokuImportVariablesInput{
+			HerokuAppId:   data.HerokuAppId.ValueString(),
+			ServiceId:     data.ServiceId.ValueString(),
+			EnvironmentId: data.EnvironmentId.ValueString(),
+			ProjectId:     data.ProjectId.ValueString(),
+		}
+
+		_, err = importHerokuVariables(ctx, *r.client, input)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import variables from Heroku, got error: %s", err))
+			return
+		}
+
+		tflog.Trace(ctx, "imported variables from heroku")
+
+		after, err := getVariables(ctx, *r.client, data.ProjectId.ValueString(), data.EnvironmentId.ValueString(), data.ServiceId.ValueString())
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read variables, got error: %s", err))
+			return
+		}
+
+		// Only the variables created by the import are managed
+		for name, value := range after.Variables {
+			if _, ok := before.Variables[name]; !ok {
+				names = append(names, name)
+				herokuVariables[name] = types.StringValue(fmt.Sprintf("%v", value))
+			}
+		}
+	}
+
+	data.HerokuVariables = types.MapValueMust(types.StringType, herokuVariables)
+
+	dotenvVariables, err := getDotenvVariables(data)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to parse dotenv, got error: %s", err))
+		return
+	}
+
+	if len(dotenvVariables) > 0 {
+		err = upsertImportedVariables(ctx, *r.client, data, dotenvVariables)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create variables, got error: %s", err))
+			return
+		}
+	}
+
+	for name := range dotenvVariables {
+		names = append(names, name)
+	}
+
+	tflog.Trace(ctx, "created a variable import")
+
+	data.Id = types.StringValue(fmt.Sprintf("%s:%s", data.ServiceId.ValueString(), data.EnvironmentId.ValueString()))
+
+	err = getAndBuildVariableImport(ctx, *r.client, names, data)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read variable import after creating it, got error: %s", err))
+		return
+	}
+
+	_, err = redeployServiceInstance(ctx, *r.client, data.EnvironmentId.ValueString(), data.ServiceId.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to redeploy service after variable import created, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VariableImportResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *VariableImportResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := getAndBuildVariableImport(ctx, *r.client, getVariableImportNames(data), data)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read variable import, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VariableImportResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *VariableImportResourceModel
+	var state *VariableImportResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	herokuVariables := state.HerokuVariables.Elements()
+	data.HerokuVariables = state.HerokuVariables
+
+	dotenvVariables, err := getDotenvVariables(data)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to parse dotenv, got error: %s", err))
+		return
+	}
+
+	variablesToUpsert := make(map[string]string)
+
+	for name, value := range dotenvVariables {
+		if current, ok := state.Variables.Elements()[name]; !ok || current.(types.String).ValueString() != value {
+			variablesToUpsert[name] = value
+		}
+	}
+
+	// Variables imported from Heroku which are no longer shadowed by the dotenv content get back their imported value
+	for name, value := range herokuVariables {
+		if _, ok := dotenvVariables[name]; ok {
+			continue
+		}
+
+		if current, ok := state.Variables.Elements()[name]; ok && !current.Equal(value) {
+			variablesToUpsert[name] = value.(types.String).ValueString()
+		}
+	}
+
+	if len(variablesToUpsert) > 0 {
+		err = upsertImportedVariables(ctx, *r.client, data, variablesToUpsert)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update variables, got error: %s", err))
+			return
+		}
+	}
+
+	names := make([]string, 0, len(herokuVariables)+len(dotenvVariables))
+	namesToDelete := make([]string, 0)
+
+	for name := range herokuVariables {
+		names = append(names, name)
+	}
+
+	for name := range dotenvVariables {
+		names = append(names, name)
+	}
+
+	for name := range state.Variables.Elements() {
+		_, fromHeroku := herokuVariables[name]
+		_, fromDotenv := dotenvVariables[name]
+
+		if !fromHeroku && !fromDotenv {
+			namesToDelete = append(namesToDelete, name)
+		}
+	}
+
+	if len(namesToDelete) > 0 {
+		err = deleteManyVariables(ctx, *r.client, data.EnvironmentId.ValueString(), data.ServiceId.ValueStringPointer(), namesToDelete)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete variables, got error: %s", err))
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "updated a variable import")
+
+	err = getAndBuildVariableImport(ctx, *r.client, names, data)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read variable import after updating it, got error: %s", err))
+		return
+	}
+
+	if len(variablesToUpsert) > 0 || len(namesToDelete) > 0 {
+		_, err = redeployServiceInstance(ctx, *r.client, data.EnvironmentId.ValueString(), data.ServiceId.ValueString())
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to redeploy service after variable import updated, got error: %s", err))
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VariableImportResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *VariableImportResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	names := getVariableImportNames(data)
+
+	if len(names) > 0 {
+		err := deleteManyVariables(ctx, *r.client, data.EnvironmentId.ValueString(), data.ServiceId.ValueStringPointer(), names)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete variable import, got error: %s", err))
+			return
+		}
+
+		_, err = redeployServiceInstance(ctx, *r.client, data.EnvironmentId.ValueString(), data.ServiceId.ValueString())
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to redeploy service after variable import deleted, got error: %s", err))
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "deleted a variable import")
+}
+
+func getDotenvVariables(data *VariableImportResourceModel) (map[string]string, error) {
+	if data.Dotenv.IsNull() {
+		return map[string]string{}, nil
+	}
+
+	return parseDotenv(data.Dotenv.ValueString())
+}
+
+func getVariableImportNames(data *VariableImportResourceModel) []string {
+	names := make([]string, 0, len(data.Variables.Elements()))
+
+	for name := range data.Variables.Elements() {
+		names = append(names, name)
+	}
+
+	return names
+}
+
+func upsertImportedVariables(ctx context.Context, client graphql.Client, data *VariableImportResourceModel, variables map[string]string) error {
+	variablesMap := make(map[string]interface{}, len(variables))
+
+	for name, value := range variables {
+		variablesMap[name] = value
+	}
+
+	input := VariableCollectionUpsertInput{
+		ServiceId:     data.ServiceId.ValueStringPointer(),
+		EnvironmentId: data.EnvironmentId.ValueString(),
+		ProjectId:     data.ProjectId.ValueString(),
+		Variables:     variablesMap,
+		SkipDeploys:   true,
+	}
+
+	_, err := upsertVariableCollection(ctx, client, input)
+
+	return err
+}
+
+func getAndBuildVariableImport(ctx context.Context, client graphql.Client, names []string, data *VariableImportResourceModel) error {
+	response, err := getVariables(ctx, client, data.ProjectId.ValueString(), data.EnvironmentId.ValueString(), data.ServiceId.ValueString())
+
+	if err != nil {
+		return err
+	}
+
+	variables := make(map[string]attr.Value, len(names))
+
+	for _, name := range names {
+		if value, ok := response.Variables[name]; ok {
+			variables[name] = types.StringValue(fmt.Sprintf("%v", value))
+		}
+	}
+
+	data.Variables = types.MapValueMust(types.StringType, variables)
+
+	return nil
+}
@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"maps"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccVariableImportResourceDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccVariableImportResourceConfigDefault(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("railway_variable_import.test", "id", "39da7e07-fa3a-42fd-b695-d229319f2993:d0519b29-5d12-4857-a5dd-76fa7418336c"),
+					resource.TestCheckResourceAttr("railway_variable_import.test", "environment_id", "d0519b29-5d12-4857-a5dd-76fa7418336c"),
+					resource.TestCheckResourceAttr("railway_variable_import.test", "service_id", "39da7e07-fa3a-42fd-b695-d229319f2993"),
+					resource.TestCheckResourceAttr("railway_variable_import.test", "project_id", "0bb01547-570d-4109-a5e8-138691f6a2d1"),
+					resource.TestCheckResourceAttr("railway_variable_import.test", "variables.%", "3"),
+					resource.TestCheckResourceAttr("railway_variable_import.test", "variables.IMPORT_A", "one"),
+					resource.TestCheckResourceAttr("railway_variable_import.test", "variables.IMPORT_B", "two # not a comment"),
+					resource.TestCheckResourceAttr("railway_variable_import.test", "variables.IMPORT_C", "multi\nline"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccVariableImportResourceConfigNonDefault(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("railway_variable_import.test", "id", "39da7e07-fa3a-42fd-b695-d229319f2993:d0519b29-5d12-4857-a5dd-76fa7418336c"),
+					resource.TestCheckResourceAttr("railway_variable_import.test", "variables.%", "2"),
+					resource.TestCheckResourceAttr("railway_variable_import.test", "variables.IMPORT_A", "three"),
+					resource.TestCheckResourceAttr("railway_variable_import.test", "variables.IMPORT_D", "four"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestParseDotenv(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    map[string]string
+		wantErr bool
+	}{
+		{name: "empty", content: "", want: map[string]string{}},
+		{name: "comments and blank lines", content: "# comment\n\n  # indented\nA=1\n", want: map[string]string{"A": "1"}},
+		{name: "unquoted", content: "A = one two \nB=", want: map[string]string{"A": "one two", "B": ""}},
+		{name: "inline comment", content: "A=one # comment\nB=two#three", want: map[string]string{"A": "one", "B": "two#three"}},
+		{name: "export prefix", content: "export A=1\n  export B=2", want: map[string]string{"A": "1", "B": "2"}},
+		{name: "single quoted", content: `A='one # two \n'`, want: map[string]string{"A": `one # two \n`}},
+		{name: "double quoted escapes", content: `A="tab\there \"quoted\" back\\slash\nnew"`, want: map[string]string{"A": "tab\there \"quoted\" back\\slash\nnew"}},
+		{name: "multi-line", content: "A=\"first\nsecond\"\nB='third\nfourth'\nC=5", want: map[string]string{"A": "first\nsecond", "B": "third\nfourth", "C": "5"}},
+		{name: "windows line endings", content: "A=1\r\nB=\"two\r\nlines\"\r\n", want: map[string]string{"A": "1", "B": "two\nlines"}},
+		{name: "last value wins", content: "A=1\nA=2", want: map[string]string{"A": "2"}},
+		{name: "missing equals", content: "A", wantErr: true},
+		{name: "invalid name", content: "1A=1", wantErr: true},
+		{name: "unterminated quote", content: "A=\"one\nB=2", wantErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := parseDotenv(test.content)
+
+			if test.wantErr {
+				if err == nil {
+					t.Fatalf("parseDotenv() = %v, want an error", got)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("parseDotenv() got error: %s", err)
+			}
+
+			if !maps.Equal(got, test.want) {
+				t.Errorf("parseDotenv() = %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func testAccVariableImportResourceConfigDefault() string {
+	return `
+resource "railway_variable_import" "test" {
+  dotenv = <<-EOT
+    # Comment
+    export IMPORT_A=one
+    IMPORT_B="two # not a comment"
+    IMPORT_C="multi
+    line"
+  EOT
+  environment_id = "d0519b29-5d12-4857-a5dd-76fa7418336c"
+  service_id = "39da7e07-fa3a-42fd-b695-d229319f2993"
+}
+`
+}
+
+func testAccVariableImportResourceConfigNonDefault() string {
+	return `
+resource "railway_variable_import" "test" {
+  dotenv = <<-EOT
+    IMPORT_A='three'
+    IMPORT_D=four # comment
+  EOT
+  environment_id = "d0519b29-5d12-4857-a5dd-76fa7418336c"
+  service_id = "39da7e07-fa3a-42fd-b695-d229319f2993"
+}
+`
+}
@@ -2,9 +2,12 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestAccVariableResourceDefault(t *testing.T) {
@@ -67,6 +70,118 @@ func TestAccVariableResourceDefault(t *testing.T) {
 	})
 }
 
+func TestAccVariableResourceReference(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing, with the referenced variable created in the same apply
+			{
+				Config: testAccVariableResourceConfigSharedVariable() + testAccVariableResourceConfigReference("REFERENCED_VALUE"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("railway_variable.test", "id", "39da7e07-fa3a-42fd-b695-d229319f2993:d0519b29-5d12-4857-a5dd-76fa7418336c:REFERENCE"),
+					resource.TestCheckResourceAttr("railway_variable.test", "name", "REFERENCE"),
+					resource.TestCheckResourceAttr("railway_variable.test", "value", "${{shared.REFERENCED_VALUE}}"),
+					resource.TestCheckNoResourceAttr("railway_variable.test", "reference.service_id"),
+					resource.TestCheckResourceAttr("railway_variable.test", "reference.name", "REFERENCED_VALUE"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccVariableResourceWriteOnly(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccVariableResourceConfigWriteOnly("secret", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("railway_variable.test", "id", "39da7e07-fa3a-42fd-b695-d229319f2993:d0519b29-5d12-4857-a5dd-76fa7418336c:WRITE_ONLY"),
+					resource.TestCheckNoResourceAttr("railway_variable.test", "value"),
+					resource.TestCheckNoResourceAttr("railway_variable.test", "value_wo"),
+					resource.TestCheckResourceAttr("railway_variable.test", "value_wo_version", "1"),
+					resource.TestMatchResourceAttr("railway_variable.test", "value_hash", regexp.MustCompile(`^[0-9a-f]{32}:[0-9a-f]{64}$`)),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccVariableResourceConfigWriteOnly("rotated", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("railway_variable.test", "value"),
+					resource.TestCheckResourceAttr("railway_variable.test", "value_wo_version", "2"),
+					resource.TestMatchResourceAttr("railway_variable.test", "value_hash", regexp.MustCompile(`^[0-9a-f]{32}:[0-9a-f]{64}$`)),
+				),
+			},
+			// Changing the write-only value without its version is detected through its hash
+			{
+				Config:             testAccVariableResourceConfigWriteOnly("changed", 2),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestHashVariableValue(t *testing.T) {
+	hash := hashVariableValue("secret", types.StringNull())
+
+	if !regexp.MustCompile(`^[0-9a-f]{32}:[0-9a-f]{64}$`).MatchString(hash) {
+		t.Fatalf("hashVariableValue() = %q, want a salted hash", hash)
+	}
+
+	if got := hashVariableValue("secret", types.StringValue(hash)); got != hash {
+		t.Errorf("hashVariableValue() with the previous salt = %q, want %q", got, hash)
+	}
+
+	if got := hashVariableValue("rotated", types.StringValue(hash)); got == hash {
+		t.Errorf("hashVariableValue() of another value = %q, want a different hash", got)
+	}
+
+	if got := hashVariableValue("secret", types.StringNull()); got == hash {
+		t.Errorf("hashVariableValue() with a new salt = %q, want a different hash", got)
+	}
+}
+
+func TestAccVariableResourceSealed(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccVariableResourceConfigSealed("sealed", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("railway_variable.test", "id", "39da7e07-fa3a-42fd-b695-d229319f2993:d0519b29-5d12-4857-a5dd-76fa7418336c:SEALED"),
+					resource.TestCheckResourceAttr("railway_variable.test", "value", "sealed"),
+					resource.TestCheckResourceAttr("railway_variable.test", "sealed", "true"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccVariableResourceConfigSealed("resealed", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("railway_variable.test", "value", "resealed"),
+					resource.TestCheckResourceAttr("railway_variable.test", "sealed", "true"),
+				),
+			},
+			// Unsealing testing
+			{
+				Config:      testAccVariableResourceConfigSealed("resealed", false),
+				ExpectError: regexp.MustCompile("Variable SEALED is sealed and cannot be unsealed"),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
 func testAccVariableResourceConfigDefault(value string) string {
 	return fmt.Sprintf(`
 resource "railway_variable" "test" {
@@ -77,3 +192,52 @@ resource "railway_variable" "test" {
 }
 `, value)
 }
+
+func testAccVariableResourceConfigSharedVariable() string {
+	return `
+resource "railway_shared_variable" "test" {
+  name = "REFERENCED_VALUE"
+  value = "referenced"
+  environment_id = "d0519b29-5d12-4857-a5dd-76fa7418336c"
+  project_id = "0bb01547-570d-4109-a5e8-138691f6a2d1"
+}
+`
+}
+
+func testAccVariableResourceConfigReference(name string) string {
+	return fmt.Sprintf(`
+resource "railway_variable" "test" {
+  name = "REFERENCE"
+  environment_id = "d0519b29-5d12-4857-a5dd-76fa7418336c"
+  service_id = "39da7e07-fa3a-42fd-b695-d229319f2993"
+
+  reference = {
+    name = "%s"
+  }
+}
+`, name)
+}
+
+func testAccVariableResourceConfigWriteOnly(value string, version int) string {
+	return fmt.Sprintf(`
+resource "railway_variable" "test" {
+  name = "WRITE_ONLY"
+  value_wo = "%s"
+  value_wo_version = %d
+  environment_id = "d0519b29-5d12-4857-a5dd-76fa7418336c"
+  service_id = "39da7e07-fa3a-42fd-b695-d229319f2993"
+}
+`, value, version)
+}
+
+func testAccVariableResourceConfigSealed(value string, sealed bool) string {
+	return fmt.Sprintf(`
+resource "railway_variable" "test" {
+  name = "SEALED"
+  value = "%s"
+  sealed = %t
+  environment_id = "d0519b29-5d12-4857-a5dd-76fa7418336c"
+  service_id = "39da7e07-fa3a-42fd-b695-d229319f2993"
+}
+`, value, sealed)
+}
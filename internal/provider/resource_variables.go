@@ -0,0 +1,420 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &VariablesResource{}
+var _ resource.ResourceWithImportState = &VariablesResource{}
+
+func NewVariablesResource() resource.Resource {
+	return &VariablesResource{}
+}
+
+type VariablesResource struct {
+	client *graphql.Client
+}
+
+type VariablesResourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	Variables     types.Map    `tfsdk:"variables"`
+	IgnoreNames   types.Set    `tfsdk:"ignore_names"`
+	SkipDeploys   types.Bool   `tfsdk:"skip_deploys"`
+	EnvironmentId types.String `tfsdk:"environment_id"`
+	ServiceId     types.String `tfsdk:"service_id"`
+	ProjectId     types.String `tfsdk:"project_id"`
+}
+
+func (r *VariablesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_variables"
+}
+
+func (r *VariablesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Railway variables. Authoritative set of variables of a service, or of the shared variables of an environment when `service_id` is not set. Variables not listed here, other than `ignore_names`, are removed.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the variables.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"variables": schema.MapAttribute{
+				MarkdownDescription: "Variables keyed by name.",
+				ElementType:         types.StringType,
+				Required:            true,
+				Sensitive:           true,
+			},
+			"ignore_names": schema.SetAttribute{
+				MarkdownDescription: "Names of the variables which are not managed, such as those injected by Railway or managed elsewhere. **Default** `[]`.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+			},
+			"skip_deploys": schema.BoolAttribute{
+				MarkdownDescription: "Whether to skip redeploying the service when the variables change. **Default** `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the environment the variables belong to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an id"),
+				},
+			},
+			"service_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the service the variables belong to.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an id"),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the project the shared variables belong to. Computed from `service_id` when managing the variables of a service.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an id"),
+					stringvalidator.ExactlyOneOf(path.MatchRoot("service_id")),
+				},
+			},
+		},
+	}
+}
+
+func (r *VariablesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VariablesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *VariablesResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.ServiceId.IsNull() {
+		service, err := getService(ctx, *r.client, data.ServiceId.ValueString())
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read service, got error: %s", err))
+			return
+		}
+
+		data.ProjectId = types.StringValue(service.Service.ProjectId)
+	}
+
+	err := applyVariables(ctx, *r.client, data)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create variables, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created variables")
+
+	err = getAndBuildVariables(ctx, *r.client, data)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read variables after creating them, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VariablesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *VariablesResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := getAndBuildVariables(ctx, *r.client, data)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read variables, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VariablesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *VariablesResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := applyVariables(ctx, *r.client, data)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update variables, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "updated variables")
+
+	err = getAndBuildVariables(ctx, *r.client, data)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read variables after updating them, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VariablesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *VariablesResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	names := make([]string, 0, len(data.Variables.Elements()))
+
+	for name := range data.Variables.Elements() {
+		names = append(names, name)
+	}
+
+	if len(names) > 0 {
+		err := deleteManyVariables(ctx, *r.client, data.EnvironmentId.ValueString(), data.ServiceId.ValueStringPointer(), names)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete variables, got error: %s", err))
+			return
+		}
+
+		if !data.ServiceId.IsNull() && !data.SkipDeploys.ValueBool() {
+			_, err = redeployServiceInstance(ctx, *r.client, data.EnvironmentId.ValueString(), data.ServiceId.ValueString())
+
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to redeploy service after variables deleted, got error: %s", err))
+				return
+			}
+		}
+	}
+
+	tflog.Trace(ctx, "deleted variables")
+}
+
+func (r *VariablesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, ":")
+
+	if !(len(parts) == 2 && parts[0] != "" && parts[1] != "") && !(len(parts) == 3 && parts[0] == "shared" && parts[1] != "" && parts[2] != "") {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: service_id:environment_name or shared:project_id:environment_name. Got: %q", req.ID),
+		)
+
+		return
+	}
+
+	var projectId string
+	var environmentName string
+
+	if len(parts) == 3 {
+		projectId = parts[1]
+		environmentName = parts[2]
+	} else {
+		service, err := getService(ctx, *r.client, parts[0])
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read service, got error: %s", err))
+			return
+		}
+
+		projectId = service.Service.ProjectId
+		environmentName = parts[1]
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_id"), parts[0])...)
+	}
+
+	environmentId, err := findEnvironment(ctx, *r.client, projectId, environmentName)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read environment, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_id"), environmentId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("ignore_names"), []string{})...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("skip_deploys"), false)...)
+}
+
+func listVariables(ctx context.Context, client graphql.Client, data *VariablesResourceModel) (map[string]interface{}, error) {
+	if data.ServiceId.IsNull() {
+		response, err := getSharedVariables(ctx, client, data.ProjectId.ValueString(), data.EnvironmentId.ValueString())
+
+		if err != nil {
+			return nil, err
+		}
+
+		return response.Variables, nil
+	}
+
+	response, err := getVariables(ctx, client, data.ProjectId.ValueString(), data.EnvironmentId.ValueString(), data.ServiceId.ValueString())
+
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Variables, nil
+}
+
+func getIgnoredVariableNames(data *VariablesResourceModel) map[string]bool {
+	ignored := make(map[string]bool, len(data.IgnoreNames.Elements()))
+
+	for _, name := range data.IgnoreNames.Elements() {
+		ignored[name.(types.String).ValueString()] = true
+	}
+
+	return ignored
+}
+
+// applyVariables upserts the changed variables and deletes the ones not in the configuration,
+// leaving the ignored ones untouched. The service is redeployed once if anything changed.
+func applyVariables(ctx context.Context, client graphql.Client, data *VariablesResourceModel) error {
+	existing, err := listVariables(ctx, client, data)
+
+	if err != nil {
+		return err
+	}
+
+	ignored := getIgnoredVariableNames(data)
+	variablesToUpsert := make(map[string]interface{})
+
+	for name, value := range data.Variables.Elements() {
+		str := value.(types.String).ValueString()
+
+		if current, ok := existing[name]; !ok || fmt.Sprintf("%v", current) != str {
+			variablesToUpsert[name] = str
+		}
+	}
+
+	namesToDelete := make([]string, 0)
+
+	for name := range existing {
+		if _, ok := data.Variables.Elements()[name]; !ok && !ignored[name] {
+			namesToDelete = append(namesToDelete, name)
+		}
+	}
+
+	if len(variablesToUpsert) > 0 {
+		input := VariableCollectionUpsertInput{
+			ServiceId:     data.ServiceId.ValueStringPointer(),
+			EnvironmentId: data.EnvironmentId.ValueString(),
+			ProjectId:     data.ProjectId.ValueString(),
+			Variables:     variablesToUpsert,
+			SkipDeploys:   true,
+		}
+
+		_, err := upsertVariableCollection(ctx, client, input)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(namesToDelete) > 0 {
+		err := deleteManyVariables(ctx, client, data.EnvironmentId.ValueString(), data.ServiceId.ValueStringPointer(), namesToDelete)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	if data.ServiceId.IsNull() || data.SkipDeploys.ValueBool() || (len(variablesToUpsert) == 0 && len(namesToDelete) == 0) {
+		return nil
+	}
+
+	_, err = redeployServiceInstance(ctx, client, data.EnvironmentId.ValueString(), data.ServiceId.ValueString())
+
+	return err
+}
+
+func getAndBuildVariables(ctx context.Context, client graphql.Client, data *VariablesResourceModel) error {
+	existing, err := listVariables(ctx, client, data)
+
+	if err != nil {
+		return err
+	}
+
+	ignored := getIgnoredVariableNames(data)
+	variables := make(map[string]attr.Value, len(existing))
+
+	for name, value := range existing {
+		if ignored[name] {
+			continue
+		}
+
+		variables[name] = types.StringValue(fmt.Sprintf("%v", value))
+	}
+
+	if data.ServiceId.IsNull() {
+		data.Id = types.StringValue(fmt.Sprintf("shared:%s:%s", data.ProjectId.ValueString(), data.EnvironmentId.ValueString()))
+	} else {
+		data.Id = types.StringValue(fmt.Sprintf("%s:%s", data.ServiceId.ValueString(), data.EnvironmentId.ValueString()))
+	}
+
+	data.Variables = types.MapValueMust(types.StringType, variables)
+
+	return nil
+}
@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccVariablesResourceDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccVariablesResourceConfigDefault("one", "two"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("railway_variables.test", "id", "39da7e07-fa3a-42fd-b695-d229319f2993:d0519b29-5d12-4857-a5dd-76fa7418336c"),
+					resource.TestCheckResourceAttr("railway_variables.test", "environment_id", "d0519b29-5d12-4857-a5dd-76fa7418336c"),
+					resource.TestCheckResourceAttr("railway_variables.test", "service_id", "39da7e07-fa3a-42fd-b695-d229319f2993"),
+					resource.TestCheckResourceAttr("railway_variables.test", "project_id", "0bb01547-570d-4109-a5e8-138691f6a2d1"),
+					resource.TestCheckResourceAttr("railway_variables.test", "variables.%", "2"),
+					resource.TestCheckResourceAttr("railway_variables.test", "variables.VALUE_A", "one"),
+					resource.TestCheckResourceAttr("railway_variables.test", "variables.VALUE_B", "two"),
+					resource.TestCheckResourceAttr("railway_variables.test", "ignore_names.#", "0"),
+					resource.TestCheckResourceAttr("railway_variables.test", "skip_deploys", "false"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "railway_variables.test",
+				ImportState:       true,
+				ImportStateId:     "39da7e07-fa3a-42fd-b695-d229319f2993:staging",
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccVariablesResourceConfigNonDefault("three"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("railway_variables.test", "id", "39da7e07-fa3a-42fd-b695-d229319f2993:d0519b29-5d12-4857-a5dd-76fa7418336c"),
+					resource.TestCheckResourceAttr("railway_variables.test", "variables.%", "1"),
+					resource.TestCheckResourceAttr("railway_variables.test", "variables.VALUE_C", "three"),
+					resource.TestCheckResourceAttr("railway_variables.test", "ignore_names.#", "1"),
+					resource.TestCheckResourceAttr("railway_variables.test", "ignore_names.0", "VALUE_A"),
+					resource.TestCheckResourceAttr("railway_variables.test", "skip_deploys", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccVariablesResourceShared(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccVariablesResourceConfigShared("one"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("railway_variables.test", "id", "shared:0bb01547-570d-4109-a5e8-138691f6a2d1:d0519b29-5d12-4857-a5dd-76fa7418336c"),
+					resource.TestCheckResourceAttr("railway_variables.test", "environment_id", "d0519b29-5d12-4857-a5dd-76fa7418336c"),
+					resource.TestCheckNoResourceAttr("railway_variables.test", "service_id"),
+					resource.TestCheckResourceAttr("railway_variables.test", "project_id", "0bb01547-570d-4109-a5e8-138691f6a2d1"),
+					resource.TestCheckResourceAttr("railway_variables.test", "variables.%", "1"),
+					resource.TestCheckResourceAttr("railway_variables.test", "variables.SHARED_A", "one"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "railway_variables.test",
+				ImportState:       true,
+				ImportStateId:     "shared:0bb01547-570d-4109-a5e8-138691f6a2d1:staging",
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccVariablesResourceConfigShared("two"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("railway_variables.test", "variables.%", "1"),
+					resource.TestCheckResourceAttr("railway_variables.test", "variables.SHARED_A", "two"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccVariablesResourceConfigDefault(valueA, valueB string) string {
+	return fmt.Sprintf(`
+resource "railway_variables" "test" {
+  environment_id = "d0519b29-5d12-4857-a5dd-76fa7418336c"
+  service_id = "39da7e07-fa3a-42fd-b695-d229319f2993"
+
+  variables = {
+    VALUE_A = "%s"
+    VALUE_B = "%s"
+  }
+}
+`, valueA, valueB)
+}
+
+func testAccVariablesResourceConfigNonDefault(valueC string) string {
+	return fmt.Sprintf(`
+resource "railway_variables" "test" {
+  environment_id = "d0519b29-5d12-4857-a5dd-76fa7418336c"
+  service_id = "39da7e07-fa3a-42fd-b695-d229319f2993"
+  ignore_names = ["VALUE_A"]
+  skip_deploys = true
+
+  variables = {
+    VALUE_C = "%s"
+  }
+}
+`, valueC)
+}
+
+func testAccVariablesResourceConfigShared(value string) string {
+	return fmt.Sprintf(`
+resource "railway_variables" "test" {
+  environment_id = "d0519b29-5d12-4857-a5dd-76fa7418336c"
+  project_id = "0bb01547-570d-4109-a5e8-138691f6a2d1"
+
+  variables = {
+    SHARED_A = "%s"
+  }
+}
+`, value)
+}
@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type VariableReferenceModel struct {
+	ServiceId types.String `tfsdk:"service_id"`
+	Name      types.String `tfsdk:"name"`
+}
+
+var variableReferenceAttrTypes = map[string]attr.Type{
+	"service_id": types.StringType,
+	"name":       types.StringType,
+}
+
+func variableReferenceSchema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "Reference to another variable in the same environment. Sets `value` to the matching Railway template. A warning is shown when the referenced variable does not exist yet, since it may be created in the same apply.",
+		Optional:            true,
+		Attributes: map[string]schema.Attribute{
+			"service_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the service the referenced variable belongs to. References a shared variable when not set.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex(), "must be an id"),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the referenced variable.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+// renderVariableReference returns the template which resolves to the referenced variable. It returns
+// an unknown value if any part of the reference is not known yet. The referenced variable may be created
+// in the same apply, so a missing variable is only reported as a warning.
+func renderVariableReference(ctx context.Context, client graphql.Client, serviceId types.String, environmentId types.String, reference *VariableReferenceModel) (types.String, string, error) {
+	if serviceId.IsUnknown() || environmentId.IsUnknown() || reference.ServiceId.IsUnknown() || reference.Name.IsUnknown() {
+		return types.StringUnknown(), "", nil
+	}
+
+	name := reference.Name.ValueString()
+
+	if reference.ServiceId.IsNull() {
+		service, err := getService(ctx, client, serviceId.ValueString())
+
+		if err != nil {
+			return types.StringNull(), "", err
+		}
+
+		response, err := getSharedVariables(ctx, client, service.Service.ProjectId, environmentId.ValueString())
+
+		if err != nil {
+			return types.StringNull(), "", err
+		}
+
+		value := types.StringValue(fmt.Sprintf("${{shared.%s}}", name))
+
+		if _, ok := response.Variables[name]; !ok {
+			return value, fmt.Sprintf("Shared variable %s does not exist yet, the reference resolves to an empty value unless it is created.", name), nil
+		}
+
+		return value, "", nil
+	}
+
+	service, err := getService(ctx, client, reference.ServiceId.ValueString())
+
+	if err != nil {
+		return types.StringNull(), "", err
+	}
+
+	response, err := getVariables(ctx, client, service.Service.ProjectId, environmentId.ValueString(), service.Service.Id)
+
+	if err != nil {
+		return types.StringNull(), "", err
+	}
+
+	value := types.StringValue(fmt.Sprintf("${{%s.%s}}", service.Service.Name, name))
+
+	if _, ok := response.Variables[name]; !ok && !slices.Contains(railwayProvidedVariables, name) {
+		return value, fmt.Sprintf("Variable %s does not exist yet in service %s, the reference resolves to an empty value unless it is created.", name, service.Service.Name), nil
+	}
+
+	return value, "", nil
+}
+
+// Railway provides these variables to every service, so they are not returned with the others
+var railwayProvidedVariables = []string{
+	"RAILWAY_PUBLIC_DOMAIN",
+	"RAILWAY_PRIVATE_DOMAIN",
+	"RAILWAY_TCP_PROXY_DOMAIN",
+	"RAILWAY_TCP_PROXY_PORT",
+	"RAILWAY_TCP_APPLICATION_PORT",
+	"RAILWAY_PROJECT_NAME",
+	"RAILWAY_PROJECT_ID",
+	"RAILWAY_ENVIRONMENT_NAME",
+	"RAILWAY_ENVIRONMENT_ID",
+	"RAILWAY_SERVICE_NAME",
+	"RAILWAY_SERVICE_ID",
+	"RAILWAY_REPLICA_ID",
+	"RAILWAY_REPLICA_REGION",
+	"RAILWAY_DEPLOYMENT_ID",
+	"RAILWAY_SNAPSHOT_ID",
+	"RAILWAY_VOLUME_NAME",
+	"RAILWAY_VOLUME_MOUNT_PATH",
+	"RAILWAY_GIT_COMMIT_SHA",
+	"RAILWAY_GIT_AUTHOR",
+	"RAILWAY_GIT_BRANCH",
+	"RAILWAY_GIT_REPO_NAME",
+	"RAILWAY_GIT_REPO_OWNER",
+	"RAILWAY_GIT_COMMIT_MESSAGE",
+}
@@ -0,0 +1,31 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// waitUntil calls check every interval until it reports done, returns an error or the timeout is reached.
+func waitUntil(ctx context.Context, timeout time.Duration, interval time.Duration, check func() (bool, error)) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for {
+		done, err := check()
+
+		if err != nil {
+			return err
+		}
+
+		if done {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out after %s", timeout)
+		case <-time.After(interval):
+		}
+	}
+}